@@ -2,24 +2,54 @@ package binding
 
 import (
 	"bytes"
-	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/abi"
 )
 
 // ABI binder context
 type Binder interface {
-	RegisterTuple(tuple *Tuple)
-	GetTuple(name string) (*Tuple, bool)
+	// RegisterTuple register parsed tuple, returns the registered tuple of the same identity or
+	// structure which should be used instead, and error if the identity conflicts
+	RegisterTuple(tuple *Tuple) (*Tuple, error)
+	GetTuple(id string) (*Tuple, bool)
 	BeginContract(name string, abi []byte)
 	Func(name string, selector string, inputs []abi.Encoder, outputs []abi.Encoder, jsondata *abi.JSONField)
 	EndContract()
 }
 
+// DocBinder optional binder interface receiving the NatSpec documentation of current contract,
+// which is called by Parse if the input is a compiler artifact with userdoc/devdoc
+type DocBinder interface {
+	Doc(doc *NatSpec)
+}
+
+// EventBinder optional binder interface receiving the events of current contract, topic is the
+// hex keccak256 hash of event signature
+type EventBinder interface {
+	Event(topic string, signature string, inputs []abi.Encoder, jsondata *abi.JSONField)
+}
+
+// BytecodeBinder optional binder interface receiving the 0x prefixed creation and runtime bytecode
+// of current contract, which is called by Parse if the input is a compiler artifact with bytecode
+type BytecodeBinder interface {
+	Bytecode(bytecode string, deployed string)
+}
+
 type symbolsImpl struct {
 	tuples map[string]*Tuple
 }
@@ -31,12 +61,22 @@ func NewSymbols() Binder {
 	}
 }
 
-func (impl *symbolsImpl) RegisterTuple(tuple *Tuple) {
-	impl.tuples[tuple.BindingName] = tuple
+func (impl *symbolsImpl) RegisterTuple(tuple *Tuple) (*Tuple, error) {
+	if existing, ok := impl.tuples[tuple.ID]; ok {
+		if !existing.SameStructure(tuple) {
+			return nil, errors.Wrap(ErrConflict, "struct %s declared as %s and %s", tuple.ID, existing.Structure, tuple.Structure)
+		}
+
+		return existing, nil
+	}
+
+	impl.tuples[tuple.ID] = tuple
+
+	return tuple, nil
 }
 
-func (impl *symbolsImpl) GetTuple(name string) (*Tuple, bool) {
-	t, ok := impl.tuples[name]
+func (impl *symbolsImpl) GetTuple(id string) (*Tuple, bool) {
+	t, ok := impl.tuples[id]
 
 	return t, ok
 }
@@ -54,45 +94,259 @@ func (impl *symbolsImpl) EndContract() {
 }
 
 type Contract struct {
-	Name          string
-	ABI           string
-	Funcs         []*Func
-	Contructor    *Func
-	overrideFuncs map[string]int
+	Name       string
+	ABI        string // compact json abi
+	Funcs      []*Func
+	Events     []*Event
+	Contructor *Func
+	Doc        string // rendered contract NatSpec comment lines
+	Bytecode   string // 0x prefixed creation bytecode, empty if unknown
+	Deployed   string // 0x prefixed runtime bytecode, empty if unknown
+	natspec    *NatSpec
+}
+
+// ABILiteral returns go string literal of json abi, raw string is used if possible
+func (c *Contract) ABILiteral() string {
+	if strings.Contains(c.ABI, "`") {
+		return strconv.Quote(c.ABI)
+	}
+
+	return "`" + c.ABI + "`"
+}
+
+// Calls returns view/pure funcs of contract
+func (c *Contract) Calls() []*Func {
+	var funcs []*Func
+
+	for _, f := range c.Funcs {
+		if f.ReadOnly {
+			funcs = append(funcs, f)
+		}
+	}
+
+	return funcs
+}
+
+// Transacts returns state-changing funcs of contract
+func (c *Contract) Transacts() []*Func {
+	var funcs []*Func
+
+	for _, f := range c.Funcs {
+		if !f.ReadOnly {
+			funcs = append(funcs, f)
+		}
+	}
+
+	return funcs
 }
 
 type Func struct {
 	ReadOnly       bool
 	Name           string
 	Selector       string
+	Signature      string // canonical solidity signature, e.g. transfer(address,uint256)
 	Inputs         []string
 	Outputs        []string
 	GoInputParams  string
 	GoOutputParams string
 	GoInputArgs    string
 	GoOutputArgs   string
+	GoCallArgs     string // forwarding arguments of mock func, include the variadic ops
+	Doc            string // rendered NatSpec comment lines
+	CodecCall      string // generated codec call statement, empty if codec is disabled
+	CodecReturn    string // generated codec return statement, empty if codec is disabled
+	CodecStrict    bool   // validate return data before the generated codec decodes it
+	solidityName   string
+	inputNames     []string // solidity input names
+	goInputNames   []string
+	outputRawNames []string // solidity output names
+	outputNames    []string // go output names of view/pure func
+	inputEncoders  []abi.Encoder
+	outputEncoders []abi.Encoder
+}
+
+// Event generated event struct, filterer and watcher of contract event
+type Event struct {
+	Name           string // go name, the event struct is named <Contract><Name>Event
+	Topic          string // hex topic0 without 0x
+	Signature      string // canonical solidity signature, e.g. Transfer(address,address,uint256)
+	Fields         []*EventField
+	GoFilterParams string // OR-set params of indexed inputs, e.g. from []address.Address, to []address.Address
+	GoFilterArgs   string // forwarding arguments of indexed inputs
+	GoFieldArgs    string // unpack targets of event struct fields, e.g. &event.From, &event.To
+	Doc            string // rendered NatSpec comment lines of event struct
+	solidityName   string
+	inputNames     []string // solidity input names
+	inputEncoders  []abi.Encoder
+}
+
+// EventField go field of event struct
+type EventField struct {
+	Name   string
+	GoType string // hashed indexed inputs are [32]byte, see abi.IndexedValueType
 }
 
 type Generator struct {
 	tuples    map[string]*Tuple
 	contracts []*Contract
+	codec     bool
+	strict    bool
+	names     map[string]string
+	natspecs  map[string]*NatSpec
+	err       error
+}
+
+// GenOption generator option
+type GenOption func(gen *Generator)
+
+// WithCodec generate zero-reflection marshal/unmarshal code for tuple structs and functions,
+// which are byte-identical with the reflective abi encoders
+func WithCodec() GenOption {
+	return func(gen *Generator) {
+		gen.codec = true
+	}
 }
 
-func (impl *Generator) RegisterTuple(tuple *Tuple) {
-	impl.tuples[tuple.BindingName] = tuple
+// WithStrict validate return data in strict mode before the generated codec decodes it, see
+// abi.Validate, the reflective bindings are always decoded by abi.Func.Return in strict mode
+func WithStrict() GenOption {
+	return func(gen *Generator) {
+		gen.strict = true
+	}
+}
+
+// WithNames override go method names of funcs, the key is the canonical solidity signature
+// optionally prefixed by contract name, e.g. "safeTransferFrom(address,address,uint256,bytes)"
+// or "CurveUSDVault.safeTransferFrom(address,address,uint256,bytes)"
+func WithNames(overrides map[string]string) GenOption {
+	return func(gen *Generator) {
+		for k, v := range overrides {
+			gen.names[k] = v
+		}
+	}
 }
 
-func (impl *Generator) GetTuple(name string) (*Tuple, bool) {
-	t, ok := impl.tuples[name]
+// WithNatSpec add NatSpec documentation of contract, e.g. parsed from separate userdoc/devdoc json
+// by ParseNatSpecFile, it is merged with the documentation of compiler artifact
+func WithNatSpec(contract string, doc *NatSpec) GenOption {
+	return func(gen *Generator) {
+		if gen.natspecs[contract] == nil {
+			gen.natspecs[contract] = NewNatSpec()
+		}
+
+		gen.natspecs[contract].Merge(doc)
+	}
+}
+
+// RegisterTuple register tuple by identity, struct with the same name and components declared by
+// different contracts is deduplicated, the go name of conflicting struct is qualified by the
+// declaring contract, e.g. Settlement.Order -> SettlementOrder
+func (impl *Generator) RegisterTuple(tuple *Tuple) (*Tuple, error) {
+	if existing, ok := impl.tuples[tuple.ID]; ok {
+		if !existing.SameStructure(tuple) {
+			return nil, errors.Wrap(ErrConflict, "struct %s declared as %s and %s", tuple.ID, existing.Structure, tuple.Structure)
+		}
+
+		return existing, nil
+	}
+
+	ids := impl.tupleIDs()
+
+	for _, id := range ids {
+		other := impl.tuples[id]
+
+		if tupleShortName(id) == tupleShortName(tuple.ID) && other.SameStructure(tuple) {
+			impl.tuples[tuple.ID] = other
+			return other, nil
+		}
+	}
+
+	if other, ok := impl.localTuple(tuple.BindingName); ok {
+		i := strings.LastIndex(tuple.ID, ".")
+
+		if i < 0 {
+			return nil, errors.Wrap(ErrConflict, "struct %s %s conflicts with struct %s %s", tuple.ID, tuple.Structure, other.ID, other.Structure)
+		}
+
+		tuple.BindingName = GoName(tuple.ID[:i]) + tuple.BindingName
+
+		if other, ok := impl.localTuple(tuple.BindingName); ok {
+			return nil, errors.Wrap(ErrConflict, "struct %s go name %s conflicts with struct %s", tuple.ID, tuple.BindingName, other.ID)
+		}
+	}
+
+	impl.tuples[tuple.ID] = tuple
+
+	return tuple, nil
+}
+
+func (impl *Generator) GetTuple(id string) (*Tuple, bool) {
+	t, ok := impl.tuples[id]
 
 	return t, ok
 }
 
+func tupleShortName(id string) string {
+	return id[strings.LastIndex(id, ".")+1:]
+}
+
+// tupleIDs returns sorted tuple identities
+func (impl *Generator) tupleIDs() []string {
+	var ids []string
+
+	for id := range impl.tuples {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (impl *Generator) localTuple(bindingName string) (*Tuple, bool) {
+	for _, tuple := range impl.tuples {
+		if tuple.Package == "" && tuple.BindingName == bindingName {
+			return tuple, true
+		}
+	}
+
+	return nil, false
+}
+
+// localTuples returns deduplicated tuples generated by this generator, sorted by go name
+func (impl *Generator) localTuples() []*Tuple {
+	var tuples []*Tuple
+
+	seen := make(map[*Tuple]bool)
+
+	for _, tuple := range impl.tuples {
+		if tuple.Package != "" || seen[tuple] {
+			continue
+		}
+
+		seen[tuple] = true
+
+		tuples = append(tuples, tuple)
+	}
+
+	sort.Slice(tuples, func(i, j int) bool {
+		return tuples[i].BindingName < tuples[j].BindingName
+	})
+
+	return tuples
+}
+
 func (impl *Generator) BeginContract(name string, abi []byte) {
+	var compact bytes.Buffer
+
+	if err := json.Compact(&compact, abi); err != nil {
+		compact.Reset()
+		compact.Write(abi)
+	}
+
 	impl.contracts = append(impl.contracts, &Contract{
-		Name:          name,
-		ABI:           hex.EncodeToString(abi),
-		overrideFuncs: make(map[string]int),
+		Name: name,
+		ABI:  compact.String(),
 	})
 }
 
@@ -114,77 +368,89 @@ func (impl *Generator) Func(name string, selector string, inputs []abi.Encoder,
 
 	c := impl.contracts[len(impl.contracts)-1]
 
-	if counter, ok := c.overrideFuncs[name]; ok {
-		c.overrideFuncs[name] = counter + 1
-		name = fmt.Sprintf("%s%d", name, counter)
-	} else {
-		c.overrideFuncs[name] = 1
-	}
-
 	var is []string
-	var inputParams []string
-	var inputArgs []string
+	var rawInputNames []string
+	var rawOutputNames []string
+	var inputNames []string
 
 	for i, p := range inputs {
 		is = append(is, p.GoTypeName())
+		rawInputNames = append(rawInputNames, jsondata.Inputs[i].Name)
+		inputNames = append(inputNames, goParamName(jsondata.Inputs[i].Name))
+	}
 
-		name := jsondata.Inputs[i].Name
+	used := map[string]bool{"ret0": !readOnly}
 
-		if name == "" {
-			name = fmt.Sprintf("param%d", i)
-		}
+	inputNames = uniqueNames(inputNames, "param", used)
 
-		inputParams = append(inputParams, fmt.Sprintf("%s %s", name, p.GoTypeName()))
+	var inputParams []string
 
-		inputArgs = append(inputArgs, name)
+	for i, p := range inputs {
+		inputParams = append(inputParams, fmt.Sprintf("%s %s", inputNames[i], p.GoTypeName()))
 	}
 
+	callArgs := append([]string{"ctx"}, inputNames...)
+
 	if !readOnly {
 		inputParams = append(inputParams, "ops ...abi.Op")
+		callArgs = append(callArgs, "ops...")
 	}
 
 	var os []string
 	var outputParams []string
 	var goOutputArgs []string
+	var outputNames []string
 
-	for i, p := range outputs {
-
-		if contructor {
-			continue
+	if !contructor {
+		for i, p := range outputs {
+			os = append(os, p.GoTypeName())
+			rawOutputNames = append(rawOutputNames, jsondata.Outputs[i].Name)
+			outputNames = append(outputNames, goParamName(jsondata.Outputs[i].Name))
 		}
+	}
 
-		is = append(os, p.GoTypeName())
-
-		if readOnly {
-			name := jsondata.Outputs[i].Name
+	if readOnly {
+		outputNames = uniqueNames(outputNames, "ret", used)
 
-			if name == "" {
-				name = fmt.Sprintf("ret%d", i)
-			}
-			outputParams = append(outputParams, fmt.Sprintf("%s %s", name, p.GoTypeName()))
-			goOutputArgs = append(goOutputArgs, fmt.Sprintf("&%s", name))
+		for i, p := range os {
+			outputParams = append(outputParams, fmt.Sprintf("%s %s", outputNames[i], p))
+			goOutputArgs = append(goOutputArgs, fmt.Sprintf("&%s", outputNames[i]))
 		}
-
-	}
-
-	if !readOnly {
+	} else {
+		outputNames = nil
 		outputParams = append(outputParams, "ret0 abi.Transaction")
 	}
 
 	if !contructor {
 		outputParams = append(outputParams, "err error")
 
-		c.Funcs = append(c.Funcs, &Func{
+		var signature []string
+
+		for _, input := range inputs {
+			signature = append(signature, input.String())
+		}
+
+		f := &Func{
 			ReadOnly:       readOnly,
-			Name:           name,
 			Selector:       selector,
+			Signature:      fmt.Sprintf("%s(%s)", jsondata.Name, strings.Join(signature, ",")),
 			Inputs:         is,
 			Outputs:        os,
 			GoInputParams:  strings.Join(inputParams, ", "),
 			GoOutputParams: strings.Join(outputParams, ", "),
-			GoInputArgs:    strings.Join(inputArgs, ", "),
+			GoInputArgs:    strings.Join(inputNames, ", "),
 			GoOutputArgs:   strings.Join(goOutputArgs, ", "),
-		})
+			GoCallArgs:     strings.Join(callArgs, ", "),
+			solidityName:   jsondata.Name,
+			inputNames:     rawInputNames,
+			goInputNames:   inputNames,
+			outputRawNames: rawOutputNames,
+			outputNames:    outputNames,
+			inputEncoders:  inputs,
+			outputEncoders: outputs,
+		}
+
+		c.Funcs = append(c.Funcs, f)
 
 	} else {
 		c.Contructor = &Func{
@@ -199,111 +465,427 @@ func (impl *Generator) Func(name string, selector string, inputs []abi.Encoder,
 
 }
 
+// filterType returns the go OR-set type of indexed event input
+func filterType(input abi.Encoder) string {
+	switch {
+	case abi.IndexedValueType(input):
+		return "[]" + input.GoTypeName()
+	case input.String() == "string":
+		return "[]string"
+	case input.String() == "bytes":
+		return "[][]byte"
+	default:
+		return "[][32]byte"
+	}
+}
+
+func (impl *Generator) Event(topic string, signature string, inputs []abi.Encoder, jsondata *abi.JSONField) {
+	if len(impl.contracts) == 0 {
+		return
+	}
+
+	c := impl.contracts[len(impl.contracts)-1]
+
+	var fieldNames, fieldTypes, paramNames, paramTypes []string
+
+	for i, input := range inputs {
+		param := jsondata.Inputs[i]
+
+		goType := input.GoTypeName()
+
+		if param.Indexed != nil && *param.Indexed {
+			if !abi.IndexedValueType(input) {
+				goType = "[32]byte"
+			}
+
+			paramNames = append(paramNames, eventParamName(param.Name))
+			paramTypes = append(paramTypes, filterType(input))
+		}
+
+		fieldNames = append(fieldNames, GoName(param.Name))
+		fieldTypes = append(fieldTypes, goType)
+	}
+
+	fieldNames = uniqueNames(fieldNames, "Arg", map[string]bool{"Raw": true})
+	paramNames = uniqueNames(paramNames, "arg", make(map[string]bool))
+
+	e := &Event{
+		Topic:         topic,
+		Signature:     signature,
+		solidityName:  jsondata.Name,
+		inputEncoders: inputs,
+	}
+
+	var fieldArgs, filterParams []string
+
+	for i, name := range fieldNames {
+		e.Fields = append(e.Fields, &EventField{Name: name, GoType: fieldTypes[i]})
+		e.inputNames = append(e.inputNames, jsondata.Inputs[i].Name)
+		fieldArgs = append(fieldArgs, "&event."+name)
+	}
+
+	for i, name := range paramNames {
+		filterParams = append(filterParams, fmt.Sprintf("%s %s", name, paramTypes[i]))
+	}
+
+	e.GoFilterParams = strings.Join(filterParams, ", ")
+	e.GoFilterArgs = strings.Join(paramNames, ", ")
+	e.GoFieldArgs = strings.Join(fieldArgs, ", ")
+
+	c.Events = append(c.Events, e)
+}
+
+func (impl *Generator) Doc(doc *NatSpec) {
+	if len(impl.contracts) == 0 {
+		return
+	}
+
+	c := impl.contracts[len(impl.contracts)-1]
+
+	if c.natspec == nil {
+		c.natspec = NewNatSpec()
+	}
+
+	c.natspec.Merge(doc)
+}
+
+func (impl *Generator) Bytecode(bytecode string, deployed string) {
+	if len(impl.contracts) == 0 {
+		return
+	}
+
+	c := impl.contracts[len(impl.contracts)-1]
+
+	c.Bytecode, c.Deployed = bytecode, deployed
+}
+
+// EndContract resolve go names of contract funcs, the naming error is returned by Write
 func (impl *Generator) EndContract() {
+	if len(impl.contracts) == 0 || impl.err != nil {
+		return
+	}
+
+	c := impl.contracts[len(impl.contracts)-1]
+
+	if err := c.resolveNames(impl.names); err != nil {
+		impl.err = err
+		return
+	}
 
+	if doc, ok := impl.natspecs[c.Name]; ok {
+		if c.natspec == nil {
+			c.natspec = NewNatSpec()
+		}
+
+		c.natspec.Merge(doc)
+	}
+
+	c.Doc = c.natspec.contractDoc()
+
+	for _, f := range c.Funcs {
+		f.Doc = c.natspec.funcDoc(f)
+	}
+
+	for _, e := range c.Events {
+		e.Doc = c.natspec.eventDoc(c.Name+e.Name+"Event", e)
+	}
+
+	if !impl.codec {
+		return
+	}
+
+	for _, f := range c.Funcs {
+		f.CodecCall = fmt.Sprintf("buff, err = pack%s%s(%s)", c.Name, f.Name, f.GoInputArgs)
+		f.CodecReturn = fmt.Sprintf("%s = unpack%s%s(buff)", strings.Join(append(f.outputNames, "err"), ", "), c.Name, f.Name)
+		f.CodecStrict = impl.strict
+	}
 }
 
-func (impl *Generator) calcImports(buff bytes.Buffer) []string {
-	content := buff.String()
+// importPaths well-known packages referenced by generated code
+var importPaths = map[string]string{
+	"context": "context",
+	"hex":     "encoding/hex",
+	"strings": "strings",
+	"sync":    "sync",
+	"big":     "math/big",
+	"errors":  "github.com/libs4go/errors",
+	"abi":     "github.com/libs4go/ethers/abi",
+	"binding": "github.com/libs4go/ethers/abi/binding",
+	"client":  "github.com/libs4go/ethers/client",
+	"signer":  "github.com/libs4go/ethers/signer",
+	"address": "github.com/libs4go/ethers/address",
+	"fixed":   "github.com/libs4go/fixed",
+}
 
-	var imports []string
+// calcImports returns the standard and third-party imports referenced by generated code
+func (impl *Generator) calcImports(content string) ([]string, []string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package generated\n"+content, 0)
 
-	if strings.Contains(content, "*big.Int") {
-		imports = append(imports, "math/big")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse generated code error")
 	}
 
-	if strings.Contains(content, "address.Address") {
-		imports = append(imports, "github.com/libs4go/ethers/address")
+	paths := make(map[string]string)
+
+	for name, path := range importPaths {
+		paths[name] = path
 	}
 
-	return imports
+	for _, tuple := range impl.tuples {
+		if tuple.Package != "" {
+			paths[tuple.BindingName[:strings.Index(tuple.BindingName, ".")]] = tuple.Package
+		}
+	}
+
+	used := make(map[string]bool)
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				if path, ok := paths[ident.Name]; ok {
+					used[path] = true
+				}
+			}
+		}
+
+		return true
+	})
+
+	var std, imports []string
+
+	for path := range used {
+		if strings.Contains(path, ".") {
+			imports = append(imports, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(imports)
+
+	return std, imports, nil
 }
 
 type headerModel struct {
 	Name    string
+	Std     []string
 	Imports []string
 }
 
+// format add package header and imports to generated code
+func (impl *Generator) format(packageName string, content string) ([]byte, error) {
+	std, imports, err := impl.calcImports(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hm := &headerModel{
+		Name:    packageName,
+		Std:     std,
+		Imports: imports,
+	}
+
+	var headerBuff bytes.Buffer
+
+	err = headerTmpl.Execute(&headerBuff, hm)
+
+	if err != nil {
+		return nil, err
+	}
+
+	headerBuff.WriteString(content)
+
+	source, err := format.Source(headerBuff.Bytes())
+
+	if err != nil {
+		return nil, errors.Wrap(err, "format generated code error")
+	}
+
+	return source, nil
+}
+
+// render generate the shared types code and code of each contract, without package header
+func (impl *Generator) render() (string, []string, error) {
+	if impl.err != nil {
+		return "", nil, impl.err
+	}
+
+	tuples := impl.localTuples()
+
+	var types bytes.Buffer
+
+	if err := tupleTmpl.Execute(&types, tuples); err != nil {
+		return "", nil, err
+	}
+
+	codec := newCodecGen(tuples)
+
+	var contracts []string
+
+	for _, contract := range impl.contracts {
+		var buff bytes.Buffer
+
+		if err := contractTmpl.Execute(&buff, []*Contract{contract}); err != nil {
+			return "", nil, err
+		}
+
+		if impl.codec {
+			buff.WriteString(codec.funcSource(contract))
+		}
+
+		contracts = append(contracts, buff.String())
+	}
+
+	if impl.codec {
+		types.WriteString(codec.tupleSource())
+		types.WriteString(codec.helperSource())
+	}
+
+	return types.String(), contracts, nil
+}
+
+// Write write all tuples and contracts into one go source file
 func (impl *Generator) Write(packageName string, writer io.Writer) error {
+	types, contracts, err := impl.render()
 
-	var buff bytes.Buffer
+	if err != nil {
+		return err
+	}
 
-	err := tupleTmpl.Execute(&buff, impl.tuples)
+	source, err := impl.format(packageName, strings.Join(append([]string{types}, contracts...), ""))
 
 	if err != nil {
 		return err
 	}
 
-	err = contractTmpl.Execute(&buff, impl.contracts)
+	_, err = writer.Write(source)
+
+	return err
+}
+
+// WriteTypes write the tuple structs shared by contracts, and the codec helpers if WithCodec is set
+func (impl *Generator) WriteTypes(packageName string, writer io.Writer) error {
+	types, _, err := impl.render()
 
 	if err != nil {
 		return err
 	}
 
-	hm := &headerModel{
-		Name:    packageName,
-		Imports: impl.calcImports(buff),
+	source, err := impl.format(packageName, types)
+
+	if err != nil {
+		return err
 	}
 
-	var headerBuff bytes.Buffer
+	_, err = writer.Write(source)
 
-	err = headerTmpl.Execute(&headerBuff, hm)
+	return err
+}
+
+// WriteContract write the binding of contract, which references the structs written by WriteTypes
+func (impl *Generator) WriteContract(packageName string, name string, writer io.Writer) error {
+	_, contracts, err := impl.render()
 
 	if err != nil {
 		return err
 	}
 
-	_, err = writer.Write(headerBuff.Bytes())
+	for i, contract := range impl.contracts {
+		if contract.Name != name {
+			continue
+		}
+
+		source, err := impl.format(packageName, contracts[i])
+
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(source)
 
-	if err != nil {
 		return err
 	}
 
-	_, err = writer.Write(buff.Bytes())
+	return errors.Wrap(ErrBinding, "contract %s not found", name)
+}
+
+// WriteDir write types.go and one <contract>.go file per contract into dir, file names are lower case
+func (impl *Generator) WriteDir(packageName string, dir string) error {
+	types, contracts, err := impl.render()
 
 	if err != nil {
 		return err
 	}
 
-	return err
+	files := map[string]string{"types.go": types}
+
+	for i, contract := range impl.contracts {
+		filename := strings.ToLower(contract.Name) + ".go"
+
+		if _, ok := files[filename]; ok {
+			return errors.Wrap(ErrConflict, "contract %s file %s conflicts", contract.Name, filename)
+		}
+
+		files[filename] = contracts[i]
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "create dir %s error", dir)
+	}
+
+	for filename, content := range files {
+		source, err := impl.format(packageName, content)
+
+		if err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(dir, filename), source, 0644); err != nil {
+			return errors.Wrap(err, "write file %s error", filename)
+		}
+	}
+
+	return nil
 }
 
-func NewGen() *Generator {
-	return &Generator{
-		tuples: make(map[string]*Tuple),
+func NewGen(options ...GenOption) *Generator {
+	gen := &Generator{
+		tuples:   make(map[string]*Tuple),
+		names:    make(map[string]string),
+		natspecs: make(map[string]*NatSpec),
 	}
+
+	for _, option := range options {
+		option(gen)
+	}
+
+	return gen
 }
 
 var headerTmplText = `
 package {{.Name}}
 
 import (
-	"context"
-	"encoding/hex"
-
-	"github.com/libs4go/errors"
-	"github.com/libs4go/ethers/abi"
-	"github.com/libs4go/ethers/abi/binding"
-	"github.com/libs4go/ethers/client"
-	"github.com/libs4go/ethers/signer"
-	
-
+{{- range $_, $import := .Std}}
+	"{{$import}}"
+{{- end}}
 {{range $_, $import := .Imports}}
 	"{{$import}}"
-{{end}}
-
+{{- end}}
 )
 `
 
 var headerTmpl = template.Must(template.New("Gen").Parse(headerTmplText))
 
 var tupleTmplText = `
-{{range $key, $element := .}}
-// Generated tuple "{{$key}}" stub code , do not modify manually
-type {{$key}} struct {
-	{{range $name, $field := $element.BindingFields}}
-	{{$name}} {{$field}}
-	{{end}}
+{{range $_, $element := .}}
+// Generated tuple "{{$element.BindingName}}" stub code , do not modify manually
+type {{$element.BindingName}} struct {
+{{- range $_, $field := $element.BindingFields}}
+	{{$field.Name}} {{$field.GoType}} ` + "`" + `abi:"{{$field.ABIName}}"` + "`" + `
+{{- end}}
 }
 
 {{end}}
@@ -313,22 +895,178 @@ var tupleTmpl = template.Must(template.New("Gen").Parse(tupleTmplText))
 
 var contractTmplText = `
 {{range $index, $element := .}}
-// type {{$element.Name}} interface {
-// 	{{range $_, $field := $element.Funcs}}
-// 	{{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}})
-// 	{{end}}
-// }
+// {{$element.Name}}Caller view/pure funcs of contract {{$element.Name}}
+type {{$element.Name}}Caller interface {
+	{{- range $_, $field := $element.Calls}}
+	{{$field.Doc}}{{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}})
+	{{- end}}
+}
+
+// {{$element.Name}}Transactor state-changing funcs of contract {{$element.Name}}
+type {{$element.Name}}Transactor interface {
+	{{- range $_, $field := $element.Transacts}}
+	{{$field.Doc}}{{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}})
+	{{- end}}
+}
+
+{{- if $element.Events}}
+
+// {{$element.Name}}Filterer event filterers and watchers of contract {{$element.Name}}
+type {{$element.Name}}Filterer interface {
+	{{- range $_, $event := $element.Events}}
+	Filter{{$event.Name}}(ctx context.Context, opts *binding.FilterOpts, {{$event.GoFilterParams}})(events []*{{$element.Name}}{{$event.Name}}Event, err error)
+	Watch{{$event.Name}}(ctx context.Context, opts *binding.WatchOpts, sink chan<- *{{$element.Name}}{{$event.Name}}Event, {{$event.GoFilterParams}})(sub client.Subscription, err error)
+	{{- end}}
+}
+{{- end}}
+
+// {{$element.Name}} contract {{$element.Name}} binding interface
+{{$element.Doc}}type {{$element.Name}} interface {
+	{{$element.Name}}Caller
+	{{$element.Name}}Transactor
+	{{- if $element.Events}}
+	{{$element.Name}}Filterer
+	{{- end}}
+}
+{{range $_, $event := $element.Events}}
+{{if $event.Doc}}{{$event.Doc}}{{else}}// {{$element.Name}}{{$event.Name}}Event event {{$event.Signature}} of contract {{$element.Name}}
+{{end}}type {{$element.Name}}{{$event.Name}}Event struct {
+	{{- range $_, $field := $event.Fields}}
+	{{$field.Name}} {{$field.GoType}}
+	{{- end}}
+	Raw *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+{{end}}
+// {{$element.Name}}ABI json abi of contract {{$element.Name}}
+const {{$element.Name}}ABI = {{$element.ABILiteral}}
+{{if $element.Bytecode}}
+// {{$element.Name}}Bytecode creation bytecode of contract {{$element.Name}}
+const {{$element.Name}}Bytecode = "{{$element.Bytecode}}"
+{{end}}
+{{- if $element.Deployed}}
+// {{$element.Name}}DeployedBytecode runtime bytecode of contract {{$element.Name}}
+const {{$element.Name}}DeployedBytecode = "{{$element.Deployed}}"
+{{end}}
+var (
+	parsed{{$element.Name}}Once sync.Once
+	parsed{{$element.Name}} abi.Contract
+	parsed{{$element.Name}}Err error
+)
+
+// {{$element.Name}}Contract returns the abi.Contract of {{$element.Name}}ABI, which is parsed once on first use
+func {{$element.Name}}Contract() (abi.Contract, error) {
+	parsed{{$element.Name}}Once.Do(func() {
+		parsed{{$element.Name}}, parsed{{$element.Name}}Err = binding.Parse("{{$element.Name}}", []byte({{$element.Name}}ABI), binding.NewSymbols())
+	})
+
+	return parsed{{$element.Name}}, parsed{{$element.Name}}Err
+}
+
+// New{{$element.Name}} create {{$element.Name}} binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func New{{$element.Name}}(recipient address.Address, provider client.Provider, signer signer.Signer) (*{{$element.Name}}Impl, error) {
+	contract, err := {{$element.Name}}Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return New{{$element.Name}}Impl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// New{{$element.Name}}Caller create {{$element.Name}}Caller of contract deployed at recipient
+func New{{$element.Name}}Caller(recipient address.Address, provider client.Provider) (*{{$element.Name}}CallerImpl, error) {
+	contract, err := {{$element.Name}}Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{$element.Name}}CallerImpl{
+		Contract: contract,
+		Client: provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+{{if $element.Events}}
+// New{{$element.Name}}Filterer create {{$element.Name}}Filterer of contract deployed at recipient
+func New{{$element.Name}}Filterer(recipient address.Address, provider client.Provider) (*{{$element.Name}}FiltererImpl, error) {
+	contract, err := {{$element.Name}}Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{$element.Name}}FiltererImpl{
+		Contract: contract,
+		Client: provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+{{end}}
+// {{$element.Name}}CallerImpl {{$element.Name}}Caller implementation calling contract via provider
+type {{$element.Name}}CallerImpl struct {
+	Contract abi.Contract
+	Client client.Provider
+	Recipient string
+}
 
-type {{$element.Name}} struct {
+// {{$element.Name}}TransactorImpl {{$element.Name}}Transactor implementation sending signed transactions via provider
+type {{$element.Name}}TransactorImpl struct {
 	Contract abi.Contract
 	Client client.Provider
 	Signer signer.Signer
 	Recipient string
 }
 
-{{range $_, $field := $element.Funcs}}
-func (impl *{{$element.Name}}) {{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}}) {
-	f, ok :=  abi.TryGetFunc(impl.Contract, "{{$field.Selector}}")
+{{- if $element.Events}}
+
+// {{$element.Name}}FiltererImpl {{$element.Name}}Filterer implementation querying and subscribing logs via provider
+type {{$element.Name}}FiltererImpl struct {
+	Contract abi.Contract
+	Client client.Provider
+	Recipient string
+}
+{{- end}}
+
+// {{$element.Name}}Impl {{$element.Name}} implementation
+type {{$element.Name}}Impl struct {
+	*{{$element.Name}}CallerImpl
+	*{{$element.Name}}TransactorImpl
+	{{- if $element.Events}}
+	*{{$element.Name}}FiltererImpl
+	{{- end}}
+}
+
+// New{{$element.Name}}Impl create {{$element.Name}} implementation of contract deployed at recipient
+func New{{$element.Name}}Impl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *{{$element.Name}}Impl {
+	return &{{$element.Name}}Impl{
+		{{$element.Name}}CallerImpl: &{{$element.Name}}CallerImpl{
+			Contract: contract,
+			Client: provider,
+			Recipient: recipient,
+		},
+		{{$element.Name}}TransactorImpl: &{{$element.Name}}TransactorImpl{
+			Contract: contract,
+			Client: provider,
+			Signer: signer,
+			Recipient: recipient,
+		},
+		{{- if $element.Events}}
+		{{$element.Name}}FiltererImpl: &{{$element.Name}}FiltererImpl{
+			Contract: contract,
+			Client: provider,
+			Recipient: recipient,
+		},
+		{{- end}}
+	}
+}
+
+var _ {{$element.Name}} = (*{{$element.Name}}Impl)(nil)
+
+{{range $_, $field := $element.Calls}}
+{{$field.Doc}}func (impl *{{$element.Name}}CallerImpl) {{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}}) {
+	{{if and $field.CodecCall (not $field.CodecStrict)}}_{{else}}f{{end}}, ok :=  impl.Contract.Select("{{$field.Selector}}")
 
 	if !ok {
 		err = errors.Wrap(binding.ErrBinding, "func {{$field.Name}} not found")
@@ -337,16 +1075,15 @@ func (impl *{{$element.Name}}) {{$field.Name}}(ctx context.Context, {{$field.GoI
 
 	var buff []byte
 
-	buff, err = f.Call({{$field.GoInputArgs}})
+	{{if $field.CodecCall}}{{$field.CodecCall}}{{else}}buff, err = f.Call({{$field.GoInputArgs}}){{end}}
 
 	if err != nil {
 		return
 	}
 
-	{{if $field.ReadOnly}}
 	callSite := &client.CallSite {
 		To: impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 	
 	var ret string
@@ -357,17 +1094,39 @@ func (impl *{{$element.Name}}) {{$field.Name}}(ctx context.Context, {{$field.GoI
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
 	}
 
-	_, err = f.Return(buff,[]interface{}{ {{$field.GoOutputArgs}} })
+	{{if $field.CodecStrict}}if err = binding.ValidateReturn(f, buff); err != nil {
+		return
+	}
+
+	{{end}}{{if $field.CodecReturn}}{{$field.CodecReturn}}{{else}}_, err = f.Return(buff,[]interface{}{ {{$field.GoOutputArgs}} }){{end}}
 
 	return
+}
+{{end}}
+
+{{range $_, $field := $element.Transacts}}
+{{$field.Doc}}func (impl *{{$element.Name}}TransactorImpl) {{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}}) {
+	{{if $field.CodecCall}}_{{else}}f{{end}}, ok :=  impl.Contract.Select("{{$field.Selector}}")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func {{$field.Name}} not found")
+		return
+	}
+
+	var buff []byte
+
+	{{if $field.CodecCall}}{{$field.CodecCall}}{{else}}buff, err = f.Call({{$field.GoInputArgs}}){{end}}
+
+	if err != nil {
+		return
+	}
 
-	{{else}}
 	var callOps *abi.CallOps
 	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
 
@@ -378,8 +1137,126 @@ func (impl *{{$element.Name}}) {{$field.Name}}(ctx context.Context, {{$field.GoI
 	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
 	return
+}
+{{end}}
+
+{{range $_, $event := $element.Events}}
+// Filter{{$event.Name}} returns {{$event.Name}} events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *{{$element.Name}}FiltererImpl) Filter{{$event.Name}}(ctx context.Context, opts *binding.FilterOpts, {{$event.GoFilterParams}})(events []*{{$element.Name}}{{$event.Name}}Event, err error) {
+	e, ok := impl.Contract.SelectEvent("{{$event.Topic}}")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event {{$event.Name}} not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics({{$event.GoFilterArgs}})
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &{{$element.Name}}{{$event.Name}}Event{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{ {{$event.GoFieldArgs}} }); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// Watch{{$event.Name}} stream {{$event.Name}} events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *{{$element.Name}}FiltererImpl) Watch{{$event.Name}}(ctx context.Context, opts *binding.WatchOpts, sink chan<- *{{$element.Name}}{{$event.Name}}Event, {{$event.GoFilterParams}})(sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("{{$event.Topic}}")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event {{$event.Name}} not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics({{$event.GoFilterArgs}})
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &{{$element.Name}}{{$event.Name}}Event{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{ {{$event.GoFieldArgs}} }); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+{{end}}
+
+// Mock{{$element.Name}} in-memory {{$element.Name}} implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type Mock{{$element.Name}} struct {
+	{{- range $_, $field := $element.Funcs}}
+	{{$field.Name}}Func func(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}})
+	{{- end}}
+	{{- range $_, $event := $element.Events}}
+	Filter{{$event.Name}}Func func(ctx context.Context, opts *binding.FilterOpts, {{$event.GoFilterParams}})(events []*{{$element.Name}}{{$event.Name}}Event, err error)
+	Watch{{$event.Name}}Func func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *{{$element.Name}}{{$event.Name}}Event, {{$event.GoFilterParams}})(sub client.Subscription, err error)
+	{{- end}}
+}
+
+var _ {{$element.Name}} = (*Mock{{$element.Name}})(nil)
+
+{{range $_, $field := $element.Funcs}}
+func (mock *Mock{{$element.Name}}) {{$field.Name}}(ctx context.Context, {{$field.GoInputParams}})({{$field.GoOutputParams}}) {
+	if mock.{{$field.Name}}Func == nil {
+		err = errors.Wrap(binding.ErrMock, "func {{$field.Name}} not stubbed")
+		return
+	}
+
+	return mock.{{$field.Name}}Func({{$field.GoCallArgs}})
+}
+{{end}}
+
+{{range $_, $event := $element.Events}}
+func (mock *Mock{{$element.Name}}) Filter{{$event.Name}}(ctx context.Context, opts *binding.FilterOpts, {{$event.GoFilterParams}})(events []*{{$element.Name}}{{$event.Name}}Event, err error) {
+	if mock.Filter{{$event.Name}}Func == nil {
+		err = errors.Wrap(binding.ErrMock, "func Filter{{$event.Name}} not stubbed")
+		return
+	}
+
+	return mock.Filter{{$event.Name}}Func(ctx, opts, {{$event.GoFilterArgs}})
+}
+
+func (mock *Mock{{$element.Name}}) Watch{{$event.Name}}(ctx context.Context, opts *binding.WatchOpts, sink chan<- *{{$element.Name}}{{$event.Name}}Event, {{$event.GoFilterParams}})(sub client.Subscription, err error) {
+	if mock.Watch{{$event.Name}}Func == nil {
+		err = errors.Wrap(binding.ErrMock, "func Watch{{$event.Name}} not stubbed")
+		return
+	}
 
-	{{end}}
+	return mock.Watch{{$event.Name}}Func(ctx, opts, sink, {{$event.GoFilterArgs}})
 }
 {{end}}
 
@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/internal/keccak"
 )
 
 // Some parse regex
@@ -21,42 +24,213 @@ var (
 )
 
 type Tuple struct {
-	BindingName   string            // golang binding struct name
-	BindingFields map[string]string // golang binding struct field
-	Encoder       abi.Encoder       // tuple abi encoder
+	ID            string        // struct identity, qualified by declaring contract if present, e.g. LibOrder.Order
+	BindingName   string        // golang binding struct name, qualified by package name if imported
+	BindingFields []*TupleField // golang binding struct fields, in tuple components order
+	Structure     string        // canonical components with names, used to detect conflicts
+	Package       string        // import path of the package declaring the struct, empty if generated locally
+	Encoder       abi.Encoder   // tuple abi encoder
+}
+
+// SameStructure check if tuples have the same components
+func (tuple *Tuple) SameStructure(other *Tuple) bool {
+	return tuple.Structure == other.Structure
+}
+
+// TupleField golang binding struct field of tuple component
+type TupleField struct {
+	Name    string // golang field name
+	GoType  string // golang field type
+	ABIName string // tuple component name, used as field tag `abi:"name"`
 }
 
 type funcABI struct {
-	selector []byte      // function selector
-	inputs   abi.Encoder // parameter tuple encoder
-	outputs  abi.Encoder // return values tuple encoder
+	selector  []byte         // function selector
+	signature string         // canonical function signature
+	field     *abi.JSONField // json abi of function
+	inputs    abi.Encoder    // parameter tuple encoder
+	outputs   abi.Encoder    // return values tuple encoder
+}
+
+func (f *funcABI) Selector() []byte {
+	return f.selector
+}
+
+func (f *funcABI) SelectorString() string {
+	return hex.EncodeToString(f.selector)
 }
 
-func (abi *funcABI) Selector() []byte {
-	return abi.selector
+func (f *funcABI) Name() string {
+	return f.field.Name
 }
 
-func (abi *funcABI) SelectorString() string {
-	return hex.EncodeToString(abi.selector)
+func (f *funcABI) Signature() string {
+	return f.signature
 }
 
-func (abi *funcABI) Call(params ...interface{}) ([]byte, error) {
-	buff, err := abi.inputs.Marshal(params)
+func (f *funcABI) Inputs() []*abi.JSONParam {
+	return f.field.Inputs
+}
+
+func (f *funcABI) Outputs() []*abi.JSONParam {
+	return f.field.Outputs
+}
+
+func (f *funcABI) Call(params ...interface{}) ([]byte, error) {
+	buff, err := f.inputs.Marshal(params)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return append(abi.selector, buff...), nil
+	return append(append([]byte{}, f.selector...), buff...), nil
 }
 
-func (abi *funcABI) Return(data []byte, v interface{}) (uint, error) {
-	return abi.outputs.Unmarshal(data, v)
+// Return unmarshal return data, which is validated in strict mode first, see abi.Validate
+func (f *funcABI) Return(data []byte, v interface{}) (uint, error) {
+	return abi.UnmarshalStrict(f.outputs, data, v)
+}
+
+// DecodeInputs unmarshal call data, which is validated in strict mode first, see abi.Validate
+func (f *funcABI) DecodeInputs(data []byte, v interface{}) (uint, error) {
+	if !bytes.HasPrefix(data, f.selector) {
+		return 0, errors.Wrap(abi.ErrSelector, "func %s: call data selector mismatch", f.signature)
+	}
+
+	l, err := abi.UnmarshalStrict(f.inputs, data[len(f.selector):], v)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return l + uint(len(f.selector)), nil
+}
+
+func (f *funcABI) EncodeOutputs(values ...interface{}) ([]byte, error) {
+	return f.outputs.Marshal(values)
+}
+
+// ValidateReturn check return data of func in strict mode, used by bindings generated with
+// WithCodec and WithStrict before the zero-reflection decoding
+func ValidateReturn(f abi.Func, data []byte) error {
+	impl, ok := f.(*funcABI)
+
+	if !ok {
+		return errors.Wrap(ErrBinding, "func %s: not created by binding", f.Signature())
+	}
+
+	_, err := abi.Validate(impl.outputs, data)
+
+	return err
+}
+
+// decodeArgs decode call data into named dynamic values
+func (f *funcABI) decodeArgs(data []byte) (*abi.DecodedCall, error) {
+	var value abi.Value
+
+	if _, err := f.DecodeInputs(data, &value); err != nil {
+		return nil, err
+	}
+
+	args, ok := value.(abi.TupleValue)
+
+	if !ok {
+		return nil, errors.Wrap(abi.ErrValue, "func %s: decode args error", f.signature)
+	}
+
+	return &abi.DecodedCall{
+		Func:      f,
+		Name:      f.field.Name,
+		Signature: f.signature,
+		Args:      args,
+	}, nil
+}
+
+type eventABI struct {
+	topic     []byte         // keccak256 hash of signature
+	signature string         // canonical event signature
+	field     *abi.JSONField // json abi of event
+	indexed   []abi.Encoder  // indexed input encoders
+	data      abi.Encoder    // non-indexed inputs tuple encoder
+}
+
+func (e *eventABI) Name() string {
+	return e.field.Name
+}
+
+func (e *eventABI) Signature() string {
+	return e.signature
+}
+
+func (e *eventABI) Topic() []byte {
+	return e.topic
+}
+
+func (e *eventABI) Anonymous() bool {
+	return e.field.Anonymous != nil && *e.field.Anonymous
+}
+
+func (e *eventABI) Inputs() []*abi.JSONParam {
+	return e.field.Inputs
+}
+
+func (e *eventABI) FilterTopics(args ...interface{}) ([][]string, error) {
+	var topic0 []byte
+
+	if !e.Anonymous() {
+		topic0 = e.topic
+	}
+
+	return abi.EncodeFilterTopics(topic0, e.indexed, args)
+}
+
+func (e *eventABI) Unpack(topics [][]byte, data []byte, values []interface{}) error {
+	if len(values) != len(e.field.Inputs) {
+		return errors.Wrap(abi.ErrValue, "event %s: values len %d != inputs len %d", e.signature, len(values), len(e.field.Inputs))
+	}
+
+	if !e.Anonymous() {
+		if len(topics) == 0 || !bytes.Equal(topics[0], e.topic) {
+			return errors.Wrap(abi.ErrTopic, "event %s: topic0 mismatch", e.signature)
+		}
+
+		topics = topics[1:]
+	}
+
+	if len(topics) != len(e.indexed) {
+		return errors.Wrap(abi.ErrTopic, "event %s: indexed topics %d != %d", e.signature, len(topics), len(e.indexed))
+	}
+
+	var dataValues []interface{}
+
+	i := 0
+
+	for j, input := range e.field.Inputs {
+		if input.Indexed == nil || !*input.Indexed {
+			dataValues = append(dataValues, values[j])
+			continue
+		}
+
+		if err := abi.DecodeTopic(e.indexed[i], topics[i], values[j]); err != nil {
+			return errors.Wrap(err, "event %s: decode indexed input %d error", e.signature, j)
+		}
+
+		i++
+	}
+
+	if len(dataValues) == 0 {
+		return nil
+	}
+
+	_, err := e.data.Unmarshal(data, dataValues)
+
+	return err
 }
 
 type contractImpl struct {
-	funcs       map[string]*funcABI // function encoders
-	constructor *funcABI            // contructor encoders
+	funcs       map[string]*funcABI  // function encoders
+	events      map[string]*eventABI // event encoders, keyed by hex topic0
+	constructor *funcABI             // contructor encoders
 }
 
 func (contract *contractImpl) Select(selector string) (abi.Func, bool) {
@@ -65,6 +239,40 @@ func (contract *contractImpl) Select(selector string) (abi.Func, bool) {
 	return f, ok
 }
 
+func (contract *contractImpl) Funcs() []abi.Func {
+	var funcs []abi.Func
+
+	for _, f := range contract.funcs {
+		funcs = append(funcs, f)
+	}
+
+	sort.Slice(funcs, func(i, j int) bool {
+		return funcs[i].Signature() < funcs[j].Signature()
+	})
+
+	return funcs
+}
+
+func (contract *contractImpl) SelectEvent(topic string) (abi.Event, bool) {
+	e, ok := contract.events[strings.ToLower(strings.TrimPrefix(topic, "0x"))]
+
+	return e, ok
+}
+
+func (contract *contractImpl) DecodeCall(data []byte) (*abi.DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, errors.Wrap(abi.ErrLength, "call data length %d < 4", len(data))
+	}
+
+	f, ok := contract.funcs[hex.EncodeToString(data[:4])]
+
+	if !ok {
+		return nil, errors.Wrap(abi.ErrSelector, "selector %x not found", data[:4])
+	}
+
+	return f.decodeArgs(data)
+}
+
 func (contract *contractImpl) parseFunc(index int, field *abi.JSONField, binder Binder) (*funcABI, error) {
 
 	name := strings.Title(field.Name)
@@ -85,7 +293,17 @@ func (contract *contractImpl) parseFunc(index int, field *abi.JSONField, binder
 		return nil, errors.Wrap(abi.ErrJSON, "func name expect,field(%d)", index)
 	}
 
-	f := &funcABI{}
+	f := &funcABI{
+		field: field,
+	}
+
+	encoder, inputs, err := contract.parseParams("inputs", field.Inputs, binder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.inputs = encoder
 
 	if field.Type == abi.JSONTypeFunc {
 		var buff bytes.Buffer
@@ -93,41 +311,82 @@ func (contract *contractImpl) parseFunc(index int, field *abi.JSONField, binder
 
 		buff.WriteString("(")
 
+		// canonical types, e.g. tuple[] is expanded to (uint256,address)[]
 		var args []string
-		for _, arg := range field.Inputs {
-			args = append(args, arg.Type)
+		for _, input := range inputs {
+			args = append(args, input.String())
 		}
 
 		buff.WriteString(strings.Join(args, ","))
 
 		buff.WriteString(")")
 
-		f.selector = abi.Selector(buff.String())
+		f.signature = buff.String()
+		f.selector = abi.Selector(f.signature)
 	}
 
-	encoder, inputs, err := contract.parseParams("inputs", field.Inputs, binder)
+	encoder, outputs, err := contract.parseParams("outputs", field.Outputs, binder)
 
 	if err != nil {
 		return nil, err
 	}
 
-	f.inputs = encoder
+	f.outputs = encoder
 
-	encoder, outputs, err := contract.parseParams("outputs", field.Outputs, binder)
+	binder.Func(name, f.SelectorString(), inputs, outputs, field)
+
+	return f, nil
+}
+
+func (contract *contractImpl) parseEvent(index int, field *abi.JSONField, binder Binder) (*eventABI, error) {
+	if field.Name == "" {
+		return nil, errors.Wrap(abi.ErrJSON, "event name expect,field(%d)", index)
+	}
+
+	_, inputs, err := contract.parseParams("inputs", field.Inputs, binder)
 
 	if err != nil {
 		return nil, err
 	}
 
-	f.outputs = encoder
+	e := &eventABI{
+		field: field,
+	}
 
-	binder.Func(name, f.SelectorString(), inputs, outputs, field)
+	var types []string
+	var names []string
+	var elems []abi.Encoder
 
-	return f, nil
+	for i, input := range inputs {
+		types = append(types, input.String())
+
+		if field.Inputs[i].Indexed != nil && *field.Inputs[i].Indexed {
+			e.indexed = append(e.indexed, input)
+		} else {
+			names = append(names, field.Inputs[i].Name)
+			elems = append(elems, input)
+		}
+	}
+
+	e.signature = fmt.Sprintf("%s(%s)", field.Name, strings.Join(types, ","))
+	e.topic = keccak.Hash([]byte(e.signature))
+
+	e.data, err = abi.NamedTuple("data", names, elems...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if eventBinder, ok := binder.(EventBinder); ok {
+		eventBinder.Event(hex.EncodeToString(e.topic), e.signature, inputs, field)
+	}
+
+	return e, nil
 }
 
 func (contract *contractImpl) parseParams(name string, params []*abi.JSONParam, binder Binder) (abi.Encoder, []abi.Encoder, error) {
 	var elems []abi.Encoder
+	var names []string
 
 	for _, param := range params {
 		enc, err := contract.parseParam(param, binder)
@@ -137,9 +396,10 @@ func (contract *contractImpl) parseParams(name string, params []*abi.JSONParam,
 		}
 
 		elems = append(elems, enc)
+		names = append(names, param.Name)
 	}
 
-	t, err := abi.Tuple(name, elems...)
+	t, err := abi.NamedTuple(name, names, elems...)
 
 	if err != nil {
 		return nil, nil, err
@@ -216,17 +476,83 @@ func (contract *contractImpl) parseTuple(param *abi.JSONParam, binder Binder) (a
 	allMatch := TupleNameRegex.FindStringSubmatch(param.InternalType)
 
 	if len(allMatch) != 2 {
+		if strings.HasPrefix(param.InternalType, "tuple") {
+			return contract.parseAnonymousTuple(param, binder)
+		}
+
 		return nil, errors.Wrap(abi.ErrJSON, "struct InternalType('%s') parse error", param.InternalType)
 	}
 
-	tuple, ok := binder.GetTuple(allMatch[1])
+	var elems []abi.Encoder
+	var names []string
+	var fields []*TupleField
 
-	if ok {
-		return tuple.Encoder, nil
+	fieldNames := make(map[string]bool)
+
+	for i, p := range param.Components {
+		elem, err := contract.parseParam(p, binder)
+
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, elem)
+		names = append(names, p.Name)
+
+		fieldName := GoName(p.Name)
+
+		if fieldName == "" {
+			fieldName = fmt.Sprintf("Field%d", i)
+		}
+
+		if fieldNames[fieldName] {
+			return nil, errors.Wrap(ErrNaming, "struct %s: component %s go field name %s collides", allMatch[1], p.Name, fieldName)
+		}
+
+		fieldNames[fieldName] = true
+
+		fields = append(fields, &TupleField{
+			Name:    fieldName,
+			GoType:  elem.GoTypeName(),
+			ABIName: p.Name,
+		})
+	}
+
+	id := allMatch[1]
+
+	var structure []string
+
+	for i, elem := range elems {
+		structure = append(structure, strings.TrimSpace(elem.String()+" "+names[i]))
+	}
+
+	tuple, err := binder.RegisterTuple(&Tuple{
+		ID:            id,
+		BindingName:   GoName(id[strings.LastIndex(id, ".")+1:]),
+		BindingFields: fields,
+		Structure:     "(" + strings.Join(structure, ",") + ")",
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
+	if tuple.Encoder == nil {
+		tuple.Encoder, err = abi.NamedTuple(tuple.BindingName, names, elems...)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tuple.Encoder, nil
+}
+
+// parseAnonymousTuple parse inline tuple without struct name, e.g. human-readable "(uint256,address)",
+// which is not registered to binder
+func (contract *contractImpl) parseAnonymousTuple(param *abi.JSONParam, binder Binder) (abi.Encoder, error) {
 	var elems []abi.Encoder
-	fields := make(map[string]string)
+	var names []string
 
 	for _, p := range param.Components {
 		elem, err := contract.parseParam(p, binder)
@@ -236,38 +562,176 @@ func (contract *contractImpl) parseTuple(param *abi.JSONParam, binder Binder) (a
 		}
 
 		elems = append(elems, elem)
-
-		fields[strings.Title(p.Name)] = elem.GoTypeName()
+		names = append(names, p.Name)
 	}
 
-	encoder, err := abi.Tuple(allMatch[1], elems...)
+	return abi.NamedTuple("tuple", names, elems...)
+}
+
+// ParseHumanReadable parse human-readable abi fragments, see abi.ParseHumanReadable
+func ParseHumanReadable(name string, fragments []string, binder Binder) (abi.Contract, error) {
+	fields, err := abi.ParseHumanReadable(fragments...)
 
 	if err != nil {
 		return nil, err
 	}
 
-	tuple = &Tuple{
-		BindingName:   allMatch[1],
-		BindingFields: fields,
-		Encoder:       encoder,
+	data, err := json.Marshal(fields)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal human-readable abi error")
 	}
 
-	binder.RegisterTuple(tuple)
+	return Parse(name, data, binder)
+}
 
-	return encoder, nil
+// artifact compiler output of contract, e.g. solc standard json contract output, hardhat and
+// foundry artifacts
+type artifact struct {
+	ABI              json.RawMessage `json:"abi"`
+	UserDoc          json.RawMessage `json:"userdoc"`
+	DevDoc           json.RawMessage `json:"devdoc"`
+	Metadata         json.RawMessage `json:"metadata"`         // solc metadata object or string, with output.userdoc/devdoc
+	Bytecode         json.RawMessage `json:"bytecode"`         // hex string or foundry {"object": "0x..."}
+	DeployedBytecode json.RawMessage `json:"deployedBytecode"` // hex string or foundry {"object": "0x..."}
+	EVM              struct {
+		Bytecode         json.RawMessage `json:"bytecode"`
+		DeployedBytecode json.RawMessage `json:"deployedBytecode"`
+	} `json:"evm"` // solc standard json output
+}
+
+// Artifact parsed compiler artifact
+type Artifact struct {
+	ABI              []byte   // json abi array
+	NatSpec          *NatSpec // nil if artifact has no userdoc/devdoc
+	Bytecode         string   // 0x prefixed creation bytecode, empty if absent
+	DeployedBytecode string   // 0x prefixed runtime bytecode, empty if absent
+}
+
+// bytecodeOf returns 0x prefixed bytecode of hex string or {"object": "..."} json
+func bytecodeOf(raws ...json.RawMessage) string {
+	for _, raw := range raws {
+		if raw == nil {
+			continue
+		}
+
+		var text string
+
+		if json.Unmarshal(raw, &text) != nil {
+			var object struct {
+				Object string `json:"object"`
+			}
+
+			if json.Unmarshal(raw, &object) != nil {
+				continue
+			}
+
+			text = object.Object
+		}
+
+		if text = strings.TrimPrefix(text, "0x"); text != "" {
+			return "0x" + text
+		}
+	}
+
+	return ""
+}
+
+type artifactMetadata struct {
+	Output struct {
+		UserDoc json.RawMessage `json:"userdoc"`
+		DevDoc  json.RawMessage `json:"devdoc"`
+	} `json:"output"`
+}
+
+// ParseArtifact parse compiler artifact, e.g. solc standard json contract output, hardhat and
+// foundry artifacts, the plain json abi array is returned as Artifact.ABI
+func ParseArtifact(data []byte) (*Artifact, error) {
+	trimmed := bytes.TrimSpace(data)
+
+	if !bytes.HasPrefix(trimmed, []byte("{")) {
+		return &Artifact{ABI: data}, nil
+	}
+
+	var output artifact
+
+	if err := json.Unmarshal(trimmed, &output); err != nil {
+		return nil, errors.Wrap(err, "parse contract artifact error")
+	}
+
+	if output.ABI == nil {
+		return nil, errors.Wrap(abi.ErrJSON, "contract artifact abi field expect")
+	}
+
+	result := &Artifact{
+		ABI:              output.ABI,
+		Bytecode:         bytecodeOf(output.Bytecode, output.EVM.Bytecode),
+		DeployedBytecode: bytecodeOf(output.DeployedBytecode, output.EVM.DeployedBytecode),
+	}
+
+	docs := []json.RawMessage{output.UserDoc, output.DevDoc}
+
+	if output.Metadata != nil {
+		metadata := []byte(output.Metadata)
+
+		// solc emits metadata as json string
+		var text string
+
+		if json.Unmarshal(metadata, &text) == nil {
+			metadata = []byte(text)
+		}
+
+		var meta artifactMetadata
+
+		if err := json.Unmarshal(metadata, &meta); err == nil {
+			docs = append(docs, meta.Output.UserDoc, meta.Output.DevDoc)
+		}
+	}
+
+	var specs [][]byte
+
+	for _, doc := range docs {
+		if doc != nil && string(doc) != "null" {
+			specs = append(specs, doc)
+		}
+	}
+
+	if len(specs) == 0 {
+		return result, nil
+	}
+
+	doc, err := ParseNatSpec(specs...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.NatSpec = doc
+
+	return result, nil
 }
 
-// Parse json abi
+// Parse json abi array or compiler artifact, the NatSpec userdoc/devdoc and bytecode of artifact
+// are passed to binder implementing DocBinder and BytecodeBinder
 func Parse(name string, data []byte, binder Binder) (abi.Contract, error) {
 
 	contract := &contractImpl{
 		funcs:       make(map[string]*funcABI),
+		events:      make(map[string]*eventABI),
 		constructor: nil,
 	}
 
+	artifact, err := ParseArtifact(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data = artifact.ABI
+
 	var fields []*abi.JSONField
 
-	err := json.Unmarshal(data, &fields)
+	err = json.Unmarshal(data, &fields)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "parse input json abi errorn")
@@ -276,6 +740,14 @@ func Parse(name string, data []byte, binder Binder) (abi.Contract, error) {
 	binder.BeginContract(name, data)
 	defer binder.EndContract()
 
+	if docBinder, ok := binder.(DocBinder); ok && artifact.NatSpec != nil {
+		docBinder.Doc(artifact.NatSpec)
+	}
+
+	if bytecodeBinder, ok := binder.(BytecodeBinder); ok && artifact.Bytecode != "" {
+		bytecodeBinder.Bytecode(artifact.Bytecode, artifact.DeployedBytecode)
+	}
+
 	for i, field := range fields {
 		switch field.Type {
 		case abi.JSONTypeFunc:
@@ -299,7 +771,13 @@ func Parse(name string, data []byte, binder Binder) (abi.Contract, error) {
 		case abi.JSONTypeFallback, abi.JSONTypeReceive:
 			// skip parse fallback receive function
 		case abi.JSONTypeEvent:
-			// TODO: next version support event filter
+			e, err := contract.parseEvent(i, field, binder)
+
+			if err != nil {
+				return nil, err
+			}
+
+			contract.events[hex.EncodeToString(e.topic)] = e
 		default:
 			// Skip others
 
@@ -3,13 +3,20 @@ package binding
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/fixed"
 	"github.com/stretchr/testify/require"
 )
 
@@ -102,6 +109,555 @@ func TestGen(t *testing.T) {
 	ioutil.WriteFile("./testdata/test.go", writerBuffer.Bytes(), 077)
 }
 
+var update = flag.Bool("update", false, "update generated golden files")
+
+// requireGenerated generate contract binding and compare with the compiled golden file
+func requireGenerated(t *testing.T, name string, abiFile string, packageName string, golden string, options ...GenOption) {
+	generator := NewGen(options...)
+
+	_, err := ParseFile(name, abiFile, generator)
+
+	require.NoError(t, err)
+
+	var writerBuffer bytes.Buffer
+
+	require.NoError(t, generator.Write(packageName, &writerBuffer))
+
+	if *update {
+		require.NoError(t, ioutil.WriteFile(golden, writerBuffer.Bytes(), 0644))
+	}
+
+	expect, err := ioutil.ReadFile(golden)
+
+	require.NoError(t, err)
+
+	require.Equal(t, string(expect), writerBuffer.String(), golden)
+}
+
+func TestGenCodec(t *testing.T) {
+	// internal/codectest/vault.go is the compiled and benchmarked output of codec generator
+	requireGenerated(t, "CurveUSDVault", "./testdata/CurveUSDVault.json", "codectest", "./internal/codectest/vault.go", WithCodec())
+}
+
+func TestGenInterfaces(t *testing.T) {
+	// internal/bindtest is the compiled output of the testdata ABIs
+	requireGenerated(t, "IERC20", "./testdata/IERC20.json", "bindtest", "./internal/bindtest/erc20.go")
+	requireGenerated(t, "IPancakeRouter02", "./testdata/IPancakeRouter02.json", "bindtest", "./internal/bindtest/router.go")
+	requireGenerated(t, "CurveUSDVault", "./testdata/CurveUSDVault.json", "bindtest", "./internal/bindtest/vault.go")
+	requireGenerated(t, "NatSpecToken", "./testdata/NatSpecToken.json", "bindtest", "./internal/bindtest/natspec.go")
+}
+
+// requireGeneratedDir write package by WriteDir and compare with the compiled golden dir
+func requireGeneratedDir(t *testing.T, generator *Generator, packageName string, golden string) {
+	if *update {
+		require.NoError(t, generator.WriteDir(packageName, golden))
+	}
+
+	dir, err := ioutil.TempDir("", "binding")
+
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, generator.WriteDir(packageName, dir))
+
+	files, err := ioutil.ReadDir(dir)
+
+	require.NoError(t, err)
+
+	for _, file := range files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+
+		require.NoError(t, err)
+
+		expect, err := ioutil.ReadFile(filepath.Join(golden, file.Name()))
+
+		require.NoError(t, err)
+
+		require.Equal(t, string(expect), string(content), file.Name())
+	}
+}
+
+func TestGenMultiFile(t *testing.T) {
+	generator := NewGen()
+
+	for _, name := range []string{"Exchange", "Settlement"} {
+		_, err := ParseFile(name, "./testdata/"+name+".json", generator)
+
+		require.NoError(t, err)
+	}
+
+	// LibOrder.Order shared by contracts, Settlement.Order conflicts by name
+	order, ok := generator.GetTuple("LibOrder.Order")
+
+	require.True(t, ok)
+
+	require.Equal(t, "Order", order.BindingName)
+
+	settlementOrder, ok := generator.GetTuple("Settlement.Order")
+
+	require.True(t, ok)
+
+	require.Equal(t, "SettlementOrder", settlementOrder.BindingName)
+
+	requireGeneratedDir(t, generator, "multitest", "./internal/multitest")
+
+	manifest := generator.Manifest("github.com/libs4go/ethers/abi/binding/internal/multitest", "multitest")
+
+	if *update {
+		buff, err := json.MarshalIndent(manifest, "", "  ")
+
+		require.NoError(t, err)
+
+		require.NoError(t, ioutil.WriteFile("./internal/multitest/abigen.json", append(buff, '\n'), 0644))
+	}
+
+	expect, err := ParseManifestFile("./internal/multitest/abigen.json")
+
+	require.NoError(t, err)
+
+	require.Equal(t, expect, manifest)
+
+	// import LibOrder.Order from multitest, generate Matcher.Result locally
+	generator = NewGen(WithImport(manifest))
+
+	_, err = ParseFile("Matcher", "./testdata/Matcher.json", generator)
+
+	require.NoError(t, err)
+
+	requireGeneratedDir(t, generator, "importtest", "./internal/importtest")
+}
+
+func TestTupleConflict(t *testing.T) {
+	order := func(contract string, internalType string, second string) string {
+		return fmt.Sprintf(`[{"inputs":[{"components":[{"internalType":"address","name":"maker","type":"address"},{"internalType":"uint256","name":"%s","type":"uint256"}],"internalType":"%s","name":"order","type":"tuple"}],"name":"%s","outputs":[],"stateMutability":"nonpayable","type":"function"}]`, second, internalType, contract)
+	}
+
+	// same identity with different components
+	generator := NewGen()
+
+	_, err := Parse("A", []byte(order("a", "struct LibOrder.Order", "amount")), generator)
+
+	require.NoError(t, err)
+
+	_, err = Parse("B", []byte(order("b", "struct LibOrder.Order", "price")), generator)
+
+	require.True(t, errors.Is(err, ErrConflict))
+
+	// the same name and components declared by different contracts is deduplicated
+	generator = NewGen()
+
+	_, err = Parse("A", []byte(order("a", "struct A.Order", "amount")), generator)
+
+	require.NoError(t, err)
+
+	_, err = Parse("B", []byte(order("b", "struct B.Order", "amount")), generator)
+
+	require.NoError(t, err)
+
+	require.Len(t, generator.localTuples(), 1)
+
+	// unqualified struct can not be renamed
+	generator = NewGen()
+
+	_, err = Parse("A", []byte(order("a", "struct Order", "amount")), generator)
+
+	require.NoError(t, err)
+
+	_, err = Parse("B", []byte(order("b", "struct Order", "price")), generator)
+
+	require.True(t, errors.Is(err, ErrConflict))
+
+	// imported struct with different components
+	generator = NewGen(WithImport(&TypesManifest{
+		Package: "github.com/foo/types",
+		Tuples:  []*ManifestTuple{{ID: "LibOrder.Order", GoName: "Order", Structure: "(address maker,uint256 price)"}},
+	}))
+
+	_, err = Parse("A", []byte(order("a", "struct LibOrder.Order", "amount")), generator)
+
+	require.True(t, errors.Is(err, ErrConflict))
+}
+
 func TestToUpper(t *testing.T) {
 	println(strings.Title("hello world"))
 }
+
+type CurveNFT struct {
+	Id               *big.Int        `abi:"id"`
+	Created          *big.Int        `abi:"created"`
+	Deposit          address.Address `abi:"deposit"`
+	DepositAmount    *big.Int        `abi:"depositAmount"`
+	CommissionAmount *big.Int        `abi:"commissionAmount"`
+}
+
+func newCurveNFT(id int64) *CurveNFT {
+	return &CurveNFT{
+		Id:               big.NewInt(id),
+		Created:          big.NewInt(1620000000 + id),
+		Deposit:          address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		DepositAmount:    big.NewInt(id * 100),
+		CommissionAmount: big.NewInt(id),
+	}
+}
+
+func TestStructTuple(t *testing.T) {
+	contract, err := ParseFile("CurveUSDVault", "./testdata/CurveUSDVault.json", NewSymbols())
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "hello(uint256[][20],(uint256,uint256,address,uint256,uint256)[],(uint256,uint256,address,uint256,uint256)[2][])")
+
+	require.True(t, ok)
+
+	var tokenID [20][]*big.Int
+
+	for i := range tokenID {
+		for j := 0; j < i%3; j++ {
+			tokenID[i] = append(tokenID[i], big.NewInt(int64(i*10+j)))
+		}
+	}
+
+	nft := []*CurveNFT{newCurveNFT(1), newCurveNFT(2)}
+	nfts := [][2]*CurveNFT{{newCurveNFT(3), newCurveNFT(4)}}
+
+	buff, err := f.Call(tokenID, nft, nfts)
+
+	require.NoError(t, err)
+
+	// same as the []interface{} tuple values
+	values := func(nft *CurveNFT) []interface{} {
+		return []interface{}{nft.Id, nft.Created, nft.Deposit, nft.DepositAmount, nft.CommissionAmount}
+	}
+
+	expect, err := f.Call(tokenID, [][]interface{}{values(nft[0]), values(nft[1])}, [][2][]interface{}{{values(nfts[0][0]), values(nfts[0][1])}})
+
+	require.NoError(t, err)
+
+	require.Equal(t, hex.EncodeToString(expect), hex.EncodeToString(buff))
+
+	// decode call data into structs
+	uint256, _ := abi.Builtin("uint256")
+	addressEncoder, _ := abi.Builtin("address")
+
+	nftEncoder, err := abi.NamedTuple("CurveNFT", []string{"id", "created", "deposit", "depositAmount", "commissionAmount"}, uint256, uint256, addressEncoder, uint256, uint256)
+
+	require.NoError(t, err)
+
+	require.True(t, nftEncoder.Static())
+
+	uint256Array, _ := abi.Array(uint256)
+	tokenIDEncoder, _ := abi.FixedArray(uint256Array, 20)
+	nftArray, _ := abi.Array(nftEncoder)
+	nftFixedArray, _ := abi.FixedArray(nftEncoder, 2)
+	nftsArray, _ := abi.Array(nftFixedArray)
+
+	inputs, err := abi.Tuple("inputs", tokenIDEncoder, nftArray, nftsArray)
+
+	require.NoError(t, err)
+
+	var decodedTokenID [20][]*big.Int
+	var decodedNFT []*CurveNFT
+	var decodedNFTs [][2]*CurveNFT
+
+	l, err := inputs.Unmarshal(buff[4:], []interface{}{&decodedTokenID, &decodedNFT, &decodedNFTs})
+
+	require.NoError(t, err)
+	require.Equal(t, uint(len(buff)-4), l)
+
+	for i := range tokenID {
+		if len(tokenID[i]) == 0 {
+			require.Empty(t, decodedTokenID[i])
+		} else {
+			require.Equal(t, tokenID[i], decodedTokenID[i])
+		}
+	}
+
+	require.Equal(t, nft, decodedNFT)
+	require.Equal(t, nfts, decodedNFTs)
+
+	// decode return value into struct
+	f, ok = abi.TryGetFunc(contract, "data(uint256)")
+
+	require.True(t, ok)
+
+	ret, err := nftEncoder.Marshal(newCurveNFT(5))
+
+	require.NoError(t, err)
+
+	var ret0 *CurveNFT
+
+	_, err = f.Return(ret, []interface{}{&ret0})
+
+	require.NoError(t, err)
+
+	require.Equal(t, newCurveNFT(5), ret0)
+}
+
+func TestParseHumanReadable(t *testing.T) {
+	contract, err := ParseHumanReadable("ERC20", []string{
+		"function transfer(address to, uint256 amount) returns (bool)",
+		"function balanceOf(address owner) view returns (uint256)",
+		"function fill((uint256 id, address maker)[] orders)",
+		"event Transfer(address indexed from, address indexed to, uint256 value)",
+	}, NewSymbols())
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "transfer(address,uint256)")
+
+	require.True(t, ok)
+
+	buff, err := f.Call(address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"), big.NewInt(100))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "a9059cbb00000000000000000000000044a347cf7278685320a05cb39e903c42e472e2620000000000000000000000000000000000000000000000000000000000000064", hex.EncodeToString(buff))
+
+	f, ok = abi.TryGetFunc(contract, "fill((uint256,address)[])")
+
+	require.True(t, ok)
+
+	_, err = f.Call([]interface{}{[]interface{}{big.NewInt(1), address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")}})
+
+	require.NoError(t, err)
+}
+
+func TestDecodeCall(t *testing.T) {
+	contract, err := ParseFile("IPancakeRouter02", "./testdata/IPancakeRouter02.json", NewSymbols())
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "swapExactTokensForTokens(uint256,uint256,address[],address,uint256)")
+
+	require.True(t, ok)
+
+	require.Equal(t, "swapExactTokensForTokens", f.Name())
+
+	tokenA := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+	tokenB := address.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+
+	buff, err := f.Call(big.NewInt(1000), big.NewInt(900), []address.Address{tokenA, tokenB}, tokenA, big.NewInt(1700000000))
+
+	require.NoError(t, err)
+
+	call, err := abi.DecodeCallHex(contract, "0x"+hex.EncodeToString(buff))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "swapExactTokensForTokens", call.Name)
+	require.Equal(t, "swapExactTokensForTokens(uint256,uint256,address[],address,uint256)", call.Signature)
+	require.Equal(t, []string{"amountIn", "amountOutMin", "path", "to", "deadline"}, call.Args.Names)
+
+	amountIn, ok := call.Arg("amountIn")
+
+	require.True(t, ok)
+
+	require.Equal(t, "1000", amountIn.String())
+
+	path, ok := call.Arg("path")
+
+	require.True(t, ok)
+
+	require.Equal(t, []interface{}{tokenA, tokenB}, path.Interface())
+
+	require.True(t, strings.HasPrefix(call.String(), call.Signature+"\namountIn uint256: 1000\n"))
+
+	var args struct {
+		AmountIn     *big.Int
+		AmountOutMin *big.Int
+		Path         []address.Address
+		To           address.Address
+		Deadline     *big.Int `abi:"deadline"`
+	}
+
+	l, err := f.DecodeInputs(buff, &args)
+
+	require.NoError(t, err)
+
+	require.Equal(t, uint(len(buff)), l)
+
+	require.Equal(t, []address.Address{tokenA, tokenB}, args.Path)
+
+	require.Equal(t, int64(1700000000), args.Deadline.Int64())
+
+	ret, err := f.EncodeOutputs([]*big.Int{big.NewInt(1000), big.NewInt(950)})
+
+	require.NoError(t, err)
+
+	var amounts []*big.Int
+
+	_, err = f.Return(ret, []interface{}{&amounts})
+
+	require.NoError(t, err)
+
+	require.Equal(t, []*big.Int{big.NewInt(1000), big.NewInt(950)}, amounts)
+
+	_, err = contract.DecodeCall([]byte{0xde, 0xad, 0xbe, 0xef})
+
+	require.Error(t, err)
+
+	_, err = contract.DecodeCall(buff[:3])
+
+	require.Error(t, err)
+
+	_, err = contract.DecodeCall(buff[:40])
+
+	require.Error(t, err)
+
+	_, err = f.DecodeInputs(append([]byte{0, 0, 0, 0}, buff[4:]...), &args)
+
+	require.Error(t, err)
+}
+
+// aliasedArray encode nested dynamic arrays of depth, whose elements all alias the same content,
+// the reflective decoding without validation visits width^depth elements
+func aliasedArray(depth int, width int) []byte {
+	var buff []byte
+
+	word := func(n int) {
+		buff = append(buff, big.NewInt(int64(n)).FillBytes(make([]byte, 32))...)
+	}
+
+	for i := 0; i < depth; i++ {
+		word(width)
+
+		for j := 0; j < width; j++ {
+			word(32 * width)
+		}
+	}
+
+	word(width)
+
+	for j := 0; j < width; j++ {
+		word(j)
+	}
+
+	return buff
+}
+
+func TestDecodeAliased(t *testing.T) {
+	contract, err := ParseHumanReadable("Aliased", []string{
+		"function nested(uint256[][][][][][][][][] values) view returns (uint256[][][][][][][][][])",
+	}, NewSymbols())
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "nested(uint256[][][][][][][][][])")
+
+	require.True(t, ok)
+
+	data := append(big.NewInt(32).FillBytes(make([]byte, 32)), aliasedArray(8, 16)...)
+
+	var values abi.Value
+
+	_, err = f.Return(data, &values)
+
+	require.True(t, errors.Is(err, abi.ErrLength))
+
+	_, err = contract.DecodeCall(append(f.Selector(), data...))
+
+	require.True(t, errors.Is(err, abi.ErrLength))
+
+	_, err = f.DecodeInputs(append(f.Selector(), data...), &values)
+
+	require.True(t, errors.Is(err, abi.ErrLength))
+}
+
+func TestGenStrict(t *testing.T) {
+	generator := NewGen(WithCodec(), WithStrict())
+
+	_, err := ParseFile("IERC20", "./testdata/IERC20.json", generator)
+
+	require.NoError(t, err)
+
+	var writerBuffer bytes.Buffer
+
+	require.NoError(t, generator.Write("bindtest", &writerBuffer))
+
+	require.Contains(t, writerBuffer.String(), `f, ok := impl.Contract.Select("70a08231")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = packIERC20BalanceOf(account)`)
+
+	require.Contains(t, writerBuffer.String(), `if err = binding.ValidateReturn(f, buff); err != nil {
+		return
+	}
+
+	ret0, err = unpackIERC20BalanceOf(buff)`)
+
+	contract, err := ParseFile("IERC20", "./testdata/IERC20.json", NewSymbols())
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "balanceOf(address)")
+
+	require.True(t, ok)
+
+	require.NoError(t, ValidateReturn(f, big.NewInt(1).FillBytes(make([]byte, 32))))
+	require.True(t, errors.Is(ValidateReturn(f, []byte{1}), abi.ErrLength))
+}
+
+func TestFixedParam(t *testing.T) {
+	data := `[{"inputs":[{"internalType":"ufixed","name":"rate","type":"ufixed"},{"internalType":"fixed64x10[]","name":"deltas","type":"fixed64x10[]"}],"name":"set","outputs":[{"internalType":"fixed","name":"","type":"fixed"}],"stateMutability":"view","type":"function"}]`
+
+	generator := NewGen()
+
+	contract, err := Parse("Rates", []byte(data), generator)
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "set(ufixed128x18,fixed64x10[])")
+
+	require.True(t, ok)
+
+	_, err = f.Call(1.25, []*fixed.Number{{RawValue: big.NewInt(-5), Decimals: 10}})
+
+	require.NoError(t, err)
+
+	var writerBuffer bytes.Buffer
+
+	require.NoError(t, generator.Write("testdata", &writerBuffer))
+
+	code := writerBuffer.String()
+
+	require.Contains(t, code, `"github.com/libs4go/fixed"`)
+
+	require.Contains(t, code, "Set(ctx context.Context, rate *fixed.Number, deltas []*fixed.Number) (ret0 *fixed.Number, err error)")
+}
+
+func TestArtifactBytecode(t *testing.T) {
+	// hardhat artifact carries hex string, foundry artifact carries {"object": "0x..."}
+	hardhat, err := ParseArtifact([]byte(`{"abi": [], "bytecode": "0x6080", "deployedBytecode": "0x"}`))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "0x6080", hardhat.Bytecode)
+	require.Equal(t, "", hardhat.DeployedBytecode)
+
+	foundry, err := ParseArtifact([]byte(`{"abi": [], "bytecode": {"object": "0x6080"}, "deployedBytecode": {"object": "6000"}}`))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "0x6080", foundry.Bytecode)
+	require.Equal(t, "0x6000", foundry.DeployedBytecode)
+
+	generator := NewGen()
+
+	_, err = Parse("Empty", []byte(`{"abi": [], "evm": {"bytecode": {"object": "6080"}, "deployedBytecode": {"object": "6000"}}}`), generator)
+
+	require.NoError(t, err)
+
+	var writerBuffer bytes.Buffer
+
+	require.NoError(t, generator.Write("bindtest", &writerBuffer))
+
+	require.Contains(t, writerBuffer.String(), `const EmptyBytecode = "0x6080"`)
+	require.Contains(t, writerBuffer.String(), `const EmptyDeployedBytecode = "0x6000"`)
+}
@@ -0,0 +1,471 @@
+package binding
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/libs4go/ethers/abi"
+)
+
+// typeInfo abi type tree node collected by abi.ElementaryVisitor
+type typeInfo struct {
+	kind  string
+	sign  bool
+	bits  uint
+	M     uint
+	N     uint
+	len   uint
+	elem  abi.Encoder
+	name  string
+	elems []abi.Encoder
+}
+
+func (info *typeInfo) HandleInt(sign bool, bits uint) {
+	info.kind, info.sign, info.bits = "int", sign, bits
+}
+
+func (info *typeInfo) HandleAddress() {
+	info.kind = "address"
+}
+
+func (info *typeInfo) HandleBool() {
+	info.kind = "bool"
+}
+
+func (info *typeInfo) HandleFixed(sign bool, M, N uint) {
+	info.kind, info.sign, info.M, info.N = "fixed", sign, M, N
+}
+
+func (info *typeInfo) HandleFixedBytes(len uint) {
+	info.kind, info.len = "fixedBytes", len
+}
+
+func (info *typeInfo) HandleFixedArray(len uint, elem abi.Encoder) {
+	info.kind, info.len, info.elem = "fixedArray", len, elem
+}
+
+func (info *typeInfo) HandleBytes() {
+	info.kind = "bytes"
+}
+
+func (info *typeInfo) HandleArray(elem abi.Encoder) {
+	info.kind, info.elem = "array", elem
+}
+
+func (info *typeInfo) HandleString() {
+	info.kind = "string"
+}
+
+func (info *typeInfo) HandleTuple(name string, elems []abi.Encoder) {
+	info.kind, info.name, info.elems = "tuple", name, elems
+}
+
+var _ abi.ElementaryVisitor = (*typeInfo)(nil)
+
+func typeOf(encoder abi.Encoder) *typeInfo {
+	info := &typeInfo{}
+
+	encoder.Accept(info)
+
+	return info
+}
+
+// typeName returns go identifier of abi type, used as the name suffix of generated codec helpers
+func typeName(encoder abi.Encoder) string {
+	info := typeOf(encoder)
+
+	switch info.kind {
+	case "int":
+		if info.sign {
+			return fmt.Sprintf("Int%d", info.bits)
+		}
+
+		return fmt.Sprintf("Uint%d", info.bits)
+	case "fixed":
+		if info.sign {
+			return fmt.Sprintf("Fixed%dx%d", info.M, info.N)
+		}
+
+		return fmt.Sprintf("Ufixed%dx%d", info.M, info.N)
+	case "fixedBytes":
+		return fmt.Sprintf("Bytes%d", info.len)
+	case "fixedArray":
+		return fmt.Sprintf("%sArray%d", typeName(info.elem), info.len)
+	case "array":
+		return fmt.Sprintf("%sSlice", typeName(info.elem))
+	case "tuple":
+		// imported tuple is qualified by package name, e.g. types.Order -> TypesOrder
+		var parts []string
+
+		for _, part := range strings.Split(info.name, ".") {
+			parts = append(parts, strings.Title(part))
+		}
+
+		return strings.Join(parts, "")
+	default:
+		return strings.Title(info.kind)
+	}
+}
+
+// codecItem value expression of head/tail encoded sequence
+type codecItem struct {
+	encoder abi.Encoder
+	expr    string
+}
+
+// codecGen zero-reflection abi codec source generator
+type codecGen struct {
+	tuples  []*Tuple
+	helpers map[string]string
+}
+
+func newCodecGen(tuples []*Tuple) *codecGen {
+	return &codecGen{
+		tuples:  tuples,
+		helpers: make(map[string]string),
+	}
+}
+
+// appendStmt generate statements to append value expr to buff
+func (gen *codecGen) appendStmt(w *strings.Builder, encoder abi.Encoder, expr string, ret string) {
+	info := typeOf(encoder)
+
+	switch info.kind {
+	case "int":
+		fmt.Fprintf(w, "buff, err = abi.AppendInt(buff, %s, %t, %d)\n", expr, info.sign, info.bits)
+	case "address":
+		fmt.Fprintf(w, "buff, err = abi.AppendAddress(buff, %s)\n", expr)
+	case "bool":
+		fmt.Fprintf(w, "buff, err = abi.AppendBool(buff, %s)\n", expr)
+	case "fixed":
+		fmt.Fprintf(w, "buff, err = abi.AppendFixed(buff, %s, %t, %d, %d)\n", expr, info.sign, info.M, info.N)
+	case "fixedBytes":
+		fmt.Fprintf(w, "buff, err = abi.AppendFixedBytes(buff, %s[:])\n", expr)
+	case "bytes":
+		fmt.Fprintf(w, "buff, err = abi.AppendBytes(buff, %s)\n", expr)
+	case "string":
+		fmt.Fprintf(w, "buff, err = abi.AppendString(buff, %s)\n", expr)
+	case "tuple":
+		fmt.Fprintf(w, "buff, err = %s.AppendABI(buff)\n", expr)
+	default:
+		gen.arrayHelpers(encoder)
+		fmt.Fprintf(w, "buff, err = append%s(buff, %s)\n", typeName(encoder), expr)
+	}
+
+	fmt.Fprintf(w, "if err != nil {\n%s\n}\n", ret)
+}
+
+// decodeStmt generate statements to decode value from data expr into target, the consumed length is set to l
+func (gen *codecGen) decodeStmt(w *strings.Builder, encoder abi.Encoder, target string, data string, ret string) {
+	info := typeOf(encoder)
+
+	switch info.kind {
+	case "int":
+		fmt.Fprintf(w, "%s, l, err = abi.DecodeInt(%s, %t, %d)\n", target, data, info.sign, info.bits)
+	case "address":
+		fmt.Fprintf(w, "%s, l, err = abi.DecodeAddress(%s)\n", target, data)
+	case "bool":
+		fmt.Fprintf(w, "%s, l, err = abi.DecodeBool(%s)\n", target, data)
+	case "fixed":
+		fmt.Fprintf(w, "%s, l, err = abi.DecodeFixed(%s, %t, %d, %d)\n", target, data, info.sign, info.M, info.N)
+	case "fixedBytes":
+		fmt.Fprintf(w, "l, err = abi.DecodeFixedBytes(%s, %s[:])\n", data, target)
+	case "bytes":
+		fmt.Fprintf(w, "%s, l, err = abi.DecodeBytes(%s)\n", target, data)
+	case "string":
+		fmt.Fprintf(w, "%s, l, err = abi.DecodeString(%s)\n", target, data)
+	case "tuple":
+		fmt.Fprintf(w, "%s = new(%s)\n", target, info.name)
+		fmt.Fprintf(w, "l, err = %s.UnmarshalABI(%s)\n", target, data)
+	default:
+		gen.arrayHelpers(encoder)
+		fmt.Fprintf(w, "%s, l, err = decode%s(%s)\n", target, typeName(encoder), data)
+	}
+
+	fmt.Fprintf(w, "if err != nil {\n%s\n}\n", ret)
+}
+
+// appendSequence generate statements to append head/tail encoded items to buff
+func (gen *codecGen) appendSequence(w *strings.Builder, items []*codecItem, ret string) {
+	var dynamic []int
+
+	for i, item := range items {
+		if !item.encoder.Static() {
+			dynamic = append(dynamic, i)
+		}
+	}
+
+	if len(dynamic) > 0 {
+		w.WriteString("start := len(buff)\n")
+	}
+
+	for i, item := range items {
+		if item.encoder.Static() {
+			gen.appendStmt(w, item.encoder, item.expr, ret)
+			continue
+		}
+
+		fmt.Fprintf(w, "head%d := len(buff)\n", i)
+		w.WriteString("buff = append(buff, make([]byte, 32)...)\n")
+	}
+
+	for _, i := range dynamic {
+		fmt.Fprintf(w, "abi.PutOffset(buff[head%d:], len(buff)-start)\n", i)
+		gen.appendStmt(w, items[i].encoder, items[i].expr, ret)
+	}
+}
+
+// decodeSequence generate statements to decode head/tail encoded items from data,
+// the consumed length is set to end
+func (gen *codecGen) decodeSequence(w *strings.Builder, items []*codecItem, ret string) {
+	w.WriteString("offset := uint(0)\n")
+	w.WriteString("end := uint(0)\n")
+
+	if len(items) > 0 {
+		w.WriteString("var l uint\n")
+	}
+
+	for _, item := range items {
+		if !item.encoder.Static() {
+			w.WriteString("var pos uint\n")
+			break
+		}
+	}
+
+	for _, item := range items {
+		if item.encoder.Static() {
+			gen.decodeStmt(w, item.encoder, item.expr, "data[offset:]", ret)
+			w.WriteString("offset += l\n")
+			continue
+		}
+
+		w.WriteString("pos, err = abi.DecodeOffset(data, offset)\n")
+		fmt.Fprintf(w, "if err != nil {\n%s\n}\n", ret)
+		gen.decodeStmt(w, item.encoder, item.expr, "data[pos:]", ret)
+		w.WriteString("if pos+l > end {\nend = pos + l\n}\n")
+		w.WriteString("offset += 32\n")
+	}
+
+	w.WriteString("if offset > end {\nend = offset\n}\n")
+}
+
+// arrayHelpers generate append and decode helpers of fixed array or array type
+func (gen *codecGen) arrayHelpers(encoder abi.Encoder) {
+	name := typeName(encoder)
+
+	if _, ok := gen.helpers[name]; ok {
+		return
+	}
+
+	// placeholder for recursive generating
+	gen.helpers[name] = ""
+
+	info := typeOf(encoder)
+
+	var w strings.Builder
+
+	fmt.Fprintf(&w, "// append%s append abi encoding of %s, generated zero-reflection codec\n", name, encoder)
+	fmt.Fprintf(&w, "func append%s(buff []byte, v %s) ([]byte, error) {\n", name, encoder.GoTypeName())
+	w.WriteString("var err error\n")
+
+	if info.kind == "array" {
+		w.WriteString("buff = abi.AppendLength(buff, len(v))\n")
+	}
+
+	if info.elem.Static() {
+		w.WriteString("for i := range v {\n")
+		gen.appendStmt(&w, info.elem, "v[i]", "return nil, err")
+		w.WriteString("}\n")
+	} else {
+		w.WriteString("start := len(buff)\n")
+		w.WriteString("buff = append(buff, make([]byte, 32*len(v))...)\n")
+		w.WriteString("for i := range v {\n")
+		w.WriteString("abi.PutOffset(buff[start+32*i:], len(buff)-start)\n")
+		gen.appendStmt(&w, info.elem, "v[i]", "return nil, err")
+		w.WriteString("}\n")
+	}
+
+	w.WriteString("return buff, nil\n}\n\n")
+
+	fmt.Fprintf(&w, "// decode%s decode abi encoding of %s, generated zero-reflection codec\n", name, encoder)
+	fmt.Fprintf(&w, "func decode%s(data []byte) (v %s, n uint, err error) {\n", name, encoder.GoTypeName())
+
+	if info.kind == "array" {
+		w.WriteString("var size uint\n")
+		w.WriteString("size, err = abi.DecodeLength(data, 32)\n")
+		w.WriteString("if err != nil {\nreturn\n}\n")
+		fmt.Fprintf(&w, "v = make(%s, size)\n", encoder.GoTypeName())
+		w.WriteString("data = data[32:]\n")
+	}
+
+	w.WriteString("var l uint\n")
+
+	if info.elem.Static() {
+		w.WriteString("for i := range v {\n")
+		gen.decodeStmt(&w, info.elem, "v[i]", "data[n:]", "return")
+		w.WriteString("n += l\n")
+		w.WriteString("}\n")
+	} else {
+		w.WriteString("n = uint(32 * len(v))\n")
+		w.WriteString("if n > uint(len(data)) {\nerr = errors.Wrap(abi.ErrLength, \"abi data too short\")\nreturn\n}\n")
+		w.WriteString("var pos uint\n")
+		w.WriteString("for i := range v {\n")
+		w.WriteString("pos, err = abi.DecodeOffset(data, uint(32*i))\n")
+		w.WriteString("if err != nil {\nreturn\n}\n")
+		gen.decodeStmt(&w, info.elem, "v[i]", "data[pos:]", "return")
+		w.WriteString("if pos+l > n {\nn = pos + l\n}\n")
+		w.WriteString("}\n")
+	}
+
+	if info.kind == "array" {
+		w.WriteString("n += 32\n")
+	}
+
+	w.WriteString("return\n}\n\n")
+
+	gen.helpers[name] = w.String()
+}
+
+// tupleMethods generate AppendABI, MarshalABI and UnmarshalABI methods of tuple struct
+func (gen *codecGen) tupleMethods(w *strings.Builder, tuple *Tuple) {
+	info := typeOf(tuple.Encoder)
+
+	var items []*codecItem
+
+	for i, elem := range info.elems {
+		items = append(items, &codecItem{
+			encoder: elem,
+			expr:    "t." + tuple.BindingFields[i].Name,
+		})
+	}
+
+	name := tuple.BindingName
+
+	fmt.Fprintf(w, "// AppendABI append abi encoding of %s to buff, generated zero-reflection codec\n", name)
+	fmt.Fprintf(w, "func (t *%s) AppendABI(buff []byte) ([]byte, error) {\n", name)
+	fmt.Fprintf(w, "if t == nil {\nreturn nil, errors.Wrap(abi.ErrValue, \"nil %s\")\n}\n", name)
+	w.WriteString("var err error\n")
+	gen.appendSequence(w, items, "return nil, err")
+	w.WriteString("return buff, nil\n}\n\n")
+
+	fmt.Fprintf(w, "// MarshalABI returns abi encoding of %s, generated zero-reflection codec\n", name)
+	fmt.Fprintf(w, "func (t *%s) MarshalABI() ([]byte, error) {\n", name)
+	fmt.Fprintf(w, "return t.AppendABI(make([]byte, 0, %d))\n}\n\n", codecBufferSize(items))
+
+	fmt.Fprintf(w, "// UnmarshalABI decode abi encoding of %s, generated zero-reflection codec\n", name)
+	fmt.Fprintf(w, "func (t *%s) UnmarshalABI(data []byte) (uint, error) {\n", name)
+	w.WriteString("var err error\n")
+	gen.decodeSequence(w, items, "return 0, err")
+	w.WriteString("return end, nil\n}\n\n")
+}
+
+// codecBufferSize returns the preallocated buffer size, head size plus 32 bytes per dynamic item
+func codecBufferSize(items []*codecItem) int {
+	size := 0
+
+	for _, item := range items {
+		size += 32
+
+		if !item.encoder.Static() {
+			size += 32
+		}
+
+		info := typeOf(item.encoder)
+
+		if info.kind == "fixedArray" && item.encoder.Static() {
+			size += 32 * (int(info.len) - 1)
+		}
+	}
+
+	return size
+}
+
+// funcCodec generate pack and unpack functions of contract function
+func (gen *codecGen) funcCodec(w *strings.Builder, name string, f *Func) {
+	var items []*codecItem
+	var params []string
+
+	for i, input := range f.inputEncoders {
+		items = append(items, &codecItem{encoder: input, expr: fmt.Sprintf("p%d", i)})
+		params = append(params, fmt.Sprintf("p%d %s", i, input.GoTypeName()))
+	}
+
+	selector := ""
+
+	for i := 0; i < len(f.Selector); i += 2 {
+		selector += fmt.Sprintf("0x%s, ", f.Selector[i:i+2])
+	}
+
+	fmt.Fprintf(w, "// pack%s abi encode call data, generated zero-reflection codec\n", name)
+	fmt.Fprintf(w, "func pack%s(%s) ([]byte, error) {\n", name, strings.Join(params, ", "))
+	fmt.Fprintf(w, "buff := append(make([]byte, 0, %d), %s)\n", 4+codecBufferSize(items), selector)
+
+	if len(items) > 0 {
+		w.WriteString("var err error\n")
+	}
+
+	gen.appendSequence(w, items, "return nil, err")
+	w.WriteString("return buff, nil\n}\n\n")
+
+	if !f.ReadOnly {
+		return
+	}
+
+	items = nil
+
+	var results []string
+
+	for i, output := range f.outputEncoders {
+		items = append(items, &codecItem{encoder: output, expr: fmt.Sprintf("r%d", i)})
+		results = append(results, fmt.Sprintf("r%d %s", i, output.GoTypeName()))
+	}
+
+	results = append(results, "err error")
+
+	fmt.Fprintf(w, "// unpack%s abi decode return data, generated zero-reflection codec\n", name)
+	fmt.Fprintf(w, "func unpack%s(data []byte) (%s) {\n", name, strings.Join(results, ", "))
+	gen.decodeSequence(w, items, "return")
+	w.WriteString("return\n}\n\n")
+}
+
+// tupleSource generate codec methods of local tuples
+func (gen *codecGen) tupleSource() string {
+	var w strings.Builder
+
+	for _, tuple := range gen.tuples {
+		gen.tupleMethods(&w, tuple)
+	}
+
+	return w.String()
+}
+
+// funcSource generate pack/unpack functions of contract
+func (gen *codecGen) funcSource(contract *Contract) string {
+	var w strings.Builder
+
+	for _, f := range contract.Funcs {
+		gen.funcCodec(&w, contract.Name+f.Name, f)
+	}
+
+	return w.String()
+}
+
+// helperSource generate array helpers used by the generated tuples and functions
+func (gen *codecGen) helperSource() string {
+	var w strings.Builder
+
+	var helpers []string
+
+	for name := range gen.helpers {
+		helpers = append(helpers, name)
+	}
+
+	sort.Strings(helpers)
+
+	for _, name := range helpers {
+		w.WriteString(gen.helpers[name])
+	}
+
+	return w.String()
+}
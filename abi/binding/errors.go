@@ -7,5 +7,8 @@ const errVendor = "ethers-abi-binding"
 
 // errors
 var (
-	ErrBinding = errors.New("Binding internal error", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrBinding  = errors.New("Binding internal error", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrMock     = errors.New("Mock func not stubbed", errors.WithVendor(errVendor), errors.WithCode(-2))
+	ErrNaming   = errors.New("Binding naming error", errors.WithVendor(errVendor), errors.WithCode(-3))
+	ErrConflict = errors.New("Binding struct conflict", errors.WithVendor(errVendor), errors.WithCode(-4))
 )
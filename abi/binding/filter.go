@@ -0,0 +1,468 @@
+package binding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/client"
+)
+
+// Default options of event filterers and watchers
+const (
+	DefaultPageSize     = 5000            // blocks per eth_getLogs request
+	DefaultPollInterval = 4 * time.Second // eth_getLogs polling interval of watcher without subscription
+	DefaultReorgDepth   = 12              // blocks rechecked by polling watcher to detect removed logs
+)
+
+// FilterOpts options of generated Filter<Event> methods
+type FilterOpts struct {
+	FromBlock uint64  // first block of range
+	ToBlock   *uint64 // last block of range, nil is the latest block
+	PageSize  uint64  // blocks per eth_getLogs request, 0 is DefaultPageSize
+}
+
+// WatchOpts options of generated Watch<Event> methods
+type WatchOpts struct {
+	FromBlock    *uint64       // replay logs since block before streaming new logs, nil streams new logs only
+	PollInterval time.Duration // polling interval if provider is not client.Subscriber, 0 is DefaultPollInterval
+	ReorgDepth   *uint64       // blocks rechecked by polling to detect removed logs, nil is DefaultReorgDepth
+}
+
+// LogQuery returns the filter query of contract logs matching topics
+func LogQuery(contract string, topics [][]string) *client.FilterQuery {
+	return &client.FilterQuery{
+		Addresses: []string{contract},
+		Topics:    topics,
+	}
+}
+
+func blockHex(number uint64) string {
+	return fmt.Sprintf("0x%x", number)
+}
+
+func logBlockNumber(log *client.Log) (uint64, error) {
+	number, err := strconv.ParseUint(strings.TrimPrefix(log.BlockNumber, "0x"), 16, 64)
+
+	if err != nil {
+		return 0, errors.Wrap(ErrBinding, "invalid log block number %s", log.BlockNumber)
+	}
+
+	return number, nil
+}
+
+// FilterLogs page through eth_getLogs of query over the block range of opts, the page size is
+// halved and the page is retried if the provider rejects the request, e.g. too many results
+func FilterLogs(ctx context.Context, provider client.Provider, query *client.FilterQuery, opts *FilterOpts) ([]*client.Log, error) {
+	if opts == nil {
+		opts = &FilterOpts{}
+	}
+
+	var to uint64
+
+	if opts.ToBlock != nil {
+		to = *opts.ToBlock
+	} else {
+		latest, err := provider.BlockNumber(ctx)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "get latest block number error")
+		}
+
+		to = latest
+	}
+
+	pageSize := opts.PageSize
+
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return filterRange(ctx, provider, query, opts.FromBlock, to, pageSize)
+}
+
+func filterRange(ctx context.Context, provider client.Provider, query *client.FilterQuery, from uint64, to uint64, pageSize uint64) ([]*client.Log, error) {
+	var logs []*client.Log
+
+	for start := from; start <= to; {
+		end := to
+
+		if end-start >= pageSize {
+			end = start + pageSize - 1
+		}
+
+		page := *query
+
+		page.FromBlock = blockHex(start)
+		page.ToBlock = blockHex(end)
+
+		result, err := provider.GetLogs(ctx, &page)
+
+		if err != nil {
+			if pageSize == 1 || ctx.Err() != nil {
+				return nil, errors.Wrap(err, "get logs of blocks [%d, %d] error", start, end)
+			}
+
+			pageSize /= 2
+
+			continue
+		}
+
+		logs = append(logs, result...)
+
+		if end == to {
+			break
+		}
+
+		start = end + 1
+	}
+
+	return logs, nil
+}
+
+// LogHandler handle log of watcher, returning error terminates the watcher
+type LogHandler func(ctx context.Context, log *client.Log) error
+
+type watchSubscription struct {
+	cancel context.CancelFunc
+	err    chan error
+}
+
+func (sub *watchSubscription) Unsubscribe() {
+	sub.cancel()
+}
+
+func (sub *watchSubscription) Err() <-chan error {
+	return sub.err
+}
+
+// fail send the error terminating the watcher, the error caused by unsubscribe is dropped
+func (sub *watchSubscription) fail(ctx context.Context, err error) {
+	if ctx.Err() == nil {
+		sub.err <- err
+	}
+}
+
+// WatchLogs stream logs of query to handler, the logs are streamed by eth_subscribe if provider
+// implements client.Subscriber, otherwise by eth_getLogs polling. The removed logs of chain
+// reorganization are delivered with Removed flag, which are detected by rechecking the last
+// ReorgDepth blocks while polling
+func WatchLogs(ctx context.Context, provider client.Provider, query *client.FilterQuery, opts *WatchOpts, handler LogHandler) (client.Subscription, error) {
+	if opts == nil {
+		opts = &WatchOpts{}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	sub := &watchSubscription{
+		cancel: cancel,
+		err:    make(chan error, 1),
+	}
+
+	if subscriber, ok := provider.(client.Subscriber); ok {
+		logs := make(chan *client.Log, 128)
+
+		// subscribe before replay, the replayed logs are skipped by subscription
+		inner, err := subscriber.SubscribeLogs(ctx, query, logs)
+
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		go sub.subscribe(ctx, provider, query, opts, inner, logs, handler)
+
+		return sub, nil
+	}
+
+	start, err := provider.BlockNumber(ctx)
+
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "get latest block number error")
+	}
+
+	// new logs only
+	start++
+
+	if opts.FromBlock != nil {
+		start = *opts.FromBlock
+	}
+
+	go sub.poll(ctx, provider, query, opts, start, handler)
+
+	return sub, nil
+}
+
+func (sub *watchSubscription) subscribe(ctx context.Context, provider client.Provider, query *client.FilterQuery, opts *WatchOpts, inner client.Subscription, logs <-chan *client.Log, handler LogHandler) {
+	defer close(sub.err)
+	defer inner.Unsubscribe()
+
+	var replayed uint64
+
+	// the replayed logs are delivered by subscription too
+	replayedLogs := make(map[string]bool)
+
+	duplicated := func(log *client.Log) bool {
+		if log.Removed || replayed == 0 {
+			return false
+		}
+
+		if replayedLogs[logKey(log)] {
+			return true
+		}
+
+		number, err := logBlockNumber(log)
+
+		return err == nil && number < replayed
+	}
+
+	if opts.FromBlock != nil {
+		// drain the subscription while replaying, otherwise the live logs overflow the notification
+		// queue and the subscription is closed
+		drain := drainLogs(ctx, logs)
+
+		head, err := provider.BlockNumber(ctx)
+
+		if err != nil {
+			drain()
+			sub.fail(ctx, errors.Wrap(err, "get latest block number error"))
+			return
+		}
+
+		history, err := filterRange(ctx, provider, query, *opts.FromBlock, head, DefaultPageSize)
+
+		if err != nil {
+			drain()
+			sub.fail(ctx, err)
+			return
+		}
+
+		for _, log := range history {
+			if err := handler(ctx, log); err != nil {
+				drain()
+				sub.fail(ctx, err)
+				return
+			}
+
+			replayedLogs[logKey(log)] = true
+		}
+
+		replayed = head + 1
+
+		for _, log := range drain() {
+			if duplicated(log) {
+				continue
+			}
+
+			if err := handler(ctx, log); err != nil {
+				sub.fail(ctx, err)
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-inner.Err():
+			if ok && err != nil {
+				sub.fail(ctx, err)
+			}
+
+			return
+		case log := <-logs:
+			if duplicated(log) {
+				continue
+			}
+
+			if err := handler(ctx, log); err != nil {
+				sub.fail(ctx, err)
+				return
+			}
+		}
+	}
+}
+
+// drainLogs buffer logs in background until the returned func is called, which returns the buffered logs
+func drainLogs(ctx context.Context, logs <-chan *client.Log) func() []*client.Log {
+	var buffered []*client.Log
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-stop:
+				return
+			case log := <-logs:
+				buffered = append(buffered, log)
+			}
+		}
+	}()
+
+	return func() []*client.Log {
+		close(stop)
+		<-done
+
+		return buffered
+	}
+}
+
+// logKey identity of log in block
+func logKey(log *client.Log) string {
+	return strings.ToLower(log.BlockHash) + ":" + strings.ToLower(log.LogIndex)
+}
+
+func (sub *watchSubscription) poll(ctx context.Context, provider client.Provider, query *client.FilterQuery, opts *WatchOpts, start uint64, handler LogHandler) {
+	defer close(sub.err)
+
+	interval := opts.PollInterval
+
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+
+	depth := uint64(DefaultReorgDepth)
+
+	if opts.ReorgDepth != nil {
+		depth = *opts.ReorgDepth
+	}
+
+	// delivered logs of the recheck window
+	recent := make(map[string]*client.Log)
+
+	next := start
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		head, err := provider.BlockNumber(ctx)
+
+		if err != nil {
+			sub.fail(ctx, errors.Wrap(err, "get latest block number error"))
+			return
+		}
+
+		if head+1 >= next {
+			from := next
+
+			if head+1 >= depth && head+1-depth < from {
+				from = head + 1 - depth
+			}
+
+			if from < start {
+				from = start
+			}
+
+			if err := sub.recheck(ctx, provider, query, from, head, recent, handler); err != nil {
+				sub.fail(ctx, err)
+				return
+			}
+
+			next = head + 1
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recheck query logs of blocks [from, to], the delivered logs missing from result are delivered
+// as removed, and the new logs are delivered
+func (sub *watchSubscription) recheck(ctx context.Context, provider client.Provider, query *client.FilterQuery, from uint64, to uint64, recent map[string]*client.Log, handler LogHandler) error {
+	if from > to {
+		return nil
+	}
+
+	logs, err := filterRange(ctx, provider, query, from, to, DefaultPageSize)
+
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]bool)
+
+	for _, log := range logs {
+		current[logKey(log)] = true
+	}
+
+	var removed []*client.Log
+
+	for key, log := range recent {
+		number, err := logBlockNumber(log)
+
+		if err != nil || number < from {
+			delete(recent, key)
+			continue
+		}
+
+		if !current[key] {
+			delete(recent, key)
+
+			copied := *log
+			copied.Removed = true
+
+			removed = append(removed, &copied)
+		}
+	}
+
+	sortLogs(removed)
+
+	for _, log := range removed {
+		if err := handler(ctx, log); err != nil {
+			return err
+		}
+	}
+
+	for _, log := range logs {
+		key := logKey(log)
+
+		if _, ok := recent[key]; ok {
+			continue
+		}
+
+		recent[key] = log
+
+		if err := handler(ctx, log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sortLogs sort logs by block number and log index
+func sortLogs(logs []*client.Log) {
+	position := func(log *client.Log) (uint64, uint64) {
+		number, _ := logBlockNumber(log)
+		index, _ := strconv.ParseUint(strings.TrimPrefix(log.LogIndex, "0x"), 16, 64)
+
+		return number, index
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		ni, ii := position(logs[i])
+		nj, ij := position(logs[j])
+
+		if ni != nj {
+			return ni < nj
+		}
+
+		return ii < ij
+	})
+}
@@ -0,0 +1,335 @@
+package binding
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/ethers/internal/keccak"
+	"github.com/stretchr/testify/require"
+)
+
+var eventFragments = []string{
+	"event Transfer(address indexed from, address indexed to, uint256 value)",
+	"event Named(string indexed name, bytes data)",
+	"event Anon(uint256 indexed id) anonymous",
+}
+
+var (
+	alice        = address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+	bob          = address.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+	tokenAddress = "0x0000000000000000000000000000000000001234"
+)
+
+func hexTopic(buff []byte) string {
+	return "0x" + hex.EncodeToString(buff)
+}
+
+func addressTopic(addr address.Address) string {
+	return hexTopic(append(make([]byte, 12), addr[:]...))
+}
+
+func transferLog(t *testing.T, block uint64, index uint64, from address.Address, to address.Address, value int64) *client.Log {
+	data, err := abi.Integer(false, 256)
+
+	require.NoError(t, err)
+
+	buff, err := data.Marshal(big.NewInt(value))
+
+	require.NoError(t, err)
+
+	return &client.Log{
+		Address:     tokenAddress,
+		Topics:      []string{hexTopic(keccak.Hash([]byte("Transfer(address,address,uint256)"))), addressTopic(from), addressTopic(to)},
+		Data:        hexTopic(buff),
+		BlockNumber: fmt.Sprintf("0x%x", block),
+		BlockHash:   fmt.Sprintf("0x%064x", block),
+		LogIndex:    fmt.Sprintf("0x%x", index),
+	}
+}
+
+func TestEventTopics(t *testing.T) {
+	contract, err := ParseHumanReadable("Events", eventFragments, NewSymbols())
+
+	require.NoError(t, err)
+
+	transfer, ok := abi.TryGetEvent(contract, "Transfer(address,address,uint256)")
+
+	require.True(t, ok)
+
+	// OR-set of from, any to
+	topics, err := transfer.FilterTopics([]address.Address{alice, bob}, nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, [][]string{
+		{hexTopic(transfer.Topic())},
+		{addressTopic(alice), addressTopic(bob)},
+	}, topics)
+
+	_, err = transfer.FilterTopics(alice)
+
+	require.True(t, errors.Is(err, abi.ErrValue), "filter arg must be slice")
+
+	log := transferLog(t, 1, 0, alice, bob, 100)
+
+	var from, to address.Address
+	var value *big.Int
+
+	require.NoError(t, abi.UnpackLog(transfer, log, []interface{}{&from, &to, &value}))
+
+	require.Equal(t, alice, from)
+	require.Equal(t, bob, to)
+	require.Equal(t, int64(100), value.Int64())
+
+	log.Topics = log.Topics[:2]
+
+	require.True(t, errors.Is(abi.UnpackLog(transfer, log, []interface{}{&from, &to, &value}), abi.ErrTopic))
+
+	// indexed string is filtered and decoded as keccak256 hash
+	named, ok := abi.TryGetEvent(contract, "Named(string,bytes)")
+
+	require.True(t, ok)
+
+	topics, err = named.FilterTopics([]string{"alice"})
+
+	require.NoError(t, err)
+
+	require.Equal(t, hexTopic(keccak.Hash([]byte("alice"))), topics[1][0])
+
+	bytesEncoder, err := abi.Bytes()
+
+	require.NoError(t, err)
+
+	data, err := abi.Tuple("data", bytesEncoder)
+
+	require.NoError(t, err)
+
+	buff, err := data.Marshal([]interface{}{[]byte{1, 2}})
+
+	require.NoError(t, err)
+
+	var nameHash [32]byte
+	var payload []byte
+
+	require.NoError(t, named.Unpack([][]byte{named.Topic(), keccak.Hash([]byte("alice"))}, buff, []interface{}{&nameHash, &payload}))
+
+	require.Equal(t, keccak.Hash([]byte("alice")), nameHash[:])
+	require.Equal(t, []byte{1, 2}, payload)
+
+	// anonymous event has no topic0
+	anon, ok := abi.TryGetEvent(contract, "Anon(uint256)")
+
+	require.True(t, ok)
+
+	require.True(t, anon.Anonymous())
+
+	topics, err = anon.FilterTopics([]*big.Int{big.NewInt(1)})
+
+	require.NoError(t, err)
+
+	require.Len(t, topics, 1)
+
+	require.Equal(t, hexTopic(append(make([]byte, 31), 1)), topics[0][0])
+}
+
+func TestFilterLogsPaging(t *testing.T) {
+	provider := clienttest.New(56)
+
+	provider.SetBlockNumber(25)
+
+	provider.AddLogs(transferLog(t, 3, 0, alice, bob, 1), transferLog(t, 12, 0, bob, alice, 2), transferLog(t, 25, 0, alice, bob, 3))
+
+	logs, err := FilterLogs(context.Background(), provider, LogQuery(tokenAddress, nil), &FilterOpts{FromBlock: 1, PageSize: 10})
+
+	require.NoError(t, err)
+
+	require.Len(t, logs, 3)
+
+	var ranges []string
+
+	for _, query := range provider.LogQueries() {
+		ranges = append(ranges, query.FromBlock+"-"+query.ToBlock)
+	}
+
+	require.Equal(t, []string{"0x1-0xa", "0xb-0x14", "0x15-0x19"}, ranges)
+
+	to := uint64(12)
+
+	logs, err = FilterLogs(context.Background(), provider, LogQuery(tokenAddress, nil), &FilterOpts{FromBlock: 4, ToBlock: &to})
+
+	require.NoError(t, err)
+
+	require.Len(t, logs, 1)
+}
+
+// nonSubscriber hides the Subscriber implementation of provider
+type nonSubscriber struct {
+	client.Provider
+}
+
+func receiveLog(t *testing.T, logs <-chan *client.Log) *client.Log {
+	select {
+	case log := <-logs:
+		return log
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "receive log timeout")
+		return nil
+	}
+}
+
+func collect(logs chan<- *client.Log) LogHandler {
+	return func(ctx context.Context, log *client.Log) error {
+		select {
+		case logs <- log:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func TestWatchLogsPolling(t *testing.T) {
+	provider := clienttest.New(56)
+
+	provider.SetBlockNumber(10)
+
+	provider.AddLogs(transferLog(t, 10, 0, alice, bob, 1))
+
+	logs := make(chan *client.Log, 10)
+
+	from := uint64(10)
+
+	sub, err := WatchLogs(context.Background(), &nonSubscriber{provider}, LogQuery(tokenAddress, nil), &WatchOpts{
+		FromBlock:    &from,
+		PollInterval: 10 * time.Millisecond,
+	}, collect(logs))
+
+	require.NoError(t, err)
+
+	defer sub.Unsubscribe()
+
+	require.Equal(t, "0xa", receiveLog(t, logs).BlockNumber)
+
+	// block 11 is mined and then replaced by reorg
+	provider.AddLogs(transferLog(t, 11, 0, alice, bob, 2))
+	provider.SetBlockNumber(11)
+
+	log := receiveLog(t, logs)
+
+	require.Equal(t, "0xb", log.BlockNumber)
+	require.False(t, log.Removed)
+
+	reorged := transferLog(t, 11, 0, alice, bob, 2)
+	reorged.Removed = true
+
+	provider.AddLogs(reorged)
+
+	log = receiveLog(t, logs)
+
+	require.Equal(t, "0xb", log.BlockNumber)
+	require.True(t, log.Removed)
+}
+
+func TestWatchLogsSubscription(t *testing.T) {
+	provider := clienttest.New(56)
+
+	provider.SetBlockNumber(10)
+
+	provider.AddLogs(transferLog(t, 9, 0, alice, bob, 1))
+
+	logs := make(chan *client.Log, 10)
+
+	from := uint64(1)
+
+	sub, err := WatchLogs(context.Background(), provider, LogQuery(tokenAddress, nil), &WatchOpts{FromBlock: &from}, collect(logs))
+
+	require.NoError(t, err)
+
+	// replayed history
+	require.Equal(t, "0x9", receiveLog(t, logs).BlockNumber)
+
+	provider.AddLogs(transferLog(t, 11, 0, alice, bob, 2))
+
+	require.Equal(t, "0xb", receiveLog(t, logs).BlockNumber)
+
+	removed := transferLog(t, 11, 0, alice, bob, 2)
+	removed.Removed = true
+
+	provider.AddLogs(removed)
+
+	require.True(t, receiveLog(t, logs).Removed)
+
+	sub.Unsubscribe()
+
+	_, ok := <-sub.Err()
+
+	require.False(t, ok)
+}
+
+func TestWatchLogsLongReplay(t *testing.T) {
+	provider := clienttest.New(56)
+
+	const count = 400
+
+	provider.SetBlockNumber(count)
+
+	for i := uint64(1); i <= count; i++ {
+		provider.AddLogs(transferLog(t, i, 0, alice, bob, 1))
+	}
+
+	logs := make(chan *client.Log, 3*count)
+
+	live := uint64(count)
+
+	// live logs arrive while replaying, more than the subscription can buffer
+	handler := func(ctx context.Context, log *client.Log) error {
+		// duplicate of replayed log is skipped
+		if live == count {
+			provider.AddLogs(transferLog(t, 1, 0, alice, bob, 1))
+		}
+
+		if live < 2*count {
+			// paced as the live chain, the watcher must keep draining the subscription
+			time.Sleep(200 * time.Microsecond)
+
+			live++
+			provider.AddLogs(transferLog(t, live, 0, alice, bob, 2))
+		}
+
+		return collect(logs)(ctx, log)
+	}
+
+	from := uint64(1)
+
+	sub, err := WatchLogs(context.Background(), provider, LogQuery(tokenAddress, nil), &WatchOpts{FromBlock: &from}, handler)
+
+	require.NoError(t, err)
+
+	defer sub.Unsubscribe()
+
+	for i := uint64(1); i <= 2*count; i++ {
+		require.Equal(t, fmt.Sprintf("0x%x", i), receiveLog(t, logs).BlockNumber)
+	}
+
+	// still alive after replay
+	provider.AddLogs(transferLog(t, 2*count+1, 0, alice, bob, 3))
+
+	require.Equal(t, fmt.Sprintf("0x%x", 2*count+1), receiveLog(t, logs).BlockNumber)
+
+	select {
+	case err := <-sub.Err():
+		require.FailNow(t, "watcher terminated", "%v", err)
+	case log := <-logs:
+		require.FailNow(t, "unexpected log", log.BlockNumber)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,183 @@
+package bindtest
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/stretchr/testify/require"
+)
+
+// callProvider stub provider answering eth_call only
+type callProvider struct {
+	client.Provider
+	call func(callSite *client.CallSite) (string, error)
+}
+
+func (provider *callProvider) Call(ctx context.Context, callSite *client.CallSite) (string, error) {
+	return provider.call(callSite)
+}
+
+func TestMock(t *testing.T) {
+	owner := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	mock := &MockIERC20{
+		BalanceOfFunc: func(ctx context.Context, account address.Address) (*big.Int, error) {
+			if account == owner {
+				return big.NewInt(100), nil
+			}
+
+			return big.NewInt(0), nil
+		},
+	}
+
+	var token IERC20 = mock
+
+	balance, err := token.BalanceOf(context.Background(), owner)
+
+	require.NoError(t, err)
+
+	require.Equal(t, int64(100), balance.Int64())
+
+	_, err = token.Transfer(context.Background(), owner, big.NewInt(1))
+
+	require.True(t, errors.Is(err, binding.ErrMock))
+
+	var caller IPancakeRouter02Caller = &MockIPancakeRouter02{
+		GetAmountOutFunc: func(ctx context.Context, amountIn, reserveIn, reserveOut *big.Int) (*big.Int, error) {
+			return new(big.Int).Div(new(big.Int).Mul(amountIn, reserveOut), reserveIn), nil
+		},
+	}
+
+	amountOut, err := caller.GetAmountOut(context.Background(), big.NewInt(10), big.NewInt(100), big.NewInt(200))
+
+	require.NoError(t, err)
+
+	require.Equal(t, int64(20), amountOut.Int64())
+}
+
+func TestCallerImpl(t *testing.T) {
+	contract, err := IERC20Contract()
+
+	require.NoError(t, err)
+
+	again, err := IERC20Contract()
+
+	require.NoError(t, err)
+
+	require.True(t, contract == again, "abi parsed once")
+
+	balanceOf, ok := abi.TryGetFunc(contract, "balanceOf(address)")
+
+	require.True(t, ok)
+
+	owner := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+	usdt := address.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+
+	provider := &callProvider{
+		call: func(callSite *client.CallSite) (string, error) {
+			expect, err := balanceOf.Call(owner)
+
+			require.NoError(t, err)
+
+			require.Equal(t, "0x"+hex.EncodeToString(expect), callSite.Data)
+
+			require.Equal(t, usdt.Hex(), callSite.To)
+
+			ret, err := balanceOf.EncodeOutputs(big.NewInt(1e18))
+
+			require.NoError(t, err)
+
+			return "0x" + hex.EncodeToString(ret), nil
+		},
+	}
+
+	token, err := NewIERC20(usdt, provider, nil)
+
+	require.NoError(t, err)
+
+	balance, err := token.BalanceOf(context.Background(), owner)
+
+	require.NoError(t, err)
+
+	require.Equal(t, big.NewInt(1e18), balance)
+
+	caller, err := NewIERC20Caller(usdt, provider)
+
+	require.NoError(t, err)
+
+	balance, err = caller.BalanceOf(context.Background(), owner)
+
+	require.NoError(t, err)
+
+	require.Equal(t, big.NewInt(1e18), balance)
+
+	// embedded abi is the readable json of testdata
+	_, err = binding.Parse("IERC20", []byte(IERC20ABI), binding.NewSymbols())
+
+	require.NoError(t, err)
+
+	require.Contains(t, IERC20ABI, `"name":"balanceOf"`)
+}
+
+func transferLog(from address.Address, to address.Address, value int64, block uint64) *client.Log {
+	word := func(buff []byte) string {
+		return hex.EncodeToString(append(make([]byte, 32-len(buff)), buff...))
+	}
+
+	return &client.Log{
+		Address:     "0x0000000000000000000000000000000000001234",
+		Topics:      []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", "0x" + word(from[:]), "0x" + word(to[:])},
+		Data:        "0x" + word(big.NewInt(value).Bytes()),
+		BlockNumber: "0x" + big.NewInt(int64(block)).Text(16),
+		BlockHash:   "0x" + word(big.NewInt(int64(block)).Bytes()),
+		LogIndex:    "0x0",
+	}
+}
+
+func TestFilterer(t *testing.T) {
+	alice := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+	bob := address.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+
+	provider := clienttest.New(56)
+
+	provider.SetBlockNumber(10)
+
+	provider.AddLogs(transferLog(alice, bob, 1, 5), transferLog(bob, alice, 2, 6))
+
+	filterer, err := NewIERC20Filterer(address.HexToAddress("0x0000000000000000000000000000000000001234"), provider)
+
+	require.NoError(t, err)
+
+	events, err := filterer.FilterTransfer(context.Background(), nil, []address.Address{alice}, nil)
+
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	require.Equal(t, alice, events[0].From)
+	require.Equal(t, bob, events[0].To)
+	require.Equal(t, int64(1), events[0].Value.Int64())
+	require.Equal(t, "0x5", events[0].Raw.BlockNumber)
+
+	sink := make(chan *IERC20TransferEvent, 1)
+
+	sub, err := filterer.WatchTransfer(context.Background(), nil, sink, nil, []address.Address{alice})
+
+	require.NoError(t, err)
+
+	defer sub.Unsubscribe()
+
+	provider.AddLogs(transferLog(alice, bob, 3, 11), transferLog(bob, alice, 4, 11))
+
+	event := <-sink
+
+	require.Equal(t, bob, event.From)
+	require.Equal(t, int64(4), event.Value.Int64())
+}
@@ -0,0 +1,631 @@
+package bindtest
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// IERC20Caller view/pure funcs of contract IERC20
+type IERC20Caller interface {
+	Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error)
+	BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error)
+	TotalSupply(ctx context.Context) (ret0 *big.Int, err error)
+}
+
+// IERC20Transactor state-changing funcs of contract IERC20
+type IERC20Transactor interface {
+	Approve(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Transfer(ctx context.Context, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFrom(ctx context.Context, sender address.Address, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// IERC20Filterer event filterers and watchers of contract IERC20
+type IERC20Filterer interface {
+	FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*IERC20ApprovalEvent, err error)
+	WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error)
+	FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*IERC20TransferEvent, err error)
+	WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+}
+
+// IERC20 contract IERC20 binding interface
+type IERC20 interface {
+	IERC20Caller
+	IERC20Transactor
+	IERC20Filterer
+}
+
+// IERC20ApprovalEvent event Approval(address,address,uint256) of contract IERC20
+type IERC20ApprovalEvent struct {
+	Owner   address.Address
+	Spender address.Address
+	Value   *big.Int
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// IERC20TransferEvent event Transfer(address,address,uint256) of contract IERC20
+type IERC20TransferEvent struct {
+	From  address.Address
+	To    address.Address
+	Value *big.Int
+	Raw   *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// IERC20ABI json abi of contract IERC20
+const IERC20ABI = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"spender","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Approval","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"spender","type":"address"}],"name":"allowance","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"approve","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"},{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"transferFrom","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+var (
+	parsedIERC20Once sync.Once
+	parsedIERC20     abi.Contract
+	parsedIERC20Err  error
+)
+
+// IERC20Contract returns the abi.Contract of IERC20ABI, which is parsed once on first use
+func IERC20Contract() (abi.Contract, error) {
+	parsedIERC20Once.Do(func() {
+		parsedIERC20, parsedIERC20Err = binding.Parse("IERC20", []byte(IERC20ABI), binding.NewSymbols())
+	})
+
+	return parsedIERC20, parsedIERC20Err
+}
+
+// NewIERC20 create IERC20 binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewIERC20(recipient address.Address, provider client.Provider, signer signer.Signer) (*IERC20Impl, error) {
+	contract, err := IERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIERC20Impl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewIERC20Caller create IERC20Caller of contract deployed at recipient
+func NewIERC20Caller(recipient address.Address, provider client.Provider) (*IERC20CallerImpl, error) {
+	contract, err := IERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &IERC20CallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewIERC20Filterer create IERC20Filterer of contract deployed at recipient
+func NewIERC20Filterer(recipient address.Address, provider client.Provider) (*IERC20FiltererImpl, error) {
+	contract, err := IERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &IERC20FiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// IERC20CallerImpl IERC20Caller implementation calling contract via provider
+type IERC20CallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// IERC20TransactorImpl IERC20Transactor implementation sending signed transactions via provider
+type IERC20TransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// IERC20FiltererImpl IERC20Filterer implementation querying and subscribing logs via provider
+type IERC20FiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// IERC20Impl IERC20 implementation
+type IERC20Impl struct {
+	*IERC20CallerImpl
+	*IERC20TransactorImpl
+	*IERC20FiltererImpl
+}
+
+// NewIERC20Impl create IERC20 implementation of contract deployed at recipient
+func NewIERC20Impl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *IERC20Impl {
+	return &IERC20Impl{
+		IERC20CallerImpl: &IERC20CallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		IERC20TransactorImpl: &IERC20TransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		IERC20FiltererImpl: &IERC20FiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ IERC20 = (*IERC20Impl)(nil)
+
+func (impl *IERC20CallerImpl) Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("dd62ed3e")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Allowance not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *IERC20CallerImpl) BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("70a08231")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(account)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *IERC20CallerImpl) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("18160ddd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TotalSupply not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *IERC20TransactorImpl) Approve(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("095ea7b3")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Approve not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(spender, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IERC20TransactorImpl) Transfer(ctx context.Context, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a9059cbb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Transfer not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(recipient, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IERC20TransactorImpl) TransferFrom(ctx context.Context, sender address.Address, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("23b872dd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(sender, recipient, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterApproval returns Approval events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *IERC20FiltererImpl) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*IERC20ApprovalEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &IERC20ApprovalEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchApproval stream Approval events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *IERC20FiltererImpl) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &IERC20ApprovalEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterTransfer returns Transfer events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *IERC20FiltererImpl) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*IERC20TransferEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &IERC20TransferEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransfer stream Transfer events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *IERC20FiltererImpl) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &IERC20TransferEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockIERC20 in-memory IERC20 implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockIERC20 struct {
+	AllowanceFunc      func(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error)
+	ApproveFunc        func(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	BalanceOfFunc      func(ctx context.Context, account address.Address) (ret0 *big.Int, err error)
+	TotalSupplyFunc    func(ctx context.Context) (ret0 *big.Int, err error)
+	TransferFunc       func(ctx context.Context, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFromFunc   func(ctx context.Context, sender address.Address, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FilterApprovalFunc func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*IERC20ApprovalEvent, err error)
+	WatchApprovalFunc  func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error)
+	FilterTransferFunc func(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*IERC20TransferEvent, err error)
+	WatchTransferFunc  func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+}
+
+var _ IERC20 = (*MockIERC20)(nil)
+
+func (mock *MockIERC20) Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error) {
+	if mock.AllowanceFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Allowance not stubbed")
+		return
+	}
+
+	return mock.AllowanceFunc(ctx, owner, spender)
+}
+
+func (mock *MockIERC20) Approve(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.ApproveFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Approve not stubbed")
+		return
+	}
+
+	return mock.ApproveFunc(ctx, spender, amount, ops...)
+}
+
+func (mock *MockIERC20) BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, account)
+}
+
+func (mock *MockIERC20) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.TotalSupplyFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TotalSupply not stubbed")
+		return
+	}
+
+	return mock.TotalSupplyFunc(ctx)
+}
+
+func (mock *MockIERC20) Transfer(ctx context.Context, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Transfer not stubbed")
+		return
+	}
+
+	return mock.TransferFunc(ctx, recipient, amount, ops...)
+}
+
+func (mock *MockIERC20) TransferFrom(ctx context.Context, sender address.Address, recipient address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferFrom not stubbed")
+		return
+	}
+
+	return mock.TransferFromFunc(ctx, sender, recipient, amount, ops...)
+}
+
+func (mock *MockIERC20) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*IERC20ApprovalEvent, err error) {
+	if mock.FilterApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApproval not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalFunc(ctx, opts, owner, spender)
+}
+
+func (mock *MockIERC20) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApproval not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalFunc(ctx, opts, sink, owner, spender)
+}
+
+func (mock *MockIERC20) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*IERC20TransferEvent, err error) {
+	if mock.FilterTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransfer not stubbed")
+		return
+	}
+
+	return mock.FilterTransferFunc(ctx, opts, from, to)
+}
+
+func (mock *MockIERC20) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *IERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransfer not stubbed")
+		return
+	}
+
+	return mock.WatchTransferFunc(ctx, opts, sink, from, to)
+}
@@ -0,0 +1,490 @@
+package bindtest
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// NatSpecTokenCaller view/pure funcs of contract NatSpecToken
+type NatSpecTokenCaller interface {
+	// BalanceOf Returns the token balance of account
+	//
+	// Parameters:
+	//  - account: The queried account
+	//
+	// Returns:
+	//  - ret0: The balance in the smallest unit
+	BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error)
+	// Reserves Reserves are updated on every transfer.
+	// Both values are packed in one slot.
+	//
+	// Returns:
+	//  - reserve0: The reserve of token0
+	//  - reserve1: The reserve of token1
+	Reserves(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, err error)
+}
+
+// NatSpecTokenTransactor state-changing funcs of contract NatSpecToken
+type NatSpecTokenTransactor interface {
+	// Transfer Moves amount tokens from the caller to to
+	Transfer(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	// TransferWithData Moves amount tokens and calls the receiver hook
+	//
+	// Dev: Reverts if the receiver hook rejects.
+	//
+	// Parameters:
+	//  - to: The receiver
+	//  - amount: The amount
+	//  - data: The hook payload
+	TransferWithData(ctx context.Context, to address.Address, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// NatSpecTokenFilterer event filterers and watchers of contract NatSpecToken
+type NatSpecTokenFilterer interface {
+	FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*NatSpecTokenTransferEvent, err error)
+	WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *NatSpecTokenTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+}
+
+// NatSpecToken contract NatSpecToken binding interface
+//
+// Title: NatSpec token
+//
+// Notice: A token documented with NatSpec
+//
+// Dev: Only used by binding tests.
+//
+// Author: libs4go
+type NatSpecToken interface {
+	NatSpecTokenCaller
+	NatSpecTokenTransactor
+	NatSpecTokenFilterer
+}
+
+// NatSpecTokenTransferEvent Emitted when tokens are moved
+type NatSpecTokenTransferEvent struct {
+	From  address.Address
+	To    address.Address
+	Value *big.Int
+	Raw   *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// NatSpecTokenABI json abi of contract NatSpecToken
+const NatSpecTokenABI = `[{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"reserves","outputs":[{"internalType":"uint112","name":"reserve0","type":"uint112"},{"internalType":"uint112","name":"reserve1","type":"uint112"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes","name":"_data","type":"bytes"}],"name":"transfer","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[{"internalType":"uint256","name":"needed","type":"uint256"}],"name":"InsufficientBalance","type":"error"}]`
+
+var (
+	parsedNatSpecTokenOnce sync.Once
+	parsedNatSpecToken     abi.Contract
+	parsedNatSpecTokenErr  error
+)
+
+// NatSpecTokenContract returns the abi.Contract of NatSpecTokenABI, which is parsed once on first use
+func NatSpecTokenContract() (abi.Contract, error) {
+	parsedNatSpecTokenOnce.Do(func() {
+		parsedNatSpecToken, parsedNatSpecTokenErr = binding.Parse("NatSpecToken", []byte(NatSpecTokenABI), binding.NewSymbols())
+	})
+
+	return parsedNatSpecToken, parsedNatSpecTokenErr
+}
+
+// NewNatSpecToken create NatSpecToken binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewNatSpecToken(recipient address.Address, provider client.Provider, signer signer.Signer) (*NatSpecTokenImpl, error) {
+	contract, err := NatSpecTokenContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNatSpecTokenImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewNatSpecTokenCaller create NatSpecTokenCaller of contract deployed at recipient
+func NewNatSpecTokenCaller(recipient address.Address, provider client.Provider) (*NatSpecTokenCallerImpl, error) {
+	contract, err := NatSpecTokenContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &NatSpecTokenCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewNatSpecTokenFilterer create NatSpecTokenFilterer of contract deployed at recipient
+func NewNatSpecTokenFilterer(recipient address.Address, provider client.Provider) (*NatSpecTokenFiltererImpl, error) {
+	contract, err := NatSpecTokenContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &NatSpecTokenFiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NatSpecTokenCallerImpl NatSpecTokenCaller implementation calling contract via provider
+type NatSpecTokenCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// NatSpecTokenTransactorImpl NatSpecTokenTransactor implementation sending signed transactions via provider
+type NatSpecTokenTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// NatSpecTokenFiltererImpl NatSpecTokenFilterer implementation querying and subscribing logs via provider
+type NatSpecTokenFiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// NatSpecTokenImpl NatSpecToken implementation
+type NatSpecTokenImpl struct {
+	*NatSpecTokenCallerImpl
+	*NatSpecTokenTransactorImpl
+	*NatSpecTokenFiltererImpl
+}
+
+// NewNatSpecTokenImpl create NatSpecToken implementation of contract deployed at recipient
+func NewNatSpecTokenImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *NatSpecTokenImpl {
+	return &NatSpecTokenImpl{
+		NatSpecTokenCallerImpl: &NatSpecTokenCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		NatSpecTokenTransactorImpl: &NatSpecTokenTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		NatSpecTokenFiltererImpl: &NatSpecTokenFiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ NatSpecToken = (*NatSpecTokenImpl)(nil)
+
+// BalanceOf Returns the token balance of account
+//
+// Parameters:
+//   - account: The queried account
+//
+// Returns:
+//   - ret0: The balance in the smallest unit
+func (impl *NatSpecTokenCallerImpl) BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("70a08231")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(account)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+// Reserves Reserves are updated on every transfer.
+// Both values are packed in one slot.
+//
+// Returns:
+//   - reserve0: The reserve of token0
+//   - reserve1: The reserve of token1
+func (impl *NatSpecTokenCallerImpl) Reserves(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, err error) {
+	f, ok := impl.Contract.Select("75172a8b")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Reserves not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&reserve0, &reserve1})
+
+	return
+}
+
+// Transfer Moves amount tokens from the caller to to
+func (impl *NatSpecTokenTransactorImpl) Transfer(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a9059cbb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Transfer not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// TransferWithData Moves amount tokens and calls the receiver hook
+//
+// Dev: Reverts if the receiver hook rejects.
+//
+// Parameters:
+//   - to: The receiver
+//   - amount: The amount
+//   - data: The hook payload
+func (impl *NatSpecTokenTransactorImpl) TransferWithData(ctx context.Context, to address.Address, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("be45fd62")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferWithData not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to, amount, data)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterTransfer returns Transfer events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *NatSpecTokenFiltererImpl) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*NatSpecTokenTransferEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &NatSpecTokenTransferEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransfer stream Transfer events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *NatSpecTokenFiltererImpl) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *NatSpecTokenTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &NatSpecTokenTransferEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockNatSpecToken in-memory NatSpecToken implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockNatSpecToken struct {
+	BalanceOfFunc        func(ctx context.Context, account address.Address) (ret0 *big.Int, err error)
+	ReservesFunc         func(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, err error)
+	TransferFunc         func(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferWithDataFunc func(ctx context.Context, to address.Address, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FilterTransferFunc   func(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*NatSpecTokenTransferEvent, err error)
+	WatchTransferFunc    func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *NatSpecTokenTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+}
+
+var _ NatSpecToken = (*MockNatSpecToken)(nil)
+
+func (mock *MockNatSpecToken) BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, account)
+}
+
+func (mock *MockNatSpecToken) Reserves(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, err error) {
+	if mock.ReservesFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Reserves not stubbed")
+		return
+	}
+
+	return mock.ReservesFunc(ctx)
+}
+
+func (mock *MockNatSpecToken) Transfer(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Transfer not stubbed")
+		return
+	}
+
+	return mock.TransferFunc(ctx, to, amount, ops...)
+}
+
+func (mock *MockNatSpecToken) TransferWithData(ctx context.Context, to address.Address, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferWithDataFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferWithData not stubbed")
+		return
+	}
+
+	return mock.TransferWithDataFunc(ctx, to, amount, data, ops...)
+}
+
+func (mock *MockNatSpecToken) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*NatSpecTokenTransferEvent, err error) {
+	if mock.FilterTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransfer not stubbed")
+		return
+	}
+
+	return mock.FilterTransferFunc(ctx, opts, from, to)
+}
+
+func (mock *MockNatSpecToken) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *NatSpecTokenTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransfer not stubbed")
+		return
+	}
+
+	return mock.WatchTransferFunc(ctx, opts, sink, from, to)
+}
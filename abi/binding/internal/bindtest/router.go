@@ -0,0 +1,1142 @@
+package bindtest
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// IPancakeRouter02Caller view/pure funcs of contract IPancakeRouter02
+type IPancakeRouter02Caller interface {
+	WETH(ctx context.Context) (ret0 address.Address, err error)
+	Factory(ctx context.Context) (ret0 address.Address, err error)
+	GetAmountIn(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error)
+	GetAmountOut(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error)
+	GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	Quote(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error)
+}
+
+// IPancakeRouter02Transactor state-changing funcs of contract IPancakeRouter02
+type IPancakeRouter02Transactor interface {
+	AddLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	AddLiquidityETH(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETH(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermit(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityWithPermit(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETHSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// IPancakeRouter02 contract IPancakeRouter02 binding interface
+type IPancakeRouter02 interface {
+	IPancakeRouter02Caller
+	IPancakeRouter02Transactor
+}
+
+// IPancakeRouter02ABI json abi of contract IPancakeRouter02
+const IPancakeRouter02ABI = `[{"inputs":[],"name":"WETH","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"amountADesired","type":"uint256"},{"internalType":"uint256","name":"amountBDesired","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"addLiquidity","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"},{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amountTokenDesired","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"addLiquidityETH","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"},{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"reserveIn","type":"uint256"},{"internalType":"uint256","name":"reserveOut","type":"uint256"}],"name":"getAmountIn","outputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"reserveIn","type":"uint256"},{"internalType":"uint256","name":"reserveOut","type":"uint256"}],"name":"getAmountOut","outputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"}],"name":"getAmountsIn","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"}],"name":"getAmountsOut","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"reserveA","type":"uint256"},{"internalType":"uint256","name":"reserveB","type":"uint256"}],"name":"quote","outputs":[{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidity","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidityETH","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidityETHSupportingFeeOnTransferTokens","outputs":[{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bool","name":"approveMax","type":"bool"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"removeLiquidityETHWithPermit","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bool","name":"approveMax","type":"bool"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"removeLiquidityETHWithPermitSupportingFeeOnTransferTokens","outputs":[{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bool","name":"approveMax","type":"bool"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"removeLiquidityWithPermit","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapETHForExactTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactETHForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactETHForTokensSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForETH","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForETHSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokensSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMax","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapTokensForExactETH","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMax","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapTokensForExactTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"}]`
+
+var (
+	parsedIPancakeRouter02Once sync.Once
+	parsedIPancakeRouter02     abi.Contract
+	parsedIPancakeRouter02Err  error
+)
+
+// IPancakeRouter02Contract returns the abi.Contract of IPancakeRouter02ABI, which is parsed once on first use
+func IPancakeRouter02Contract() (abi.Contract, error) {
+	parsedIPancakeRouter02Once.Do(func() {
+		parsedIPancakeRouter02, parsedIPancakeRouter02Err = binding.Parse("IPancakeRouter02", []byte(IPancakeRouter02ABI), binding.NewSymbols())
+	})
+
+	return parsedIPancakeRouter02, parsedIPancakeRouter02Err
+}
+
+// NewIPancakeRouter02 create IPancakeRouter02 binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewIPancakeRouter02(recipient address.Address, provider client.Provider, signer signer.Signer) (*IPancakeRouter02Impl, error) {
+	contract, err := IPancakeRouter02Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIPancakeRouter02Impl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewIPancakeRouter02Caller create IPancakeRouter02Caller of contract deployed at recipient
+func NewIPancakeRouter02Caller(recipient address.Address, provider client.Provider) (*IPancakeRouter02CallerImpl, error) {
+	contract, err := IPancakeRouter02Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &IPancakeRouter02CallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// IPancakeRouter02CallerImpl IPancakeRouter02Caller implementation calling contract via provider
+type IPancakeRouter02CallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// IPancakeRouter02TransactorImpl IPancakeRouter02Transactor implementation sending signed transactions via provider
+type IPancakeRouter02TransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// IPancakeRouter02Impl IPancakeRouter02 implementation
+type IPancakeRouter02Impl struct {
+	*IPancakeRouter02CallerImpl
+	*IPancakeRouter02TransactorImpl
+}
+
+// NewIPancakeRouter02Impl create IPancakeRouter02 implementation of contract deployed at recipient
+func NewIPancakeRouter02Impl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *IPancakeRouter02Impl {
+	return &IPancakeRouter02Impl{
+		IPancakeRouter02CallerImpl: &IPancakeRouter02CallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		IPancakeRouter02TransactorImpl: &IPancakeRouter02TransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ IPancakeRouter02 = (*IPancakeRouter02Impl)(nil)
+
+func (impl *IPancakeRouter02CallerImpl) WETH(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("ad5c4648")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func WETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *IPancakeRouter02CallerImpl) Factory(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("c45a0155")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Factory not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *IPancakeRouter02CallerImpl) GetAmountIn(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error) {
+	f, ok := impl.Contract.Select("85f8c259")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountIn not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, reserveIn, reserveOut)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amountIn})
+
+	return
+}
+
+func (impl *IPancakeRouter02CallerImpl) GetAmountOut(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error) {
+	f, ok := impl.Contract.Select("054d50d4")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountOut not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, reserveIn, reserveOut)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amountOut})
+
+	return
+}
+
+func (impl *IPancakeRouter02CallerImpl) GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	f, ok := impl.Contract.Select("1f00ca74")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountsIn not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, path)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amounts})
+
+	return
+}
+
+func (impl *IPancakeRouter02CallerImpl) GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	f, ok := impl.Contract.Select("d06ca61f")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountsOut not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, path)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amounts})
+
+	return
+}
+
+func (impl *IPancakeRouter02CallerImpl) Quote(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error) {
+	f, ok := impl.Contract.Select("ad615dec")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Quote not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountA, reserveA, reserveB)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amountB})
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) AddLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("e8e33700")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func AddLiquidity not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB, amountADesired, amountBDesired, amountAMin, amountBMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) AddLiquidityETH(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("f305d719")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func AddLiquidityETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, amountTokenDesired, amountTokenMin, amountETHMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) RemoveLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("baa2abde")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidity not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) RemoveLiquidityETH(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("02751cec")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) RemoveLiquidityETHSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("af2979eb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETHSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) RemoveLiquidityETHWithPermit(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("ded9382a")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETHWithPermit not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("5b0d5984")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) RemoveLiquidityWithPermit(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("2195995c")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityWithPermit not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline, approveMax, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("fb3bdb41")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapETHForExactTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapExactETHForTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("7ff36ab5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactETHForTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapExactETHForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("b6f9de95")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactETHForTokensSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("18cbafe5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapExactTokensForETHSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("791ac947")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForETHSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("38ed1739")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapExactTokensForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("5c11d795")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForTokensSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("4a25d94a")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapTokensForExactETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, amountInMax, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *IPancakeRouter02TransactorImpl) SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("8803dbee")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapTokensForExactTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, amountInMax, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// MockIPancakeRouter02 in-memory IPancakeRouter02 implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockIPancakeRouter02 struct {
+	WETHFunc                                                      func(ctx context.Context) (ret0 address.Address, err error)
+	AddLiquidityFunc                                              func(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	AddLiquidityETHFunc                                           func(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FactoryFunc                                                   func(ctx context.Context) (ret0 address.Address, err error)
+	GetAmountInFunc                                               func(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error)
+	GetAmountOutFunc                                              func(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error)
+	GetAmountsInFunc                                              func(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	GetAmountsOutFunc                                             func(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	QuoteFunc                                                     func(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error)
+	RemoveLiquidityFunc                                           func(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHFunc                                        func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHSupportingFeeOnTransferTokensFunc           func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermitFunc                              func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensFunc func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityWithPermitFunc                                 func(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapETHForExactTokensFunc                                     func(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokensFunc                                     func(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokensSupportingFeeOnTransferTokensFunc        func(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETHFunc                                     func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETHSupportingFeeOnTransferTokensFunc        func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokensFunc                                  func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokensSupportingFeeOnTransferTokensFunc     func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactETHFunc                                     func(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactTokensFunc                                  func(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+var _ IPancakeRouter02 = (*MockIPancakeRouter02)(nil)
+
+func (mock *MockIPancakeRouter02) WETH(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.WETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WETH not stubbed")
+		return
+	}
+
+	return mock.WETHFunc(ctx)
+}
+
+func (mock *MockIPancakeRouter02) AddLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.AddLiquidityFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func AddLiquidity not stubbed")
+		return
+	}
+
+	return mock.AddLiquidityFunc(ctx, tokenA, tokenB, amountADesired, amountBDesired, amountAMin, amountBMin, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) AddLiquidityETH(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.AddLiquidityETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func AddLiquidityETH not stubbed")
+		return
+	}
+
+	return mock.AddLiquidityETHFunc(ctx, token, amountTokenDesired, amountTokenMin, amountETHMin, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) Factory(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.FactoryFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Factory not stubbed")
+		return
+	}
+
+	return mock.FactoryFunc(ctx)
+}
+
+func (mock *MockIPancakeRouter02) GetAmountIn(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error) {
+	if mock.GetAmountInFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountIn not stubbed")
+		return
+	}
+
+	return mock.GetAmountInFunc(ctx, amountOut, reserveIn, reserveOut)
+}
+
+func (mock *MockIPancakeRouter02) GetAmountOut(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error) {
+	if mock.GetAmountOutFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountOut not stubbed")
+		return
+	}
+
+	return mock.GetAmountOutFunc(ctx, amountIn, reserveIn, reserveOut)
+}
+
+func (mock *MockIPancakeRouter02) GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	if mock.GetAmountsInFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountsIn not stubbed")
+		return
+	}
+
+	return mock.GetAmountsInFunc(ctx, amountOut, path)
+}
+
+func (mock *MockIPancakeRouter02) GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	if mock.GetAmountsOutFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountsOut not stubbed")
+		return
+	}
+
+	return mock.GetAmountsOutFunc(ctx, amountIn, path)
+}
+
+func (mock *MockIPancakeRouter02) Quote(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error) {
+	if mock.QuoteFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Quote not stubbed")
+		return
+	}
+
+	return mock.QuoteFunc(ctx, amountA, reserveA, reserveB)
+}
+
+func (mock *MockIPancakeRouter02) RemoveLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidity not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityFunc(ctx, tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) RemoveLiquidityETH(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETH not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) RemoveLiquidityETHSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETHSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHSupportingFeeOnTransferTokensFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) RemoveLiquidityETHWithPermit(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHWithPermitFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETHWithPermit not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHWithPermitFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s, ops...)
+}
+
+func (mock *MockIPancakeRouter02) RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s, ops...)
+}
+
+func (mock *MockIPancakeRouter02) RemoveLiquidityWithPermit(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityWithPermitFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityWithPermit not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityWithPermitFunc(ctx, tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline, approveMax, v, r, s, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapETHForExactTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapETHForExactTokens not stubbed")
+		return
+	}
+
+	return mock.SwapETHForExactTokensFunc(ctx, amountOut, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapExactETHForTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactETHForTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactETHForTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactETHForTokensFunc(ctx, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapExactETHForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactETHForTokensSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactETHForTokensSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactETHForTokensSupportingFeeOnTransferTokensFunc(ctx, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForETH not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForETHFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapExactTokensForETHSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForETHSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForETHSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForETHSupportingFeeOnTransferTokensFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForTokensFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapExactTokensForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForTokensSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForTokensSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForTokensSupportingFeeOnTransferTokensFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapTokensForExactETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapTokensForExactETH not stubbed")
+		return
+	}
+
+	return mock.SwapTokensForExactETHFunc(ctx, amountOut, amountInMax, path, to, deadline, ops...)
+}
+
+func (mock *MockIPancakeRouter02) SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapTokensForExactTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapTokensForExactTokens not stubbed")
+		return
+	}
+
+	return mock.SwapTokensForExactTokensFunc(ctx, amountOut, amountInMax, path, to, deadline, ops...)
+}
@@ -0,0 +1,304 @@
+package codectest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+func loadContract(t testing.TB) abi.Contract {
+	contract, err := binding.ParseFile("CurveUSDVault", "../../testdata/CurveUSDVault.json", binding.NewSymbols())
+
+	require.NoError(t, err)
+
+	return contract
+}
+
+func getFunc(t testing.TB, contract abi.Contract, signature string) abi.Func {
+	f, ok := abi.TryGetFunc(contract, signature)
+
+	require.True(t, ok, signature)
+
+	return f
+}
+
+func newCurveNFT(id int64) *CurveNFT {
+	return &CurveNFT{
+		Id:               big.NewInt(id),
+		Created:          big.NewInt(1620000000 + id),
+		Deposit:          address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		DepositAmount:    big.NewInt(id * 100),
+		CommissionAmount: big.NewInt(id),
+	}
+}
+
+// requireNFTEqual compare by value, big.Int zero may be decoded with different internal representation
+func requireNFTEqual(t testing.TB, expect, actual *CurveNFT) {
+	require.Equal(t, expect.Id.String(), actual.Id.String())
+	require.Equal(t, expect.Created.String(), actual.Created.String())
+	require.Equal(t, expect.Deposit, actual.Deposit)
+	require.Equal(t, expect.DepositAmount.String(), actual.DepositAmount.String())
+	require.Equal(t, expect.CommissionAmount.String(), actual.CommissionAmount.String())
+}
+
+type helloArgs struct {
+	tokenID [20][]*big.Int
+	nft     []*CurveNFT
+	nfts    [][2]*CurveNFT
+}
+
+func newHelloArgs(n int) *helloArgs {
+	args := &helloArgs{}
+
+	for i := range args.tokenID {
+		for j := 0; j < (i+n)%4; j++ {
+			args.tokenID[i] = append(args.tokenID[i], big.NewInt(int64(i*10+j)))
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		args.nft = append(args.nft, newCurveNFT(int64(i)))
+		args.nfts = append(args.nfts, [2]*CurveNFT{newCurveNFT(int64(i * 2)), newCurveNFT(int64(i*2 + 1))})
+	}
+
+	return args
+}
+
+const helloSignature = "hello(uint256[][20],(uint256,uint256,address,uint256,uint256)[],(uint256,uint256,address,uint256,uint256)[2][])"
+
+func TestPackIdentical(t *testing.T) {
+	contract := loadContract(t)
+
+	owner := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	cases := []struct {
+		signature string
+		pack      func() ([]byte, error)
+		args      []interface{}
+	}{
+		{"DAO()", func() ([]byte, error) { return packCurveUSDVaultDAO() }, nil},
+		{"approve(address,uint256)", func() ([]byte, error) { return packCurveUSDVaultApprove(owner, big.NewInt(7)) }, []interface{}{owner, big.NewInt(7)}},
+		{"setApprovalForAll(address,bool)", func() ([]byte, error) { return packCurveUSDVaultSetApprovalForAll(owner, true) }, []interface{}{owner, true}},
+		{"supportsInterface(bytes4)", func() ([]byte, error) { return packCurveUSDVaultSupportsInterface([4]byte{0x01, 0xff, 0xc9, 0xa7}) }, []interface{}{[4]byte{0x01, 0xff, 0xc9, 0xa7}}},
+		{
+			"safeTransferFrom(address,address,uint256,bytes)",
+			func() ([]byte, error) {
+				return packCurveUSDVaultSafeTransferFromWithData(owner, owner, big.NewInt(1), []byte("hello world, more than 32 bytes data"))
+			},
+			[]interface{}{owner, owner, big.NewInt(1), []byte("hello world, more than 32 bytes data")},
+		},
+		{
+			"safeTransferFrom(address,address,uint256,bytes)",
+			func() ([]byte, error) {
+				return packCurveUSDVaultSafeTransferFromWithData(owner, owner, big.NewInt(1), []byte{})
+			},
+			[]interface{}{owner, owner, big.NewInt(1), []byte{}},
+		},
+	}
+
+	for n := 0; n < 4; n++ {
+		args := newHelloArgs(n)
+
+		cases = append(cases, struct {
+			signature string
+			pack      func() ([]byte, error)
+			args      []interface{}
+		}{
+			helloSignature,
+			func() ([]byte, error) { return packCurveUSDVaultHello(args.tokenID, args.nft, args.nfts) },
+			[]interface{}{args.tokenID, args.nft, args.nfts},
+		})
+	}
+
+	for _, c := range cases {
+		expect, err := getFunc(t, contract, c.signature).Call(c.args...)
+
+		require.NoError(t, err, c.signature)
+
+		buff, err := c.pack()
+
+		require.NoError(t, err, c.signature)
+
+		require.Equal(t, expect, buff, c.signature)
+	}
+
+	_, err := packCurveUSDVaultApprove(owner, nil)
+
+	require.Error(t, err)
+
+	_, err = packCurveUSDVaultHello([20][]*big.Int{}, []*CurveNFT{nil}, nil)
+
+	require.Error(t, err)
+}
+
+func TestUnpackIdentical(t *testing.T) {
+	contract := loadContract(t)
+
+	data := getFunc(t, contract, "data(uint256)")
+
+	buff, err := data.EncodeOutputs(newCurveNFT(9))
+
+	require.NoError(t, err)
+
+	var expect *CurveNFT
+
+	_, err = data.Return(buff, []interface{}{&expect})
+
+	require.NoError(t, err)
+
+	nft, err := unpackCurveUSDVaultData(buff)
+
+	require.NoError(t, err)
+
+	requireNFTEqual(t, expect, nft)
+
+	buff, err = getFunc(t, contract, "name()").EncodeOutputs("Curve USD Vault NFT, a name longer than 32 bytes")
+
+	require.NoError(t, err)
+
+	name, err := unpackCurveUSDVaultName(buff)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "Curve USD Vault NFT, a name longer than 32 bytes", name)
+
+	buff, err = getFunc(t, contract, "supportsInterface(bytes4)").EncodeOutputs(true)
+
+	require.NoError(t, err)
+
+	ok, err := unpackCurveUSDVaultSupportsInterface(buff)
+
+	require.NoError(t, err)
+
+	require.True(t, ok)
+
+	// decode hello call data by struct codec and reflective tuple encoder
+	for n := 0; n < 4; n++ {
+		args := newHelloArgs(n)
+
+		buff, err := packCurveUSDVaultHello(args.tokenID, args.nft, args.nfts)
+
+		require.NoError(t, err)
+
+		var decoded struct {
+			TokenID [20][]*big.Int
+			NFT     []*CurveNFT
+			NFTs    [][2]*CurveNFT
+		}
+
+		_, err = getFunc(t, contract, helloSignature).DecodeInputs(buff, &decoded)
+
+		require.NoError(t, err)
+
+		for _, nft := range decoded.NFT {
+			content, err := nft.MarshalABI()
+
+			require.NoError(t, err)
+
+			var unmarshaled CurveNFT
+
+			l, err := unmarshaled.UnmarshalABI(content)
+
+			require.NoError(t, err)
+
+			require.Equal(t, uint(len(content)), l)
+
+			requireNFTEqual(t, nft, &unmarshaled)
+		}
+
+		require.Len(t, decoded.NFT, len(args.nft))
+
+		for i := range args.nft {
+			requireNFTEqual(t, args.nft[i], decoded.NFT[i])
+		}
+	}
+}
+
+func TestUnpackHostile(t *testing.T) {
+	buff, err := packCurveUSDVaultHello([20][]*big.Int{}, []*CurveNFT{newCurveNFT(1)}, nil)
+
+	require.NoError(t, err)
+
+	// truncated or garbage data must fail without panic
+	for i := 0; i < len(buff); i++ {
+		_, _ = unpackCurveUSDVaultName(buff[:i])
+
+		_, _ = unpackCurveUSDVaultData(buff[:i])
+
+		if i >= 4 {
+			_, _, _ = decodeCurveNFTSlice(buff[4:i])
+		}
+	}
+
+	_, err = unpackCurveUSDVaultName(append(make([]byte, 31), 0x20))
+
+	require.Error(t, err)
+}
+
+func BenchmarkPackReflect(b *testing.B) {
+	f := getFunc(b, loadContract(b), helloSignature)
+
+	args := newHelloArgs(8)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := f.Call(args.tokenID, args.nft, args.nfts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPackCodec(b *testing.B) {
+	args := newHelloArgs(8)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := packCurveUSDVaultHello(args.tokenID, args.nft, args.nfts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnpackReflect(b *testing.B) {
+	f := getFunc(b, loadContract(b), "data(uint256)")
+
+	buff, err := f.EncodeOutputs(newCurveNFT(9))
+
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var nft *CurveNFT
+
+		if _, err := f.Return(buff, []interface{}{&nft}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnpackCodec(b *testing.B) {
+	f := getFunc(b, loadContract(b), "data(uint256)")
+
+	buff, err := f.EncodeOutputs(newCurveNFT(9))
+
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := unpackCurveUSDVaultData(buff); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package importtest
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/abi/binding/internal/multitest"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// MatcherCaller view/pure funcs of contract Matcher
+type MatcherCaller interface {
+	MatchOrders(ctx context.Context, left *multitest.Order, right *multitest.Order) (ret0 *Result, err error)
+}
+
+// MatcherTransactor state-changing funcs of contract Matcher
+type MatcherTransactor interface {
+}
+
+// Matcher contract Matcher binding interface
+type Matcher interface {
+	MatcherCaller
+	MatcherTransactor
+}
+
+// MatcherABI json abi of contract Matcher
+const MatcherABI = `[{"inputs":[{"components":[{"internalType":"address","name":"maker","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"internalType":"struct LibOrder.Order","name":"left","type":"tuple"},{"components":[{"internalType":"address","name":"maker","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"internalType":"struct LibOrder.Order","name":"right","type":"tuple"}],"name":"matchOrders","outputs":[{"components":[{"internalType":"uint256","name":"filled","type":"uint256"},{"internalType":"bool","name":"done","type":"bool"}],"internalType":"struct Matcher.Result","name":"","type":"tuple"}],"stateMutability":"view","type":"function"}]`
+
+var (
+	parsedMatcherOnce sync.Once
+	parsedMatcher     abi.Contract
+	parsedMatcherErr  error
+)
+
+// MatcherContract returns the abi.Contract of MatcherABI, which is parsed once on first use
+func MatcherContract() (abi.Contract, error) {
+	parsedMatcherOnce.Do(func() {
+		parsedMatcher, parsedMatcherErr = binding.Parse("Matcher", []byte(MatcherABI), binding.NewSymbols())
+	})
+
+	return parsedMatcher, parsedMatcherErr
+}
+
+// NewMatcher create Matcher binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewMatcher(recipient address.Address, provider client.Provider, signer signer.Signer) (*MatcherImpl, error) {
+	contract, err := MatcherContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMatcherImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewMatcherCaller create MatcherCaller of contract deployed at recipient
+func NewMatcherCaller(recipient address.Address, provider client.Provider) (*MatcherCallerImpl, error) {
+	contract, err := MatcherContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &MatcherCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// MatcherCallerImpl MatcherCaller implementation calling contract via provider
+type MatcherCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// MatcherTransactorImpl MatcherTransactor implementation sending signed transactions via provider
+type MatcherTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// MatcherImpl Matcher implementation
+type MatcherImpl struct {
+	*MatcherCallerImpl
+	*MatcherTransactorImpl
+}
+
+// NewMatcherImpl create Matcher implementation of contract deployed at recipient
+func NewMatcherImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *MatcherImpl {
+	return &MatcherImpl{
+		MatcherCallerImpl: &MatcherCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		MatcherTransactorImpl: &MatcherTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ Matcher = (*MatcherImpl)(nil)
+
+func (impl *MatcherCallerImpl) MatchOrders(ctx context.Context, left *multitest.Order, right *multitest.Order) (ret0 *Result, err error) {
+	f, ok := impl.Contract.Select("849827c6")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func MatchOrders not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(left, right)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+// MockMatcher in-memory Matcher implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockMatcher struct {
+	MatchOrdersFunc func(ctx context.Context, left *multitest.Order, right *multitest.Order) (ret0 *Result, err error)
+}
+
+var _ Matcher = (*MockMatcher)(nil)
+
+func (mock *MockMatcher) MatchOrders(ctx context.Context, left *multitest.Order, right *multitest.Order) (ret0 *Result, err error) {
+	if mock.MatchOrdersFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func MatchOrders not stubbed")
+		return
+	}
+
+	return mock.MatchOrdersFunc(ctx, left, right)
+}
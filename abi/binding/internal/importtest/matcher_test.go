@@ -0,0 +1,52 @@
+package importtest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding/internal/multitest"
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportedTuple(t *testing.T) {
+	contract, err := MatcherContract()
+
+	require.NoError(t, err)
+
+	f, ok := abi.TryGetFunc(contract, "matchOrders((address,uint256),(address,uint256))")
+
+	require.True(t, ok)
+
+	maker := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	buff, err := f.Call(&multitest.Order{Maker: maker, Amount: big.NewInt(1)}, &multitest.Order{Maker: maker, Amount: big.NewInt(2)})
+
+	require.NoError(t, err)
+
+	var decoded struct {
+		Left  *multitest.Order
+		Right *multitest.Order
+	}
+
+	_, err = f.DecodeInputs(buff, &decoded)
+
+	require.NoError(t, err)
+
+	require.Equal(t, maker, decoded.Right.Maker)
+	require.Equal(t, int64(2), decoded.Right.Amount.Int64())
+
+	ret, err := f.EncodeOutputs(&Result{Filled: big.NewInt(3), Done: true})
+
+	require.NoError(t, err)
+
+	var result *Result
+
+	_, err = f.Return(ret, []interface{}{&result})
+
+	require.NoError(t, err)
+
+	require.True(t, result.Done)
+	require.Equal(t, int64(3), result.Filled.Int64())
+}
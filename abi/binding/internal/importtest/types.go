@@ -0,0 +1,11 @@
+package importtest
+
+import (
+	"math/big"
+)
+
+// Generated tuple "Result" stub code , do not modify manually
+type Result struct {
+	Filled *big.Int `abi:"filled"`
+	Done   bool     `abi:"done"`
+}
@@ -0,0 +1,211 @@
+package multitest
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// ExchangeCaller view/pure funcs of contract Exchange
+type ExchangeCaller interface {
+	GetOrder(ctx context.Context, hash [32]byte) (ret0 *Order, err error)
+}
+
+// ExchangeTransactor state-changing funcs of contract Exchange
+type ExchangeTransactor interface {
+	FillOrder(ctx context.Context, order *Order, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// Exchange contract Exchange binding interface
+type Exchange interface {
+	ExchangeCaller
+	ExchangeTransactor
+}
+
+// ExchangeABI json abi of contract Exchange
+const ExchangeABI = `[{"inputs":[{"components":[{"internalType":"address","name":"maker","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"internalType":"struct LibOrder.Order","name":"order","type":"tuple"}],"name":"fillOrder","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes32","name":"hash","type":"bytes32"}],"name":"getOrder","outputs":[{"components":[{"internalType":"address","name":"maker","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"internalType":"struct LibOrder.Order","name":"","type":"tuple"}],"stateMutability":"view","type":"function"}]`
+
+var (
+	parsedExchangeOnce sync.Once
+	parsedExchange     abi.Contract
+	parsedExchangeErr  error
+)
+
+// ExchangeContract returns the abi.Contract of ExchangeABI, which is parsed once on first use
+func ExchangeContract() (abi.Contract, error) {
+	parsedExchangeOnce.Do(func() {
+		parsedExchange, parsedExchangeErr = binding.Parse("Exchange", []byte(ExchangeABI), binding.NewSymbols())
+	})
+
+	return parsedExchange, parsedExchangeErr
+}
+
+// NewExchange create Exchange binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewExchange(recipient address.Address, provider client.Provider, signer signer.Signer) (*ExchangeImpl, error) {
+	contract, err := ExchangeContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewExchangeImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewExchangeCaller create ExchangeCaller of contract deployed at recipient
+func NewExchangeCaller(recipient address.Address, provider client.Provider) (*ExchangeCallerImpl, error) {
+	contract, err := ExchangeContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExchangeCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// ExchangeCallerImpl ExchangeCaller implementation calling contract via provider
+type ExchangeCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ExchangeTransactorImpl ExchangeTransactor implementation sending signed transactions via provider
+type ExchangeTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// ExchangeImpl Exchange implementation
+type ExchangeImpl struct {
+	*ExchangeCallerImpl
+	*ExchangeTransactorImpl
+}
+
+// NewExchangeImpl create Exchange implementation of contract deployed at recipient
+func NewExchangeImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *ExchangeImpl {
+	return &ExchangeImpl{
+		ExchangeCallerImpl: &ExchangeCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		ExchangeTransactorImpl: &ExchangeTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ Exchange = (*ExchangeImpl)(nil)
+
+func (impl *ExchangeCallerImpl) GetOrder(ctx context.Context, hash [32]byte) (ret0 *Order, err error) {
+	f, ok := impl.Contract.Select("5778472a")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetOrder not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(hash)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ExchangeTransactorImpl) FillOrder(ctx context.Context, order *Order, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("2354bbc1")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func FillOrder not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(order)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// MockExchange in-memory Exchange implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockExchange struct {
+	FillOrderFunc func(ctx context.Context, order *Order, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	GetOrderFunc  func(ctx context.Context, hash [32]byte) (ret0 *Order, err error)
+}
+
+var _ Exchange = (*MockExchange)(nil)
+
+func (mock *MockExchange) FillOrder(ctx context.Context, order *Order, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.FillOrderFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FillOrder not stubbed")
+		return
+	}
+
+	return mock.FillOrderFunc(ctx, order, ops...)
+}
+
+func (mock *MockExchange) GetOrder(ctx context.Context, hash [32]byte) (ret0 *Order, err error) {
+	if mock.GetOrderFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetOrder not stubbed")
+		return
+	}
+
+	return mock.GetOrderFunc(ctx, hash)
+}
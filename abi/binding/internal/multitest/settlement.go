@@ -0,0 +1,212 @@
+package multitest
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// SettlementCaller view/pure funcs of contract Settlement
+type SettlementCaller interface {
+	Batch(ctx context.Context, id *big.Int) (ret0 *SettlementOrder, err error)
+}
+
+// SettlementTransactor state-changing funcs of contract Settlement
+type SettlementTransactor interface {
+	Settle(ctx context.Context, orders []*Order, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// Settlement contract Settlement binding interface
+type Settlement interface {
+	SettlementCaller
+	SettlementTransactor
+}
+
+// SettlementABI json abi of contract Settlement
+const SettlementABI = `[{"inputs":[{"components":[{"internalType":"address","name":"maker","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"internalType":"struct LibOrder.Order[]","name":"orders","type":"tuple[]"}],"name":"settle","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"id","type":"uint256"}],"name":"batch","outputs":[{"components":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"bytes32","name":"hash","type":"bytes32"}],"internalType":"struct Settlement.Order","name":"","type":"tuple"}],"stateMutability":"view","type":"function"}]`
+
+var (
+	parsedSettlementOnce sync.Once
+	parsedSettlement     abi.Contract
+	parsedSettlementErr  error
+)
+
+// SettlementContract returns the abi.Contract of SettlementABI, which is parsed once on first use
+func SettlementContract() (abi.Contract, error) {
+	parsedSettlementOnce.Do(func() {
+		parsedSettlement, parsedSettlementErr = binding.Parse("Settlement", []byte(SettlementABI), binding.NewSymbols())
+	})
+
+	return parsedSettlement, parsedSettlementErr
+}
+
+// NewSettlement create Settlement binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewSettlement(recipient address.Address, provider client.Provider, signer signer.Signer) (*SettlementImpl, error) {
+	contract, err := SettlementContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSettlementImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewSettlementCaller create SettlementCaller of contract deployed at recipient
+func NewSettlementCaller(recipient address.Address, provider client.Provider) (*SettlementCallerImpl, error) {
+	contract, err := SettlementContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &SettlementCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// SettlementCallerImpl SettlementCaller implementation calling contract via provider
+type SettlementCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// SettlementTransactorImpl SettlementTransactor implementation sending signed transactions via provider
+type SettlementTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// SettlementImpl Settlement implementation
+type SettlementImpl struct {
+	*SettlementCallerImpl
+	*SettlementTransactorImpl
+}
+
+// NewSettlementImpl create Settlement implementation of contract deployed at recipient
+func NewSettlementImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *SettlementImpl {
+	return &SettlementImpl{
+		SettlementCallerImpl: &SettlementCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		SettlementTransactorImpl: &SettlementTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ Settlement = (*SettlementImpl)(nil)
+
+func (impl *SettlementCallerImpl) Batch(ctx context.Context, id *big.Int) (ret0 *SettlementOrder, err error) {
+	f, ok := impl.Contract.Select("0eaa75fe")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Batch not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(id)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *SettlementTransactorImpl) Settle(ctx context.Context, orders []*Order, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("064e6aab")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Settle not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(orders)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// MockSettlement in-memory Settlement implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockSettlement struct {
+	SettleFunc func(ctx context.Context, orders []*Order, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	BatchFunc  func(ctx context.Context, id *big.Int) (ret0 *SettlementOrder, err error)
+}
+
+var _ Settlement = (*MockSettlement)(nil)
+
+func (mock *MockSettlement) Settle(ctx context.Context, orders []*Order, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SettleFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Settle not stubbed")
+		return
+	}
+
+	return mock.SettleFunc(ctx, orders, ops...)
+}
+
+func (mock *MockSettlement) Batch(ctx context.Context, id *big.Int) (ret0 *SettlementOrder, err error) {
+	if mock.BatchFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Batch not stubbed")
+		return
+	}
+
+	return mock.BatchFunc(ctx, id)
+}
@@ -0,0 +1,19 @@
+package multitest
+
+import (
+	"math/big"
+
+	"github.com/libs4go/ethers/address"
+)
+
+// Generated tuple "Order" stub code , do not modify manually
+type Order struct {
+	Maker  address.Address `abi:"maker"`
+	Amount *big.Int        `abi:"amount"`
+}
+
+// Generated tuple "SettlementOrder" stub code , do not modify manually
+type SettlementOrder struct {
+	Id   *big.Int `abi:"id"`
+	Hash [32]byte `abi:"hash"`
+}
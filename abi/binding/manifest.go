@@ -0,0 +1,92 @@
+package binding
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+
+	"github.com/libs4go/errors"
+)
+
+// TypesManifest describes the tuple structs of a generated package, which is used by WithImport
+// to reference the structs instead of generating them again
+type TypesManifest struct {
+	Package string           `json:"package"` // import path of generated package
+	Name    string           `json:"name"`    // package name, default is the last element of import path
+	Tuples  []*ManifestTuple `json:"tuples"`
+}
+
+// ManifestTuple tuple struct of generated package
+type ManifestTuple struct {
+	ID        string `json:"id"`
+	GoName    string `json:"goName"`
+	Structure string `json:"structure"`
+}
+
+// Manifest returns the manifest of tuple structs generated by this generator, importPath is the
+// import path of the package written by WriteTypes/WriteDir
+func (impl *Generator) Manifest(importPath string, packageName string) *TypesManifest {
+	manifest := &TypesManifest{
+		Package: importPath,
+		Name:    packageName,
+	}
+
+	for _, id := range impl.tupleIDs() {
+		tuple := impl.tuples[id]
+
+		if tuple.Package != "" {
+			continue
+		}
+
+		manifest.Tuples = append(manifest.Tuples, &ManifestTuple{
+			ID:        id,
+			GoName:    tuple.BindingName,
+			Structure: tuple.Structure,
+		})
+	}
+
+	return manifest
+}
+
+// ParseManifestFile load TypesManifest json file
+func ParseManifestFile(filename string) (*TypesManifest, error) {
+	buff, err := ioutil.ReadFile(filename)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "read file: %s error", filename)
+	}
+
+	manifest := &TypesManifest{}
+
+	if err := json.Unmarshal(buff, manifest); err != nil {
+		return nil, errors.Wrap(err, "parse types manifest %s error", filename)
+	}
+
+	if manifest.Package == "" {
+		return nil, errors.Wrap(ErrBinding, "types manifest %s package expect", filename)
+	}
+
+	return manifest, nil
+}
+
+// WithImport reference the tuple structs of previously generated package, a struct with the same
+// identity or the same name and components is imported instead of generated, and a struct with
+// the same identity but different components is reported as conflict
+func WithImport(manifest *TypesManifest) GenOption {
+	return func(gen *Generator) {
+		name := manifest.Name
+
+		if name == "" {
+			name = path.Base(manifest.Package)
+		}
+
+		for _, tuple := range manifest.Tuples {
+			gen.tuples[tuple.ID] = &Tuple{
+				ID:          tuple.ID,
+				BindingName: name + "." + tuple.GoName,
+				Structure:   tuple.Structure,
+				Package:     manifest.Package,
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package binding
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+)
+
+// MergeABI merge json abi arrays or compiler artifacts into one json abi array, e.g. the facet
+// abis of EIP-2535 diamond are merged to bind the diamond as one contract. The identical entries
+// are kept once, the first constructor, fallback and receive are kept, functions of the same
+// selector or events of the same signature with different declarations are rejected
+func MergeABI(abis ...[]byte) ([]byte, error) {
+	var merged []json.RawMessage
+
+	seen := make(map[string]json.RawMessage)
+	signatures := make(map[string]string)
+
+	for i, data := range abis {
+		artifact, err := ParseArtifact(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var raws []json.RawMessage
+
+		if err := json.Unmarshal(artifact.ABI, &raws); err != nil {
+			return nil, errors.Wrap(err, "parse json abi #%d error", i)
+		}
+
+		for _, raw := range raws {
+			var field abi.JSONField
+
+			if err := json.Unmarshal(raw, &field); err != nil {
+				return nil, errors.Wrap(err, "parse json abi #%d error", i)
+			}
+
+			var key string
+
+			switch field.Type {
+			case abi.JSONTypeFunc:
+				key = "function:" + hex.EncodeToString(abi.Selector(field.Signature()))
+			case abi.JSONTypeEvent, abi.JSONTypeError:
+				key = string(field.Type) + ":" + field.Signature()
+			case abi.JSONTypeConstructor, abi.JSONTypeFallback, abi.JSONTypeReceive:
+				if _, ok := seen[string(field.Type)]; !ok {
+					seen[string(field.Type)] = raw
+					merged = append(merged, raw)
+				}
+
+				continue
+			default:
+				merged = append(merged, raw)
+				continue
+			}
+
+			prev, ok := seen[key]
+
+			if !ok {
+				seen[key] = raw
+				signatures[key] = field.Signature()
+				merged = append(merged, raw)
+				continue
+			}
+
+			same, err := sameEntry(prev, raw)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !same {
+				return nil, errors.Wrap(ErrConflict, "abi #%d %s %s conflicts with %s", i, field.Type, field.Signature(), signatures[key])
+			}
+		}
+	}
+
+	buff, err := json.Marshal(merged)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal merged abi error")
+	}
+
+	return buff, nil
+}
+
+// sameEntry compare abi entries ignoring param names, internal types and key order
+func sameEntry(a json.RawMessage, b json.RawMessage) (bool, error) {
+	normalize := func(raw json.RawMessage) ([]byte, error) {
+		var field abi.JSONField
+
+		if err := json.Unmarshal(raw, &field); err != nil {
+			return nil, err
+		}
+
+		field.Inputs = stripParams(field.Inputs)
+		field.Outputs = stripParams(field.Outputs)
+
+		return json.Marshal(&field)
+	}
+
+	left, err := normalize(a)
+
+	if err != nil {
+		return false, err
+	}
+
+	right, err := normalize(b)
+
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(left, right), nil
+}
+
+func stripParams(params []*abi.JSONParam) []*abi.JSONParam {
+	var result []*abi.JSONParam
+
+	for _, param := range params {
+		result = append(result, &abi.JSONParam{
+			Type:       param.Type,
+			Components: stripParams(param.Components),
+			Indexed:    param.Indexed,
+		})
+	}
+
+	return result
+}
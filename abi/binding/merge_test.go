@@ -0,0 +1,63 @@
+package binding
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeABI(t *testing.T) {
+	vault := `[
+		{"type":"constructor","inputs":[{"name":"owner","type":"address"}],"stateMutability":"nonpayable"},
+		{"type":"function","name":"deposit","inputs":[{"name":"amount","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+		{"type":"function","name":"supportsInterface","inputs":[{"name":"id","type":"bytes4"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},
+		{"type":"event","name":"Deposit","inputs":[{"name":"amount","type":"uint256","indexed":false}],"anonymous":false}
+	]`
+
+	// artifact with the shared supportsInterface, whose param name differs
+	ownable := `{"abi":[
+		{"type":"constructor","inputs":[],"stateMutability":"nonpayable"},
+		{"type":"function","name":"owner","inputs":[],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"},
+		{"type":"function","name":"supportsInterface","inputs":[{"name":"interfaceId","type":"bytes4"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"}
+	]}`
+
+	merged, err := MergeABI([]byte(vault), []byte(ownable))
+
+	require.NoError(t, err)
+
+	var fields []*abi.JSONField
+
+	require.NoError(t, json.Unmarshal(merged, &fields))
+
+	var signatures []string
+
+	for _, field := range fields {
+		signatures = append(signatures, string(field.Type)+" "+field.Signature())
+	}
+
+	require.Equal(t, []string{
+		"constructor (address)",
+		"function deposit(uint256)",
+		"function supportsInterface(bytes4)",
+		"event Deposit(uint256)",
+		"function owner()",
+	}, signatures)
+
+	contract, err := Parse("Diamond", merged, NewSymbols())
+
+	require.NoError(t, err)
+
+	_, ok := abi.TryGetFunc(contract, "owner()")
+
+	require.True(t, ok)
+
+	// same selector with different mutability
+	conflict := `[{"type":"function","name":"deposit","inputs":[{"name":"amount","type":"uint256"}],"outputs":[],"stateMutability":"payable"}]`
+
+	_, err = MergeABI([]byte(vault), []byte(conflict))
+
+	require.True(t, errors.Is(err, ErrConflict))
+}
@@ -0,0 +1,362 @@
+package binding
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+)
+
+// reservedLocals identifiers used by generated method bodies and imports, params with these
+// names are escaped
+var reservedLocals = map[string]bool{
+	"ctx": true, "ops": true, "impl": true, "mock": true, "f": true, "ok": true, "buff": true,
+	"err": true, "ret": true, "callSite": true, "callOps": true, "l": true,
+	"abi": true, "binding": true, "client": true, "signer": true, "errors": true, "hex": true,
+	"strings": true, "context": true, "big": true, "address": true, "fixed": true,
+}
+
+// eventLocals identifiers used by generated event methods, indexed params with these names are escaped
+var eventLocals = map[string]bool{
+	"opts": true, "sink": true, "e": true, "topics": true, "logs": true, "log": true, "event": true,
+	"events": true, "sub": true,
+}
+
+// eventParamName convert solidity indexed param name to unexported go identifier of filter param
+func eventParamName(name string) string {
+	ident := goParamName(name)
+
+	if eventLocals[ident] {
+		return ident + "_"
+	}
+
+	return ident
+}
+
+// reservedMethods field names of generated implementation structs, which can not be used as method name
+var reservedMethods = map[string]bool{
+	"Contract": true, "Client": true, "Signer": true, "Recipient": true,
+}
+
+// GoName convert solidity identifier to exported go identifier, e.g. _data -> Data
+func GoName(name string) string {
+	ident := camelCase(name)
+
+	if ident == "" {
+		return ""
+	}
+
+	runes := []rune(ident)
+
+	runes[0] = unicode.ToUpper(runes[0])
+
+	if !unicode.IsLetter(runes[0]) {
+		return "X" + string(runes)
+	}
+
+	return string(runes)
+}
+
+// goParamName convert solidity param name to unexported go identifier, escape go keywords and
+// identifiers reserved by generated code with "_" suffix
+func goParamName(name string) string {
+	ident := camelCase(name)
+
+	if ident == "" {
+		return ""
+	}
+
+	runes := []rune(ident)
+
+	if !unicode.IsLetter(runes[0]) {
+		return "p" + ident
+	}
+
+	if token.IsKeyword(ident) || reservedLocals[ident] {
+		return ident + "_"
+	}
+
+	return ident
+}
+
+// camelCase strip leading/trailing underscores and invalid characters of solidity identifier,
+// inner underscores start a new upper case word, e.g. _token_id -> tokenId
+func camelCase(name string) string {
+	var builder strings.Builder
+
+	upper := false
+
+	for _, r := range strings.Trim(name, "_$") {
+		if r == '_' || r == '$' {
+			upper = true
+			continue
+		}
+
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			continue
+		}
+
+		if upper && builder.Len() > 0 {
+			r = unicode.ToUpper(r)
+		}
+
+		upper = false
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+// uniqueNames make param names unique, empty name is replaced by prefix<index>
+func uniqueNames(names []string, prefix string, used map[string]bool) []string {
+	result := make([]string, len(names))
+
+	for i, name := range names {
+		if name == "" {
+			name = fmt.Sprintf("%s%d", prefix, i)
+		}
+
+		candidate := name
+
+		for j := 1; used[candidate]; j++ {
+			candidate = fmt.Sprintf("%s%d", name, j)
+		}
+
+		used[candidate] = true
+		result[i] = candidate
+	}
+
+	return result
+}
+
+// overloadSuffix returns the name suffix of overloaded func, derived from the params which are
+// not in base func, e.g. safeTransferFrom(address,address,uint256,bytes _data) -> WithData
+func overloadSuffix(base *Func, f *Func, byType bool) string {
+	inputs := f.inputEncoders
+	names := f.inputNames
+
+	if base != nil && !byType && isPrefix(base.inputEncoders, inputs) {
+		inputs = inputs[len(base.inputEncoders):]
+		names = names[len(base.inputEncoders):]
+	}
+
+	if len(inputs) == 0 {
+		return "WithNoArgs"
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("With")
+
+	for i, input := range inputs {
+		name := GoName(names[i])
+
+		if byType || name == "" {
+			name = typeName(input)
+		}
+
+		if i > 0 && !byType {
+			builder.WriteString("And")
+		}
+
+		builder.WriteString(name)
+	}
+
+	return builder.String()
+}
+
+func isPrefix(prefix []abi.Encoder, encoders []abi.Encoder) bool {
+	if len(prefix) > len(encoders) {
+		return false
+	}
+
+	for i, encoder := range prefix {
+		if encoder.String() != encoders[i].String() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveOverloads name overloaded funcs independent of the abi declaration order:
+//
+// - the func with the fewest params keeps the base name
+// - others append a suffix derived from the extra param names, e.g. SafeTransferFromWithData
+// - fall back to param types suffix if the derived names collide, e.g. TransferWithAddressUint256
+func resolveOverloads(name string, funcs []*Func) {
+	sort.Slice(funcs, func(i, j int) bool {
+		if len(funcs[i].inputEncoders) != len(funcs[j].inputEncoders) {
+			return len(funcs[i].inputEncoders) < len(funcs[j].inputEncoders)
+		}
+
+		return funcs[i].Signature < funcs[j].Signature
+	})
+
+	var base *Func
+
+	if len(funcs) == 1 || len(funcs[0].inputEncoders) < len(funcs[1].inputEncoders) {
+		base = funcs[0]
+	}
+
+	used := make(map[string]int)
+
+	for _, f := range funcs {
+		if f == base {
+			f.Name = name
+		} else {
+			f.Name = name + overloadSuffix(base, f, false)
+		}
+
+		used[f.Name]++
+	}
+
+	for _, f := range funcs {
+		if f != base && used[f.Name] > 1 {
+			f.Name = name + overloadSuffix(base, f, true)
+		}
+	}
+}
+
+// resolveNames assign go method names of contract funcs, returns error if the names collide
+func (c *Contract) resolveNames(overrides map[string]string) error {
+	groups := make(map[string][]*Func)
+
+	var order []string
+
+	for _, f := range c.Funcs {
+		if _, ok := groups[f.solidityName]; !ok {
+			order = append(order, f.solidityName)
+		}
+
+		groups[f.solidityName] = append(groups[f.solidityName], f)
+	}
+
+	for _, name := range order {
+		resolveOverloads(GoName(name), groups[name])
+	}
+
+	for _, f := range c.Funcs {
+		override, ok := overrides[c.Name+"."+f.Signature]
+
+		if !ok {
+			override, ok = overrides[f.Signature]
+		}
+
+		if !ok {
+			continue
+		}
+
+		if !token.IsIdentifier(override) || !token.IsExported(override) {
+			return errors.Wrap(ErrNaming, "contract %s func %s: override name '%s' is not an exported go identifier", c.Name, f.Signature, override)
+		}
+
+		f.Name = override
+	}
+
+	names := make(map[string]*Func)
+
+	for _, f := range c.Funcs {
+		if f.Name == "" {
+			return errors.Wrap(ErrNaming, "contract %s func %s: can not derive go name, use name override", c.Name, f.Signature)
+		}
+
+		if reservedMethods[f.Name] {
+			return errors.Wrap(ErrNaming, "contract %s func %s: go name %s is reserved, use name override", c.Name, f.Signature, f.Name)
+		}
+
+		if other, ok := names[f.Name]; ok {
+			return errors.Wrap(ErrNaming, "contract %s: func %s and %s have the same go name %s, use name override", c.Name, other.Signature, f.Signature, f.Name)
+		}
+
+		names[f.Name] = f
+	}
+
+	// mock struct fields are named <Func>Func
+	for _, f := range c.Funcs {
+		if other, ok := names[f.Name+"Func"]; ok {
+			return errors.Wrap(ErrNaming, "contract %s: mock field of func %s collides with func %s, use name override", c.Name, f.Signature, other.Signature)
+		}
+	}
+
+	return c.resolveEventNames(overrides, names)
+}
+
+// resolveEventNames assign go names of contract events like funcs, returns error if the event
+// methods Filter<Event>/Watch<Event> or their mock fields collide with funcs
+func (c *Contract) resolveEventNames(overrides map[string]string, funcs map[string]*Func) error {
+	groups := make(map[string][]*Func)
+	events := make(map[*Func]*Event)
+
+	var order []string
+
+	for _, e := range c.Events {
+		if _, ok := groups[e.solidityName]; !ok {
+			order = append(order, e.solidityName)
+		}
+
+		// overloads are resolved by the same rules of funcs
+		f := &Func{
+			Signature:     e.Signature,
+			solidityName:  e.solidityName,
+			inputNames:    e.inputNames,
+			inputEncoders: e.inputEncoders,
+		}
+
+		events[f] = e
+		groups[e.solidityName] = append(groups[e.solidityName], f)
+	}
+
+	for _, name := range order {
+		resolveOverloads(GoName(name), groups[name])
+
+		for _, f := range groups[name] {
+			events[f].Name = f.Name
+		}
+	}
+
+	names := make(map[string]*Event)
+
+	for _, e := range c.Events {
+		override, ok := overrides[c.Name+"."+e.Signature]
+
+		if !ok {
+			override, ok = overrides[e.Signature]
+		}
+
+		if ok {
+			if !token.IsIdentifier(override) || !token.IsExported(override) {
+				return errors.Wrap(ErrNaming, "contract %s event %s: override name '%s' is not an exported go identifier", c.Name, e.Signature, override)
+			}
+
+			e.Name = override
+		}
+
+		if e.Name == "" {
+			return errors.Wrap(ErrNaming, "contract %s event %s: can not derive go name, use name override", c.Name, e.Signature)
+		}
+
+		if other, ok := names[e.Name]; ok {
+			return errors.Wrap(ErrNaming, "contract %s: event %s and %s have the same go name %s, use name override", c.Name, other.Signature, e.Signature, e.Name)
+		}
+
+		names[e.Name] = e
+
+		for _, method := range []string{"Filter" + e.Name, "Watch" + e.Name} {
+			if f, ok := funcs[method]; ok {
+				return errors.Wrap(ErrNaming, "contract %s: method %s of event %s collides with func %s, use name override", c.Name, method, e.Signature, f.Signature)
+			}
+
+			if f, ok := funcs[method+"Func"]; ok {
+				return errors.Wrap(ErrNaming, "contract %s: mock field of event %s collides with func %s, use name override", c.Name, e.Signature, f.Signature)
+			}
+		}
+	}
+
+	return nil
+}
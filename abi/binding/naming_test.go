@@ -0,0 +1,141 @@
+package binding
+
+import (
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoName(t *testing.T) {
+	require.Equal(t, "Data", GoName("_data"))
+	require.Equal(t, "TokenId", GoName("token_id"))
+	require.Equal(t, "DAO", GoName("DAO"))
+	require.Equal(t, "X0x", GoName("_0x"))
+	require.Equal(t, "", GoName("_"))
+
+	require.Equal(t, "data", goParamName("_data"))
+	require.Equal(t, "type_", goParamName("type"))
+	require.Equal(t, "ctx_", goParamName("ctx"))
+	require.Equal(t, "address_", goParamName("address"))
+	require.Equal(t, "p1", goParamName("_1"))
+}
+
+func genNames(t *testing.T, fragments []string, options ...GenOption) (map[string]string, error) {
+	generator := NewGen(options...)
+
+	_, err := ParseHumanReadable("Token", fragments, generator)
+
+	require.NoError(t, err)
+
+	names := make(map[string]string)
+
+	for _, f := range generator.contracts[0].Funcs {
+		names[f.Signature] = f.Name
+	}
+
+	return names, generator.err
+}
+
+func TestOverloadNames(t *testing.T) {
+	fragments := []string{
+		"function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data)",
+		"function safeTransferFrom(address from, address to, uint256 tokenId)",
+		"function transfer(address to, uint256 amount) returns (bool)",
+		"function transfer(uint256 amount, address to) returns (bool)",
+		"function mint(uint256 amount)",
+		"function approve(address spender, uint256 amount)",
+		"function approve(uint256 spender, uint256 amount)",
+		"function mint(address to, uint256 amount)",
+	}
+
+	names, err := genNames(t, fragments)
+
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"safeTransferFrom(address,address,uint256)":       "SafeTransferFrom",
+		"safeTransferFrom(address,address,uint256,bytes)": "SafeTransferFromWithData",
+		"transfer(address,uint256)":                       "TransferWithToAndAmount",
+		"transfer(uint256,address)":                       "TransferWithAmountAndTo",
+		"approve(address,uint256)":                        "ApproveWithAddressUint256",
+		"approve(uint256,uint256)":                        "ApproveWithUint256Uint256",
+		"mint(uint256)":                                   "Mint",
+		"mint(address,uint256)":                           "MintWithToAndAmount",
+	}, names)
+
+	// reordered abi keeps the names
+	reversed := make([]string, len(fragments))
+
+	for i, fragment := range fragments {
+		reversed[len(fragments)-1-i] = fragment
+	}
+
+	reversedNames, err := genNames(t, reversed)
+
+	require.NoError(t, err)
+
+	require.Equal(t, names, reversedNames)
+}
+
+func TestNameOverrides(t *testing.T) {
+	names, err := genNames(t, []string{
+		"function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data)",
+		"function safeTransferFrom(address from, address to, uint256 tokenId)",
+	}, WithNames(map[string]string{
+		"Token.safeTransferFrom(address,address,uint256,bytes)": "SafeTransferFromAndCall",
+	}))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "SafeTransferFromAndCall", names["safeTransferFrom(address,address,uint256,bytes)"])
+
+	_, err = genNames(t, []string{
+		"function transfer(address to, uint256 amount)",
+	}, WithNames(map[string]string{
+		"transfer(address,uint256)": "send",
+	}))
+
+	require.True(t, errors.Is(err, ErrNaming))
+}
+
+func TestNameCollisions(t *testing.T) {
+	cases := [][]string{
+		{"function signer() view returns (address)"},
+		{"function foo()", "function fooFunc()"},
+		{"function _foo()", "function foo()"},
+	}
+
+	for _, fragments := range cases {
+		_, err := genNames(t, fragments)
+
+		require.True(t, errors.Is(err, ErrNaming), "%v", fragments)
+	}
+
+	names, err := genNames(t, []string{"function signer() view returns (address)"}, WithNames(map[string]string{
+		"signer()": "GetSigner",
+	}))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "GetSigner", names["signer()"])
+}
+
+func TestParamNames(t *testing.T) {
+	generator := NewGen()
+
+	_, err := ParseHumanReadable("Token", []string{
+		"function foo(uint256 type, address _data, uint256 data, bytes) view returns (uint256 type, bool)",
+		"function bar(address ret0)",
+	}, generator)
+
+	require.NoError(t, err)
+
+	require.NoError(t, generator.err)
+
+	funcs := generator.contracts[0].Funcs
+
+	require.Equal(t, "type_ *big.Int, data address.Address, data1 *big.Int, param3 []byte", funcs[0].GoInputParams)
+	require.Equal(t, "type_1 *big.Int, ret1 bool, err error", funcs[0].GoOutputParams)
+	require.Equal(t, "ret01 address.Address, ops ...abi.Op", funcs[1].GoInputParams)
+}
@@ -0,0 +1,360 @@
+package binding
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/libs4go/errors"
+)
+
+// DocEntry NatSpec documentation of func, event or error
+type DocEntry struct {
+	Notice  string            // @notice from userdoc
+	Details string            // @dev from devdoc
+	Params  map[string]string // @param from devdoc, keyed by solidity param name
+	Returns map[string]string // @return from devdoc, keyed by output name or _<index> of unnamed output
+}
+
+// NatSpec contract documentation merged from solc userdoc and devdoc
+type NatSpec struct {
+	Title   string
+	Author  string
+	Notice  string
+	Details string
+	Methods map[string]*DocEntry // keyed by canonical signature, e.g. transfer(address,uint256)
+	Events  map[string]*DocEntry // keyed by canonical signature
+	Errors  map[string]*DocEntry // keyed by canonical signature
+}
+
+type docJSON struct {
+	Notice  string            `json:"notice"`
+	Details string            `json:"details"`
+	Params  map[string]string `json:"params"`
+	Returns map[string]string `json:"returns"`
+}
+
+type natSpecJSON struct {
+	Kind    string                     `json:"kind"`
+	Title   string                     `json:"title"`
+	Author  string                     `json:"author"`
+	Notice  string                     `json:"notice"`
+	Details string                     `json:"details"`
+	Methods map[string]*docJSON        `json:"methods"`
+	Events  map[string]*docJSON        `json:"events"`
+	Errors  map[string]json.RawMessage `json:"errors"` // solc emits array of docs per error signature
+}
+
+// NewNatSpec create empty NatSpec
+func NewNatSpec() *NatSpec {
+	return &NatSpec{
+		Methods: make(map[string]*DocEntry),
+		Events:  make(map[string]*DocEntry),
+		Errors:  make(map[string]*DocEntry),
+	}
+}
+
+// ParseNatSpec parse and merge solc userdoc/devdoc json, each data can be userdoc, devdoc or
+// an object with "userdoc" and "devdoc" fields
+func ParseNatSpec(data ...[]byte) (*NatSpec, error) {
+	doc := NewNatSpec()
+
+	for _, buff := range data {
+		if err := doc.merge(buff); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// ParseNatSpecFile parse and merge NatSpec json files, see ParseNatSpec
+func ParseNatSpecFile(filenames ...string) (*NatSpec, error) {
+	var data [][]byte
+
+	for _, filename := range filenames {
+		buff, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "read file: %s error", filename)
+		}
+
+		data = append(data, buff)
+	}
+
+	return ParseNatSpec(data...)
+}
+
+func (doc *NatSpec) merge(data []byte) error {
+	var docs struct {
+		UserDoc json.RawMessage `json:"userdoc"`
+		DevDoc  json.RawMessage `json:"devdoc"`
+	}
+
+	if err := json.Unmarshal(data, &docs); err != nil {
+		return errors.Wrap(err, "parse natspec json error")
+	}
+
+	if docs.UserDoc != nil || docs.DevDoc != nil {
+		for _, raw := range []json.RawMessage{docs.UserDoc, docs.DevDoc} {
+			if raw == nil {
+				continue
+			}
+
+			if err := doc.merge(raw); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var spec natSpecJSON
+
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return errors.Wrap(err, "parse natspec json error")
+	}
+
+	doc.Title = first(doc.Title, spec.Title)
+	doc.Author = first(doc.Author, spec.Author)
+	doc.Notice = first(doc.Notice, spec.Notice)
+	doc.Details = first(doc.Details, spec.Details)
+
+	mergeEntries(doc.Methods, spec.Methods)
+	mergeEntries(doc.Events, spec.Events)
+
+	for signature, raw := range spec.Errors {
+		var entries []*docJSON
+
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			var entry docJSON
+
+			if err := json.Unmarshal(raw, &entry); err != nil {
+				return errors.Wrap(err, "parse natspec error %s doc error", signature)
+			}
+
+			entries = []*docJSON{&entry}
+		}
+
+		mergeEntries(doc.Errors, map[string]*docJSON{signature: mergeDocJSON(entries)})
+	}
+
+	return nil
+}
+
+// Merge merge other documentation into doc, the existing text of doc takes precedence
+func (doc *NatSpec) Merge(other *NatSpec) {
+	if other == nil {
+		return
+	}
+
+	doc.Title = first(doc.Title, other.Title)
+	doc.Author = first(doc.Author, other.Author)
+	doc.Notice = first(doc.Notice, other.Notice)
+	doc.Details = first(doc.Details, other.Details)
+
+	for _, pair := range [][2]map[string]*DocEntry{{doc.Methods, other.Methods}, {doc.Events, other.Events}, {doc.Errors, other.Errors}} {
+		for signature, entry := range pair[1] {
+			pair[0][signature] = mergeEntry(pair[0][signature], entry)
+		}
+	}
+}
+
+func mergeDocJSON(entries []*docJSON) *docJSON {
+	merged := &docJSON{}
+
+	for _, entry := range entries {
+		merged.Notice = first(merged.Notice, entry.Notice)
+		merged.Details = first(merged.Details, entry.Details)
+
+		if merged.Params == nil {
+			merged.Params = entry.Params
+		}
+	}
+
+	return merged
+}
+
+func mergeEntries(target map[string]*DocEntry, source map[string]*docJSON) {
+	for signature, entry := range source {
+		target[signature] = mergeEntry(target[signature], &DocEntry{
+			Notice:  entry.Notice,
+			Details: entry.Details,
+			Params:  entry.Params,
+			Returns: entry.Returns,
+		})
+	}
+}
+
+func mergeEntry(target *DocEntry, source *DocEntry) *DocEntry {
+	if target == nil {
+		target = &DocEntry{}
+	}
+
+	target.Notice = first(target.Notice, source.Notice)
+	target.Details = first(target.Details, source.Details)
+
+	if target.Params == nil {
+		target.Params = source.Params
+	}
+
+	if target.Returns == nil {
+		target.Returns = source.Returns
+	}
+
+	return target
+}
+
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// commentLines write text as go comment lines, the first line is prefixed with prefix
+func commentLines(buff *bytes.Buffer, prefix string, text string) {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+
+		if i == 0 && prefix != "" {
+			line = prefix + " " + line
+		}
+
+		buff.WriteString(strings.TrimRight("// "+line, " "))
+		buff.WriteString("\n")
+	}
+}
+
+// namedList write the NatSpec @param/@return list, keys are mapped to go names
+func namedList(buff *bytes.Buffer, title string, names []string, goNames []string, docs map[string]string) {
+	var items []string
+
+	for i, name := range names {
+		text, ok := docs[name]
+
+		if !ok || name == "" {
+			text, ok = docs[fmt.Sprintf("_%d", i)]
+		}
+
+		if !ok {
+			continue
+		}
+
+		items = append(items, fmt.Sprintf("  - %s: %s", goNames[i], strings.Join(strings.Fields(text), " ")))
+	}
+
+	if len(items) == 0 {
+		return
+	}
+
+	buff.WriteString("//\n// " + title + ":\n")
+
+	for _, item := range items {
+		buff.WriteString("//" + item + "\n")
+	}
+}
+
+// funcDoc render go doc comment of generated method
+func (doc *NatSpec) funcDoc(f *Func) string {
+	if doc == nil {
+		return ""
+	}
+
+	entry, ok := doc.Methods[f.Signature]
+
+	if !ok {
+		return ""
+	}
+
+	var buff bytes.Buffer
+
+	switch {
+	case entry.Notice != "":
+		commentLines(&buff, f.Name, entry.Notice)
+
+		if entry.Details != "" {
+			buff.WriteString("//\n")
+			commentLines(&buff, "Dev:", entry.Details)
+		}
+	case entry.Details != "":
+		commentLines(&buff, f.Name, entry.Details)
+	default:
+		buff.WriteString("// " + f.Name + " " + f.Signature + "\n")
+	}
+
+	namedList(&buff, "Parameters", f.inputNames, f.goInputNames, entry.Params)
+
+	if f.ReadOnly {
+		namedList(&buff, "Returns", f.outputRawNames, f.outputNames, entry.Returns)
+	}
+
+	return buff.String()
+}
+
+// eventDoc render go doc comment of generated event struct, the @param list is mapped to fields
+func (doc *NatSpec) eventDoc(typeName string, e *Event) string {
+	if doc == nil {
+		return ""
+	}
+
+	entry, ok := doc.Events[e.Signature]
+
+	if !ok {
+		return ""
+	}
+
+	var buff bytes.Buffer
+
+	switch {
+	case entry.Notice != "":
+		commentLines(&buff, typeName, entry.Notice)
+
+		if entry.Details != "" {
+			buff.WriteString("//\n")
+			commentLines(&buff, "Dev:", entry.Details)
+		}
+	case entry.Details != "":
+		commentLines(&buff, typeName, entry.Details)
+	default:
+		buff.WriteString("// " + typeName + " event " + e.Signature + "\n")
+	}
+
+	var fields []string
+
+	for _, field := range e.Fields {
+		fields = append(fields, field.Name)
+	}
+
+	namedList(&buff, "Fields", e.inputNames, fields, entry.Params)
+
+	return buff.String()
+}
+
+// contractDoc render the contract level documentation, appended to the interface doc comment
+func (doc *NatSpec) contractDoc() string {
+	if doc == nil {
+		return ""
+	}
+
+	var buff bytes.Buffer
+
+	// labelled paragraphs, which are not recognized as doc headings by gofmt
+	for _, item := range [][2]string{{"Title:", doc.Title}, {"Notice:", doc.Notice}, {"Dev:", doc.Details}, {"Author:", doc.Author}} {
+		if item[1] == "" {
+			continue
+		}
+
+		buff.WriteString("//\n")
+		commentLines(&buff, item[0], item[1])
+	}
+
+	return buff.String()
+}
@@ -0,0 +1,84 @@
+package binding
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNatSpec(t *testing.T) {
+	doc, err := ParseNatSpec([]byte(`{
+		"kind": "user",
+		"methods": {"transfer(address,uint256)": {"notice": "Moves tokens"}},
+		"errors": {"InsufficientBalance(uint256)": [{"notice": "Balance too low"}]}
+	}`), []byte(`{
+		"kind": "dev",
+		"title": "Token",
+		"methods": {"transfer(address,uint256)": {"details": "Emits Transfer", "params": {"to": "The receiver"}}},
+		"events": {"Transfer(address,address,uint256)": {"details": "Emitted on transfer"}}
+	}`))
+
+	require.NoError(t, err)
+
+	require.Equal(t, "Token", doc.Title)
+	require.Equal(t, &DocEntry{
+		Notice:  "Moves tokens",
+		Details: "Emits Transfer",
+		Params:  map[string]string{"to": "The receiver"},
+	}, doc.Methods["transfer(address,uint256)"])
+	require.Equal(t, "Balance too low", doc.Errors["InsufficientBalance(uint256)"].Notice)
+	require.Equal(t, "Emitted on transfer", doc.Events["Transfer(address,address,uint256)"].Details)
+
+	_, err = ParseNatSpec([]byte(`[]`))
+
+	require.Error(t, err)
+}
+
+func TestArtifactNatSpec(t *testing.T) {
+	// foundry artifact carries NatSpec in metadata output
+	artifact := []byte(`{
+		"abi": [{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}],
+		"metadata": {"output": {"devdoc": {"kind": "dev", "methods": {"owner()": {"returns": {"_0": "The owner"}}}}}}
+	}`)
+
+	generator := NewGen()
+
+	contract, err := Parse("Ownable", artifact, generator)
+
+	require.NoError(t, err)
+
+	_, ok := contract.Select("8da5cb5b")
+
+	require.True(t, ok)
+
+	require.Equal(t, "// Owner owner()\n//\n// Returns:\n//  - ret0: The owner\n", generator.contracts[0].Funcs[0].Doc)
+}
+
+func TestSeparateNatSpec(t *testing.T) {
+	artifact, err := ioutil.ReadFile("./testdata/NatSpecToken.json")
+
+	require.NoError(t, err)
+
+	parsed, err := ParseArtifact(artifact)
+
+	require.NoError(t, err)
+
+	// abi array with separate natspec json generates the same code as artifact
+	generator := NewGen(WithNatSpec("NatSpecToken", parsed.NatSpec))
+
+	_, err = Parse("NatSpecToken", parsed.ABI, generator)
+
+	require.NoError(t, err)
+
+	var writerBuffer bytes.Buffer
+
+	require.NoError(t, generator.Write("bindtest", &writerBuffer))
+
+	expect, err := ioutil.ReadFile("./internal/bindtest/natspec.go")
+
+	require.NoError(t, err)
+
+	require.Equal(t, string(expect), writerBuffer.String())
+}
@@ -3,183 +3,248 @@ package testdata
 import (
 	"context"
 	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
 
 	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/abi"
 	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
 	"github.com/libs4go/ethers/client"
 	"github.com/libs4go/ethers/signer"
-
-	"math/big"
-
-	"github.com/libs4go/ethers/address"
 )
 
 // Generated tuple "CurveNFT" stub code , do not modify manually
 type CurveNFT struct {
-	CommissionAmount *big.Int
-
-	Created *big.Int
-
-	Deposit address.Address
-
-	DepositAmount *big.Int
-
-	Id *big.Int
-}
-
-// type CurveUSDVault interface {
-//
-// 	DAO(ctx context.Context, )(ret0 address.Address, err error)
-//
-// 	Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	BalanceOf(ctx context.Context, owner address.Address)(ret0 *big.Int, err error)
-//
-// 	Burn(ctx context.Context, tokenId *big.Int, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	BurnRequire(ctx context.Context, tokenId *big.Int)(ret0 *big.Int, err error)
-//
-// 	CommissionRate(ctx context.Context, )(ret0 *big.Int, err error)
-//
-// 	Data(ctx context.Context, tokenId *big.Int)(ret0 *CurveNFT, err error)
-//
-// 	Deposit(ctx context.Context, recipient address.Address, asset address.Address, amount *big.Int, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	GetApproved(ctx context.Context, tokenId *big.Int)(ret0 address.Address, err error)
-//
-// 	Hello(ctx context.Context, tokenId [20][]*big.Int, nft []*CurveNFT, nfts [][2]*CurveNFT, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address)(ret0 bool, err error)
-//
-// 	Name(ctx context.Context, )(ret0 string, err error)
-//
-// 	Owner(ctx context.Context, )(ret0 address.Address, err error)
-//
-// 	OwnerOf(ctx context.Context, tokenId *big.Int)(ret0 address.Address, err error)
-//
-// 	RenounceOwnership(ctx context.Context, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	SafeTransferFrom1(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, _data []byte, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	SupportsInterface(ctx context.Context, interfaceId [4]byte)(ret0 bool, err error)
-//
-// 	Symbol(ctx context.Context, )(ret0 string, err error)
-//
-// 	TokenByIndex(ctx context.Context, index *big.Int)(ret0 *big.Int, err error)
-//
-// 	TokenOfOwnerByIndex(ctx context.Context, owner address.Address, index *big.Int)(ret0 *big.Int, err error)
-//
-// 	TokenURI(ctx context.Context, tokenId *big.Int)(ret0 string, err error)
-//
-// 	TotalSupply(ctx context.Context, )(ret0 *big.Int, err error)
-//
-// 	TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	TransferOwnership(ctx context.Context, newOwner address.Address, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	Usd(ctx context.Context, )(ret0 address.Address, err error)
-//
-// 	Withdraw(ctx context.Context, recipient address.Address, tokenId *big.Int, ops ...abi.Op)(ret0 abi.Transaction, err error)
-//
-// 	WithdrawAmount(ctx context.Context, tokenId *big.Int)(ret0 *big.Int, err error)
-//
-// 	Withdrawable(ctx context.Context, tokenId *big.Int)(ret0 bool, err error)
-//
-// }
-
-type CurveUSDVault struct {
-	Contract  abi.Contract
-	Client    client.Provider
-	Signer    signer.Signer
-	Recipient string
+	Id               *big.Int        `abi:"id"`
+	Created          *big.Int        `abi:"created"`
+	Deposit          address.Address `abi:"deposit"`
+	DepositAmount    *big.Int        `abi:"depositAmount"`
+	CommissionAmount *big.Int        `abi:"commissionAmount"`
 }
 
-func (impl *CurveUSDVault) DAO(ctx context.Context) (ret0 address.Address, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "98fabd3a")
-
-	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func DAO not found")
-		return
-	}
+// CurveUSDVaultCaller view/pure funcs of contract CurveUSDVault
+type CurveUSDVaultCaller interface {
+	DAO(ctx context.Context) (ret0 address.Address, err error)
+	BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	BurnRequire(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error)
+	CommissionRate(ctx context.Context) (ret0 *big.Int, err error)
+	Data(ctx context.Context, tokenId *big.Int) (ret0 *CurveNFT, err error)
+	GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error)
+	Name(ctx context.Context) (ret0 string, err error)
+	Owner(ctx context.Context) (ret0 address.Address, err error)
+	OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error)
+	Symbol(ctx context.Context) (ret0 string, err error)
+	TokenByIndex(ctx context.Context, index *big.Int) (ret0 *big.Int, err error)
+	TokenOfOwnerByIndex(ctx context.Context, owner address.Address, index *big.Int) (ret0 *big.Int, err error)
+	TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error)
+	TotalSupply(ctx context.Context) (ret0 *big.Int, err error)
+	Usd(ctx context.Context) (ret0 address.Address, err error)
+	WithdrawAmount(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error)
+	Withdrawable(ctx context.Context, tokenId *big.Int) (ret0 bool, err error)
+}
 
-	var buff []byte
+// CurveUSDVaultTransactor state-changing funcs of contract CurveUSDVault
+type CurveUSDVaultTransactor interface {
+	Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Burn(ctx context.Context, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Deposit(ctx context.Context, recipient address.Address, asset address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Hello(ctx context.Context, tokenId [20][]*big.Int, nft []*CurveNFT, nfts [][2]*CurveNFT, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RenounceOwnership(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFromWithData(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferOwnership(ctx context.Context, newOwner address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Withdraw(ctx context.Context, recipient address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
 
-	buff, err = f.Call()
+// CurveUSDVaultFilterer event filterers and watchers of contract CurveUSDVault
+type CurveUSDVaultFilterer interface {
+	FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultApprovalEvent, err error)
+	WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+	FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*CurveUSDVaultApprovalForAllEvent, err error)
+	WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error)
+	FilterDeposit(ctx context.Context, opts *binding.FilterOpts, tokenId []*big.Int, commission []*big.Int) (events []*CurveUSDVaultDepositEvent, err error)
+	WatchDeposit(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultDepositEvent, tokenId []*big.Int, commission []*big.Int) (sub client.Subscription, err error)
+	FilterOwnershipTransferred(ctx context.Context, opts *binding.FilterOpts, previousOwner []address.Address, newOwner []address.Address) (events []*CurveUSDVaultOwnershipTransferredEvent, err error)
+	WatchOwnershipTransferred(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultOwnershipTransferredEvent, previousOwner []address.Address, newOwner []address.Address) (sub client.Subscription, err error)
+	FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultTransferEvent, err error)
+	WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultTransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+}
 
-	if err != nil {
-		return
-	}
+// CurveUSDVault contract CurveUSDVault binding interface
+type CurveUSDVault interface {
+	CurveUSDVaultCaller
+	CurveUSDVaultTransactor
+	CurveUSDVaultFilterer
+}
 
-	callSite := &client.CallSite{
-		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
-	}
+// CurveUSDVaultApprovalEvent event Approval(address,address,uint256) of contract CurveUSDVault
+type CurveUSDVaultApprovalEvent struct {
+	Owner    address.Address
+	Approved address.Address
+	TokenId  *big.Int
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
 
-	var ret string
+// CurveUSDVaultApprovalForAllEvent event ApprovalForAll(address,address,bool) of contract CurveUSDVault
+type CurveUSDVaultApprovalForAllEvent struct {
+	Owner    address.Address
+	Operator address.Address
+	Approved bool
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
 
-	ret, err = impl.Client.Call(ctx, callSite)
+// CurveUSDVaultDepositEvent event Deposit(uint256,uint256) of contract CurveUSDVault
+type CurveUSDVaultDepositEvent struct {
+	TokenId    *big.Int
+	Commission *big.Int
+	Raw        *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
 
-	if err != nil {
-		return
-	}
+// CurveUSDVaultOwnershipTransferredEvent event OwnershipTransferred(address,address) of contract CurveUSDVault
+type CurveUSDVaultOwnershipTransferredEvent struct {
+	PreviousOwner address.Address
+	NewOwner      address.Address
+	Raw           *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
 
-	buff, err = hex.DecodeString(ret)
+// CurveUSDVaultTransferEvent event Transfer(address,address,uint256) of contract CurveUSDVault
+type CurveUSDVaultTransferEvent struct {
+	From    address.Address
+	To      address.Address
+	TokenId *big.Int
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
 
-	if err != nil {
-		return
-	}
+// CurveUSDVaultABI json abi of contract CurveUSDVault
+const CurveUSDVaultABI = `[{"inputs":[{"internalType":"contract ICurveDAO","name":"DAO_","type":"address"},{"internalType":"uint256","name":"commissionRate_","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"approved","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Approval","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"operator","type":"address"},{"indexed":false,"internalType":"bool","name":"approved","type":"bool"}],"name":"ApprovalForAll","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"},{"indexed":true,"internalType":"uint256","name":"commission","type":"uint256"}],"name":"Deposit","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"previousOwner","type":"address"},{"indexed":true,"internalType":"address","name":"newOwner","type":"address"}],"name":"OwnershipTransferred","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":true,"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[],"name":"DAO","outputs":[{"internalType":"contract ICurveDAO","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"approve","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"burn","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"burnRequire","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"commissionRate","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"data","outputs":[{"components":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"created","type":"uint256"},{"internalType":"address","name":"deposit","type":"address"},{"internalType":"uint256","name":"depositAmount","type":"uint256"},{"internalType":"uint256","name":"commissionAmount","type":"uint256"}],"internalType":"struct CurveNFT","name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"address","name":"asset","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"deposit","outputs":[{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"getApproved","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256[][20]","name":"tokenId","type":"uint256[][20]"},{"components":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"created","type":"uint256"},{"internalType":"address","name":"deposit","type":"address"},{"internalType":"uint256","name":"depositAmount","type":"uint256"},{"internalType":"uint256","name":"commissionAmount","type":"uint256"}],"internalType":"struct CurveNFT[]","name":"nft","type":"tuple[]"},{"components":[{"internalType":"uint256","name":"id","type":"uint256"},{"internalType":"uint256","name":"created","type":"uint256"},{"internalType":"address","name":"deposit","type":"address"},{"internalType":"uint256","name":"depositAmount","type":"uint256"},{"internalType":"uint256","name":"commissionAmount","type":"uint256"}],"internalType":"struct CurveNFT[2][]","name":"nfts","type":"tuple[2][]"}],"name":"hello","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"operator","type":"address"}],"name":"isApprovedForAll","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"ownerOf","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"renounceOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"bytes","name":"_data","type":"bytes"}],"name":"safeTransferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"operator","type":"address"},{"internalType":"bool","name":"approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"bytes4","name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"symbol","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"index","type":"uint256"}],"name":"tokenByIndex","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"uint256","name":"index","type":"uint256"}],"name":"tokenOfOwnerByIndex","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"tokenURI","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"transferFrom","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"newOwner","type":"address"}],"name":"transferOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"usd","outputs":[{"internalType":"contract CurveUSD","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"withdraw","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"withdrawAmount","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"withdrawable","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`
 
-	_, err = f.Return(buff, []interface{}{&ret0})
+var (
+	parsedCurveUSDVaultOnce sync.Once
+	parsedCurveUSDVault     abi.Contract
+	parsedCurveUSDVaultErr  error
+)
 
-	return
+// CurveUSDVaultContract returns the abi.Contract of CurveUSDVaultABI, which is parsed once on first use
+func CurveUSDVaultContract() (abi.Contract, error) {
+	parsedCurveUSDVaultOnce.Do(func() {
+		parsedCurveUSDVault, parsedCurveUSDVaultErr = binding.Parse("CurveUSDVault", []byte(CurveUSDVaultABI), binding.NewSymbols())
+	})
 
+	return parsedCurveUSDVault, parsedCurveUSDVaultErr
 }
 
-func (impl *CurveUSDVault) Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "095ea7b3")
+// NewCurveUSDVault create CurveUSDVault binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewCurveUSDVault(recipient address.Address, provider client.Provider, signer signer.Signer) (*CurveUSDVaultImpl, error) {
+	contract, err := CurveUSDVaultContract()
 
-	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Approve not found")
-		return
+	if err != nil {
+		return nil, err
 	}
 
-	var buff []byte
+	return NewCurveUSDVaultImpl(contract, provider, signer, recipient.Hex()), nil
+}
 
-	buff, err = f.Call(to, tokenId)
+// NewCurveUSDVaultCaller create CurveUSDVaultCaller of contract deployed at recipient
+func NewCurveUSDVaultCaller(recipient address.Address, provider client.Provider) (*CurveUSDVaultCallerImpl, error) {
+	contract, err := CurveUSDVaultContract()
 
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	return &CurveUSDVaultCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewCurveUSDVaultFilterer create CurveUSDVaultFilterer of contract deployed at recipient
+func NewCurveUSDVaultFilterer(recipient address.Address, provider client.Provider) (*CurveUSDVaultFiltererImpl, error) {
+	contract, err := CurveUSDVaultContract()
 
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+	return &CurveUSDVaultFiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
 
-	return
+// CurveUSDVaultCallerImpl CurveUSDVaultCaller implementation calling contract via provider
+type CurveUSDVaultCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// CurveUSDVaultTransactorImpl CurveUSDVaultTransactor implementation sending signed transactions via provider
+type CurveUSDVaultTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// CurveUSDVaultFiltererImpl CurveUSDVaultFilterer implementation querying and subscribing logs via provider
+type CurveUSDVaultFiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// CurveUSDVaultImpl CurveUSDVault implementation
+type CurveUSDVaultImpl struct {
+	*CurveUSDVaultCallerImpl
+	*CurveUSDVaultTransactorImpl
+	*CurveUSDVaultFiltererImpl
+}
 
+// NewCurveUSDVaultImpl create CurveUSDVault implementation of contract deployed at recipient
+func NewCurveUSDVaultImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *CurveUSDVaultImpl {
+	return &CurveUSDVaultImpl{
+		CurveUSDVaultCallerImpl: &CurveUSDVaultCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		CurveUSDVaultTransactorImpl: &CurveUSDVaultTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		CurveUSDVaultFiltererImpl: &CurveUSDVaultFiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
 }
 
-func (impl *CurveUSDVault) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "70a08231")
+var _ CurveUSDVault = (*CurveUSDVaultImpl)(nil)
+
+func (impl *CurveUSDVaultCallerImpl) DAO(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("98fabd3a")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		err = errors.Wrap(binding.ErrBinding, "func DAO not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(owner)
+	buff, err = f.Call()
 
 	if err != nil {
 		return
@@ -187,7 +252,7 @@ func (impl *CurveUSDVault) BalanceOf(ctx context.Context, owner address.Address)
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -198,7 +263,7 @@ func (impl *CurveUSDVault) BalanceOf(ctx context.Context, owner address.Address)
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -207,40 +272,50 @@ func (impl *CurveUSDVault) BalanceOf(ctx context.Context, owner address.Address)
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Burn(ctx context.Context, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "42966c68")
+func (impl *CurveUSDVaultCallerImpl) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("70a08231")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Burn not found")
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(tokenId)
+	buff, err = f.Call(owner)
 
 	if err != nil {
 		return
 	}
 
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
-	return
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
 
+	return
 }
 
-func (impl *CurveUSDVault) BurnRequire(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "c3a95aeb")
+func (impl *CurveUSDVaultCallerImpl) BurnRequire(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("c3a95aeb")
 
 	if !ok {
 		err = errors.Wrap(binding.ErrBinding, "func BurnRequire not found")
@@ -257,7 +332,7 @@ func (impl *CurveUSDVault) BurnRequire(ctx context.Context, tokenId *big.Int) (r
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -268,7 +343,7 @@ func (impl *CurveUSDVault) BurnRequire(ctx context.Context, tokenId *big.Int) (r
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -277,11 +352,10 @@ func (impl *CurveUSDVault) BurnRequire(ctx context.Context, tokenId *big.Int) (r
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) CommissionRate(ctx context.Context) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "5ea1d6f8")
+func (impl *CurveUSDVaultCallerImpl) CommissionRate(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("5ea1d6f8")
 
 	if !ok {
 		err = errors.Wrap(binding.ErrBinding, "func CommissionRate not found")
@@ -298,7 +372,7 @@ func (impl *CurveUSDVault) CommissionRate(ctx context.Context) (ret0 *big.Int, e
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -309,7 +383,7 @@ func (impl *CurveUSDVault) CommissionRate(ctx context.Context) (ret0 *big.Int, e
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -318,11 +392,10 @@ func (impl *CurveUSDVault) CommissionRate(ctx context.Context) (ret0 *big.Int, e
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Data(ctx context.Context, tokenId *big.Int) (ret0 *CurveNFT, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "f0ba8440")
+func (impl *CurveUSDVaultCallerImpl) Data(ctx context.Context, tokenId *big.Int) (ret0 *CurveNFT, err error) {
+	f, ok := impl.Contract.Select("f0ba8440")
 
 	if !ok {
 		err = errors.Wrap(binding.ErrBinding, "func Data not found")
@@ -339,7 +412,7 @@ func (impl *CurveUSDVault) Data(ctx context.Context, tokenId *big.Int) (ret0 *Cu
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -350,7 +423,7 @@ func (impl *CurveUSDVault) Data(ctx context.Context, tokenId *big.Int) (ret0 *Cu
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -359,49 +432,59 @@ func (impl *CurveUSDVault) Data(ctx context.Context, tokenId *big.Int) (ret0 *Cu
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Deposit(ctx context.Context, recipient address.Address, asset address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "8340f549")
+func (impl *CurveUSDVaultCallerImpl) GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("081812fc")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Deposit not found")
+		err = errors.Wrap(binding.ErrBinding, "func GetApproved not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(recipient, asset, amount)
+	buff, err = f.Call(tokenId)
 
 	if err != nil {
 		return
 	}
 
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
-	return
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
 
+	return
 }
 
-func (impl *CurveUSDVault) GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "081812fc")
+func (impl *CurveUSDVaultCallerImpl) IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("e985e9c5")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func GetApproved not found")
+		err = errors.Wrap(binding.ErrBinding, "func IsApprovedForAll not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(tokenId)
+	buff, err = f.Call(owner, operator)
 
 	if err != nil {
 		return
@@ -409,7 +492,7 @@ func (impl *CurveUSDVault) GetApproved(ctx context.Context, tokenId *big.Int) (r
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -420,7 +503,7 @@ func (impl *CurveUSDVault) GetApproved(ctx context.Context, tokenId *big.Int) (r
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -429,49 +512,59 @@ func (impl *CurveUSDVault) GetApproved(ctx context.Context, tokenId *big.Int) (r
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Hello(ctx context.Context, tokenId [20][]*big.Int, nft []*CurveNFT, nfts [][2]*CurveNFT, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "039ef37b")
+func (impl *CurveUSDVaultCallerImpl) Name(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("06fdde03")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Hello not found")
+		err = errors.Wrap(binding.ErrBinding, "func Name not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(tokenId, nft, nfts)
+	buff, err = f.Call()
 
 	if err != nil {
 		return
 	}
 
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
-	return
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
 
+	return
 }
 
-func (impl *CurveUSDVault) IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "e985e9c5")
+func (impl *CurveUSDVaultCallerImpl) Owner(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("8da5cb5b")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func IsApprovedForAll not found")
+		err = errors.Wrap(binding.ErrBinding, "func Owner not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(owner, operator)
+	buff, err = f.Call()
 
 	if err != nil {
 		return
@@ -479,7 +572,7 @@ func (impl *CurveUSDVault) IsApprovedForAll(ctx context.Context, owner address.A
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -490,7 +583,7 @@ func (impl *CurveUSDVault) IsApprovedForAll(ctx context.Context, owner address.A
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -499,20 +592,19 @@ func (impl *CurveUSDVault) IsApprovedForAll(ctx context.Context, owner address.A
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Name(ctx context.Context) (ret0 string, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "06fdde03")
+func (impl *CurveUSDVaultCallerImpl) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("6352211e")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Name not found")
+		err = errors.Wrap(binding.ErrBinding, "func OwnerOf not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call()
+	buff, err = f.Call(tokenId)
 
 	if err != nil {
 		return
@@ -520,7 +612,7 @@ func (impl *CurveUSDVault) Name(ctx context.Context) (ret0 string, err error) {
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -531,7 +623,7 @@ func (impl *CurveUSDVault) Name(ctx context.Context) (ret0 string, err error) {
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -540,20 +632,19 @@ func (impl *CurveUSDVault) Name(ctx context.Context) (ret0 string, err error) {
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Owner(ctx context.Context) (ret0 address.Address, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "8da5cb5b")
+func (impl *CurveUSDVaultCallerImpl) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("01ffc9a7")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Owner not found")
+		err = errors.Wrap(binding.ErrBinding, "func SupportsInterface not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call()
+	buff, err = f.Call(interfaceId)
 
 	if err != nil {
 		return
@@ -561,7 +652,7 @@ func (impl *CurveUSDVault) Owner(ctx context.Context) (ret0 address.Address, err
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -572,7 +663,7 @@ func (impl *CurveUSDVault) Owner(ctx context.Context) (ret0 address.Address, err
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -581,20 +672,19 @@ func (impl *CurveUSDVault) Owner(ctx context.Context) (ret0 address.Address, err
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "6352211e")
+func (impl *CurveUSDVaultCallerImpl) Symbol(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("95d89b41")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func OwnerOf not found")
+		err = errors.Wrap(binding.ErrBinding, "func Symbol not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(tokenId)
+	buff, err = f.Call()
 
 	if err != nil {
 		return
@@ -602,7 +692,7 @@ func (impl *CurveUSDVault) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -613,7 +703,7 @@ func (impl *CurveUSDVault) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -622,136 +712,99 @@ func (impl *CurveUSDVault) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) RenounceOwnership(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "715018a6")
+func (impl *CurveUSDVaultCallerImpl) TokenByIndex(ctx context.Context, index *big.Int) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("4f6ccce7")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func RenounceOwnership not found")
+		err = errors.Wrap(binding.ErrBinding, "func TokenByIndex not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call()
-
-	if err != nil {
-		return
-	}
-
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	buff, err = f.Call(index)
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
-
-	return
-
-}
-
-func (impl *CurveUSDVault) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "42842e0e")
-
-	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFrom not found")
-		return
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
-	var buff []byte
+	var ret string
 
-	buff, err = f.Call(from, to, tokenId)
+	ret, err = impl.Client.Call(ctx, callSite)
 
 	if err != nil {
 		return
 	}
 
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) SafeTransferFrom1(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, _data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "b88d4fde")
+func (impl *CurveUSDVaultCallerImpl) TokenOfOwnerByIndex(ctx context.Context, owner address.Address, index *big.Int) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("2f745c59")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFrom1 not found")
+		err = errors.Wrap(binding.ErrBinding, "func TokenOfOwnerByIndex not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(from, to, tokenId, _data)
-
-	if err != nil {
-		return
-	}
-
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	buff, err = f.Call(owner, index)
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
-
-	return
-
-}
-
-func (impl *CurveUSDVault) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "a22cb465")
-
-	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func SetApprovalForAll not found")
-		return
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
-	var buff []byte
+	var ret string
 
-	buff, err = f.Call(operator, approved)
+	ret, err = impl.Client.Call(ctx, callSite)
 
 	if err != nil {
 		return
 	}
 
-	var callOps *abi.CallOps
-	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
 	}
 
-	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "01ffc9a7")
+func (impl *CurveUSDVaultCallerImpl) TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("c87b56dd")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func SupportsInterface not found")
+		err = errors.Wrap(binding.ErrBinding, "func TokenURI not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(interfaceId)
+	buff, err = f.Call(tokenId)
 
 	if err != nil {
 		return
@@ -759,7 +812,7 @@ func (impl *CurveUSDVault) SupportsInterface(ctx context.Context, interfaceId [4
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -770,7 +823,7 @@ func (impl *CurveUSDVault) SupportsInterface(ctx context.Context, interfaceId [4
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -779,14 +832,13 @@ func (impl *CurveUSDVault) SupportsInterface(ctx context.Context, interfaceId [4
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) Symbol(ctx context.Context) (ret0 string, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "95d89b41")
+func (impl *CurveUSDVaultCallerImpl) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("18160ddd")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Symbol not found")
+		err = errors.Wrap(binding.ErrBinding, "func TotalSupply not found")
 		return
 	}
 
@@ -800,7 +852,7 @@ func (impl *CurveUSDVault) Symbol(ctx context.Context) (ret0 string, err error)
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -811,7 +863,7 @@ func (impl *CurveUSDVault) Symbol(ctx context.Context) (ret0 string, err error)
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -820,20 +872,19 @@ func (impl *CurveUSDVault) Symbol(ctx context.Context) (ret0 string, err error)
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) TokenByIndex(ctx context.Context, index *big.Int) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "4f6ccce7")
+func (impl *CurveUSDVaultCallerImpl) Usd(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("d63a6ccd")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func TokenByIndex not found")
+		err = errors.Wrap(binding.ErrBinding, "func Usd not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(index)
+	buff, err = f.Call()
 
 	if err != nil {
 		return
@@ -841,7 +892,7 @@ func (impl *CurveUSDVault) TokenByIndex(ctx context.Context, index *big.Int) (re
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -852,7 +903,7 @@ func (impl *CurveUSDVault) TokenByIndex(ctx context.Context, index *big.Int) (re
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -861,20 +912,19 @@ func (impl *CurveUSDVault) TokenByIndex(ctx context.Context, index *big.Int) (re
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) TokenOfOwnerByIndex(ctx context.Context, owner address.Address, index *big.Int) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "2f745c59")
+func (impl *CurveUSDVaultCallerImpl) WithdrawAmount(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("0562b9f7")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func TokenOfOwnerByIndex not found")
+		err = errors.Wrap(binding.ErrBinding, "func WithdrawAmount not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(owner, index)
+	buff, err = f.Call(tokenId)
 
 	if err != nil {
 		return
@@ -882,7 +932,7 @@ func (impl *CurveUSDVault) TokenOfOwnerByIndex(ctx context.Context, owner addres
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -893,7 +943,7 @@ func (impl *CurveUSDVault) TokenOfOwnerByIndex(ctx context.Context, owner addres
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -902,14 +952,13 @@ func (impl *CurveUSDVault) TokenOfOwnerByIndex(ctx context.Context, owner addres
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "c87b56dd")
+func (impl *CurveUSDVaultCallerImpl) Withdrawable(ctx context.Context, tokenId *big.Int) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("f11988e0")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func TokenURI not found")
+		err = errors.Wrap(binding.ErrBinding, "func Withdrawable not found")
 		return
 	}
 
@@ -923,7 +972,7 @@ func (impl *CurveUSDVault) TokenURI(ctx context.Context, tokenId *big.Int) (ret0
 
 	callSite := &client.CallSite{
 		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+		Data: "0x" + hex.EncodeToString(buff),
 	}
 
 	var ret string
@@ -934,7 +983,7 @@ func (impl *CurveUSDVault) TokenURI(ctx context.Context, tokenId *big.Int) (ret0
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
 
 	if err != nil {
 		return
@@ -943,61 +992,103 @@ func (impl *CurveUSDVault) TokenURI(ctx context.Context, tokenId *big.Int) (ret0
 	_, err = f.Return(buff, []interface{}{&ret0})
 
 	return
-
 }
 
-func (impl *CurveUSDVault) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "18160ddd")
+func (impl *CurveUSDVaultTransactorImpl) Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("095ea7b3")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func TotalSupply not found")
+		err = errors.Wrap(binding.ErrBinding, "func Approve not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call()
+	buff, err = f.Call(to, tokenId)
 
 	if err != nil {
 		return
 	}
 
-	callSite := &client.CallSite{
-		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
 	}
 
-	var ret string
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
-	ret, err = impl.Client.Call(ctx, callSite)
+	return
+}
+
+func (impl *CurveUSDVaultTransactorImpl) Burn(ctx context.Context, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("42966c68")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Burn not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenId)
 
 	if err != nil {
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
 
 	if err != nil {
 		return
 	}
 
-	_, err = f.Return(buff, []interface{}{&ret0})
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
 	return
+}
 
+func (impl *CurveUSDVaultTransactorImpl) Deposit(ctx context.Context, recipient address.Address, asset address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("8340f549")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Deposit not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(recipient, asset, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
 }
 
-func (impl *CurveUSDVault) TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "23b872dd")
+func (impl *CurveUSDVaultTransactorImpl) Hello(ctx context.Context, tokenId [20][]*big.Int, nft []*CurveNFT, nfts [][2]*CurveNFT, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("23c0e129")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func TransferFrom not found")
+		err = errors.Wrap(binding.ErrBinding, "func Hello not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(from, to, tokenId)
+	buff, err = f.Call(tokenId, nft, nfts)
 
 	if err != nil {
 		return
@@ -1013,20 +1104,47 @@ func (impl *CurveUSDVault) TransferFrom(ctx context.Context, from address.Addres
 	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
 	return
+}
+
+func (impl *CurveUSDVaultTransactorImpl) RenounceOwnership(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("715018a6")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RenounceOwnership not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
+	return
 }
 
-func (impl *CurveUSDVault) TransferOwnership(ctx context.Context, newOwner address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "f2fde38b")
+func (impl *CurveUSDVaultTransactorImpl) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("42842e0e")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func TransferOwnership not found")
+		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFrom not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call(newOwner)
+	buff, err = f.Call(from, to, tokenId)
 
 	if err != nil {
 		return
@@ -1042,52 +1160,122 @@ func (impl *CurveUSDVault) TransferOwnership(ctx context.Context, newOwner addre
 	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
 	return
+}
+
+func (impl *CurveUSDVaultTransactorImpl) SafeTransferFromWithData(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("b88d4fde")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFromWithData not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, tokenId, data)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
+	return
 }
 
-func (impl *CurveUSDVault) Usd(ctx context.Context) (ret0 address.Address, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "d63a6ccd")
+func (impl *CurveUSDVaultTransactorImpl) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a22cb465")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Usd not found")
+		err = errors.Wrap(binding.ErrBinding, "func SetApprovalForAll not found")
 		return
 	}
 
 	var buff []byte
 
-	buff, err = f.Call()
+	buff, err = f.Call(operator, approved)
 
 	if err != nil {
 		return
 	}
 
-	callSite := &client.CallSite{
-		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
 	}
 
-	var ret string
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
-	ret, err = impl.Client.Call(ctx, callSite)
+	return
+}
+
+func (impl *CurveUSDVaultTransactorImpl) TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("23b872dd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, tokenId)
 
 	if err != nil {
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
 
 	if err != nil {
 		return
 	}
 
-	_, err = f.Return(buff, []interface{}{&ret0})
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
 	return
+}
+
+func (impl *CurveUSDVaultTransactorImpl) TransferOwnership(ctx context.Context, newOwner address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("f2fde38b")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferOwnership not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(newOwner)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
 
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
 }
 
-func (impl *CurveUSDVault) Withdraw(ctx context.Context, recipient address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "f3fef3a3")
+func (impl *CurveUSDVaultTransactorImpl) Withdraw(ctx context.Context, recipient address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("f3fef3a3")
 
 	if !ok {
 		err = errors.Wrap(binding.ErrBinding, "func Withdraw not found")
@@ -1112,87 +1300,776 @@ func (impl *CurveUSDVault) Withdraw(ctx context.Context, recipient address.Addre
 	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
 
 	return
+}
+
+// FilterApproval returns Approval events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *CurveUSDVaultFiltererImpl) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultApprovalEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, approved, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &CurveUSDVaultApprovalEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Approved, &event.TokenId}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
 
+	return
 }
 
-func (impl *CurveUSDVault) WithdrawAmount(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "0562b9f7")
+// WatchApproval stream Approval events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *CurveUSDVaultFiltererImpl) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func WithdrawAmount not found")
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
 		return
 	}
 
-	var buff []byte
+	var topics [][]string
 
-	buff, err = f.Call(tokenId)
+	topics, err = e.FilterTopics(owner, approved, tokenId)
 
 	if err != nil {
 		return
 	}
 
-	callSite := &client.CallSite{
-		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &CurveUSDVaultApprovalEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Approved, &event.TokenId}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterApprovalForAll returns ApprovalForAll events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *CurveUSDVaultFiltererImpl) FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*CurveUSDVaultApprovalForAllEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event ApprovalForAll not found")
+		return
 	}
 
-	var ret string
+	var topics [][]string
 
-	ret, err = impl.Client.Call(ctx, callSite)
+	topics, err = e.FilterTopics(owner, operator)
 
 	if err != nil {
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
 
 	if err != nil {
 		return
 	}
 
-	_, err = f.Return(buff, []interface{}{&ret0})
+	for _, log := range logs {
+		event := &CurveUSDVaultApprovalForAllEvent{Raw: log}
 
-	return
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Operator, &event.Approved}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
 
+	return
 }
 
-func (impl *CurveUSDVault) Withdrawable(ctx context.Context, tokenId *big.Int) (ret0 bool, err error) {
-	f, ok := abi.TryGetFunc(impl.Contract, "f11988e0")
+// WatchApprovalForAll stream ApprovalForAll events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *CurveUSDVaultFiltererImpl) WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
 
 	if !ok {
-		err = errors.Wrap(binding.ErrBinding, "func Withdrawable not found")
+		err = errors.Wrap(binding.ErrBinding, "event ApprovalForAll not found")
 		return
 	}
 
-	var buff []byte
+	var topics [][]string
 
-	buff, err = f.Call(tokenId)
+	topics, err = e.FilterTopics(owner, operator)
 
 	if err != nil {
 		return
 	}
 
-	callSite := &client.CallSite{
-		To:   impl.Recipient,
-		Data: hex.EncodeToString(buff),
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &CurveUSDVaultApprovalForAllEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Operator, &event.Approved}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterDeposit returns Deposit events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *CurveUSDVaultFiltererImpl) FilterDeposit(ctx context.Context, opts *binding.FilterOpts, tokenId []*big.Int, commission []*big.Int) (events []*CurveUSDVaultDepositEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("a3af609bf46297028ce551832669030f9effef2b02606d02cbbcc40fe6b47c55")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Deposit not found")
+		return
 	}
 
-	var ret string
+	var topics [][]string
 
-	ret, err = impl.Client.Call(ctx, callSite)
+	topics, err = e.FilterTopics(tokenId, commission)
 
 	if err != nil {
 		return
 	}
 
-	buff, err = hex.DecodeString(ret)
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
 
 	if err != nil {
 		return
 	}
 
-	_, err = f.Return(buff, []interface{}{&ret0})
+	for _, log := range logs {
+		event := &CurveUSDVaultDepositEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.TokenId, &event.Commission}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
 
 	return
+}
+
+// WatchDeposit stream Deposit events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *CurveUSDVaultFiltererImpl) WatchDeposit(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultDepositEvent, tokenId []*big.Int, commission []*big.Int) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("a3af609bf46297028ce551832669030f9effef2b02606d02cbbcc40fe6b47c55")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Deposit not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(tokenId, commission)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &CurveUSDVaultDepositEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.TokenId, &event.Commission}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterOwnershipTransferred returns OwnershipTransferred events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *CurveUSDVaultFiltererImpl) FilterOwnershipTransferred(ctx context.Context, opts *binding.FilterOpts, previousOwner []address.Address, newOwner []address.Address) (events []*CurveUSDVaultOwnershipTransferredEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event OwnershipTransferred not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(previousOwner, newOwner)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &CurveUSDVaultOwnershipTransferredEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.PreviousOwner, &event.NewOwner}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchOwnershipTransferred stream OwnershipTransferred events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *CurveUSDVaultFiltererImpl) WatchOwnershipTransferred(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultOwnershipTransferredEvent, previousOwner []address.Address, newOwner []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event OwnershipTransferred not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(previousOwner, newOwner)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &CurveUSDVaultOwnershipTransferredEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.PreviousOwner, &event.NewOwner}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterTransfer returns Transfer events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *CurveUSDVaultFiltererImpl) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultTransferEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &CurveUSDVaultTransferEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.TokenId}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransfer stream Transfer events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *CurveUSDVaultFiltererImpl) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultTransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &CurveUSDVaultTransferEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.TokenId}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockCurveUSDVault in-memory CurveUSDVault implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockCurveUSDVault struct {
+	DAOFunc                        func(ctx context.Context) (ret0 address.Address, err error)
+	ApproveFunc                    func(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	BalanceOfFunc                  func(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	BurnFunc                       func(ctx context.Context, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	BurnRequireFunc                func(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error)
+	CommissionRateFunc             func(ctx context.Context) (ret0 *big.Int, err error)
+	DataFunc                       func(ctx context.Context, tokenId *big.Int) (ret0 *CurveNFT, err error)
+	DepositFunc                    func(ctx context.Context, recipient address.Address, asset address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	GetApprovedFunc                func(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	HelloFunc                      func(ctx context.Context, tokenId [20][]*big.Int, nft []*CurveNFT, nfts [][2]*CurveNFT, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	IsApprovedForAllFunc           func(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error)
+	NameFunc                       func(ctx context.Context) (ret0 string, err error)
+	OwnerFunc                      func(ctx context.Context) (ret0 address.Address, err error)
+	OwnerOfFunc                    func(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	RenounceOwnershipFunc          func(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFromFunc           func(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFromWithDataFunc   func(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetApprovalForAllFunc          func(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SupportsInterfaceFunc          func(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error)
+	SymbolFunc                     func(ctx context.Context) (ret0 string, err error)
+	TokenByIndexFunc               func(ctx context.Context, index *big.Int) (ret0 *big.Int, err error)
+	TokenOfOwnerByIndexFunc        func(ctx context.Context, owner address.Address, index *big.Int) (ret0 *big.Int, err error)
+	TokenURIFunc                   func(ctx context.Context, tokenId *big.Int) (ret0 string, err error)
+	TotalSupplyFunc                func(ctx context.Context) (ret0 *big.Int, err error)
+	TransferFromFunc               func(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferOwnershipFunc          func(ctx context.Context, newOwner address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	UsdFunc                        func(ctx context.Context) (ret0 address.Address, err error)
+	WithdrawFunc                   func(ctx context.Context, recipient address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	WithdrawAmountFunc             func(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error)
+	WithdrawableFunc               func(ctx context.Context, tokenId *big.Int) (ret0 bool, err error)
+	FilterApprovalFunc             func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultApprovalEvent, err error)
+	WatchApprovalFunc              func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+	FilterApprovalForAllFunc       func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*CurveUSDVaultApprovalForAllEvent, err error)
+	WatchApprovalForAllFunc        func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error)
+	FilterDepositFunc              func(ctx context.Context, opts *binding.FilterOpts, tokenId []*big.Int, commission []*big.Int) (events []*CurveUSDVaultDepositEvent, err error)
+	WatchDepositFunc               func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultDepositEvent, tokenId []*big.Int, commission []*big.Int) (sub client.Subscription, err error)
+	FilterOwnershipTransferredFunc func(ctx context.Context, opts *binding.FilterOpts, previousOwner []address.Address, newOwner []address.Address) (events []*CurveUSDVaultOwnershipTransferredEvent, err error)
+	WatchOwnershipTransferredFunc  func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultOwnershipTransferredEvent, previousOwner []address.Address, newOwner []address.Address) (sub client.Subscription, err error)
+	FilterTransferFunc             func(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultTransferEvent, err error)
+	WatchTransferFunc              func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultTransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+}
+
+var _ CurveUSDVault = (*MockCurveUSDVault)(nil)
+
+func (mock *MockCurveUSDVault) DAO(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.DAOFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func DAO not stubbed")
+		return
+	}
+
+	return mock.DAOFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.ApproveFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Approve not stubbed")
+		return
+	}
+
+	return mock.ApproveFunc(ctx, to, tokenId, ops...)
+}
+
+func (mock *MockCurveUSDVault) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, owner)
+}
+
+func (mock *MockCurveUSDVault) Burn(ctx context.Context, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.BurnFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Burn not stubbed")
+		return
+	}
+
+	return mock.BurnFunc(ctx, tokenId, ops...)
+}
+
+func (mock *MockCurveUSDVault) BurnRequire(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error) {
+	if mock.BurnRequireFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BurnRequire not stubbed")
+		return
+	}
+
+	return mock.BurnRequireFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) CommissionRate(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.CommissionRateFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func CommissionRate not stubbed")
+		return
+	}
+
+	return mock.CommissionRateFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) Data(ctx context.Context, tokenId *big.Int) (ret0 *CurveNFT, err error) {
+	if mock.DataFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Data not stubbed")
+		return
+	}
+
+	return mock.DataFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) Deposit(ctx context.Context, recipient address.Address, asset address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.DepositFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Deposit not stubbed")
+		return
+	}
+
+	return mock.DepositFunc(ctx, recipient, asset, amount, ops...)
+}
+
+func (mock *MockCurveUSDVault) GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	if mock.GetApprovedFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetApproved not stubbed")
+		return
+	}
+
+	return mock.GetApprovedFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) Hello(ctx context.Context, tokenId [20][]*big.Int, nft []*CurveNFT, nfts [][2]*CurveNFT, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.HelloFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Hello not stubbed")
+		return
+	}
+
+	return mock.HelloFunc(ctx, tokenId, nft, nfts, ops...)
+}
+
+func (mock *MockCurveUSDVault) IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error) {
+	if mock.IsApprovedForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func IsApprovedForAll not stubbed")
+		return
+	}
+
+	return mock.IsApprovedForAllFunc(ctx, owner, operator)
+}
+
+func (mock *MockCurveUSDVault) Name(ctx context.Context) (ret0 string, err error) {
+	if mock.NameFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Name not stubbed")
+		return
+	}
+
+	return mock.NameFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) Owner(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.OwnerFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Owner not stubbed")
+		return
+	}
+
+	return mock.OwnerFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	if mock.OwnerOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func OwnerOf not stubbed")
+		return
+	}
+
+	return mock.OwnerOfFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) RenounceOwnership(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RenounceOwnershipFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RenounceOwnership not stubbed")
+		return
+	}
+
+	return mock.RenounceOwnershipFunc(ctx, ops...)
+}
+
+func (mock *MockCurveUSDVault) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SafeTransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SafeTransferFrom not stubbed")
+		return
+	}
+
+	return mock.SafeTransferFromFunc(ctx, from, to, tokenId, ops...)
+}
+
+func (mock *MockCurveUSDVault) SafeTransferFromWithData(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SafeTransferFromWithDataFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SafeTransferFromWithData not stubbed")
+		return
+	}
+
+	return mock.SafeTransferFromWithDataFunc(ctx, from, to, tokenId, data, ops...)
+}
+
+func (mock *MockCurveUSDVault) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SetApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SetApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.SetApprovalForAllFunc(ctx, operator, approved, ops...)
+}
+
+func (mock *MockCurveUSDVault) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
+	if mock.SupportsInterfaceFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SupportsInterface not stubbed")
+		return
+	}
+
+	return mock.SupportsInterfaceFunc(ctx, interfaceId)
+}
+
+func (mock *MockCurveUSDVault) Symbol(ctx context.Context) (ret0 string, err error) {
+	if mock.SymbolFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Symbol not stubbed")
+		return
+	}
+
+	return mock.SymbolFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) TokenByIndex(ctx context.Context, index *big.Int) (ret0 *big.Int, err error) {
+	if mock.TokenByIndexFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TokenByIndex not stubbed")
+		return
+	}
+
+	return mock.TokenByIndexFunc(ctx, index)
+}
+
+func (mock *MockCurveUSDVault) TokenOfOwnerByIndex(ctx context.Context, owner address.Address, index *big.Int) (ret0 *big.Int, err error) {
+	if mock.TokenOfOwnerByIndexFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TokenOfOwnerByIndex not stubbed")
+		return
+	}
+
+	return mock.TokenOfOwnerByIndexFunc(ctx, owner, index)
+}
+
+func (mock *MockCurveUSDVault) TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error) {
+	if mock.TokenURIFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TokenURI not stubbed")
+		return
+	}
+
+	return mock.TokenURIFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.TotalSupplyFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TotalSupply not stubbed")
+		return
+	}
+
+	return mock.TotalSupplyFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferFrom not stubbed")
+		return
+	}
+
+	return mock.TransferFromFunc(ctx, from, to, tokenId, ops...)
+}
+
+func (mock *MockCurveUSDVault) TransferOwnership(ctx context.Context, newOwner address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferOwnershipFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferOwnership not stubbed")
+		return
+	}
+
+	return mock.TransferOwnershipFunc(ctx, newOwner, ops...)
+}
+
+func (mock *MockCurveUSDVault) Usd(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.UsdFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Usd not stubbed")
+		return
+	}
+
+	return mock.UsdFunc(ctx)
+}
+
+func (mock *MockCurveUSDVault) Withdraw(ctx context.Context, recipient address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.WithdrawFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Withdraw not stubbed")
+		return
+	}
+
+	return mock.WithdrawFunc(ctx, recipient, tokenId, ops...)
+}
+
+func (mock *MockCurveUSDVault) WithdrawAmount(ctx context.Context, tokenId *big.Int) (ret0 *big.Int, err error) {
+	if mock.WithdrawAmountFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WithdrawAmount not stubbed")
+		return
+	}
+
+	return mock.WithdrawAmountFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) Withdrawable(ctx context.Context, tokenId *big.Int) (ret0 bool, err error) {
+	if mock.WithdrawableFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Withdrawable not stubbed")
+		return
+	}
+
+	return mock.WithdrawableFunc(ctx, tokenId)
+}
+
+func (mock *MockCurveUSDVault) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultApprovalEvent, err error) {
+	if mock.FilterApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApproval not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalFunc(ctx, opts, owner, approved, tokenId)
+}
+
+func (mock *MockCurveUSDVault) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	if mock.WatchApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApproval not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalFunc(ctx, opts, sink, owner, approved, tokenId)
+}
+
+func (mock *MockCurveUSDVault) FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*CurveUSDVaultApprovalForAllEvent, err error) {
+	if mock.FilterApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalForAllFunc(ctx, opts, owner, operator)
+}
+
+func (mock *MockCurveUSDVault) WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalForAllFunc(ctx, opts, sink, owner, operator)
+}
+
+func (mock *MockCurveUSDVault) FilterDeposit(ctx context.Context, opts *binding.FilterOpts, tokenId []*big.Int, commission []*big.Int) (events []*CurveUSDVaultDepositEvent, err error) {
+	if mock.FilterDepositFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterDeposit not stubbed")
+		return
+	}
+
+	return mock.FilterDepositFunc(ctx, opts, tokenId, commission)
+}
+
+func (mock *MockCurveUSDVault) WatchDeposit(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultDepositEvent, tokenId []*big.Int, commission []*big.Int) (sub client.Subscription, err error) {
+	if mock.WatchDepositFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchDeposit not stubbed")
+		return
+	}
+
+	return mock.WatchDepositFunc(ctx, opts, sink, tokenId, commission)
+}
+
+func (mock *MockCurveUSDVault) FilterOwnershipTransferred(ctx context.Context, opts *binding.FilterOpts, previousOwner []address.Address, newOwner []address.Address) (events []*CurveUSDVaultOwnershipTransferredEvent, err error) {
+	if mock.FilterOwnershipTransferredFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterOwnershipTransferred not stubbed")
+		return
+	}
+
+	return mock.FilterOwnershipTransferredFunc(ctx, opts, previousOwner, newOwner)
+}
+
+func (mock *MockCurveUSDVault) WatchOwnershipTransferred(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultOwnershipTransferredEvent, previousOwner []address.Address, newOwner []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchOwnershipTransferredFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchOwnershipTransferred not stubbed")
+		return
+	}
+
+	return mock.WatchOwnershipTransferredFunc(ctx, opts, sink, previousOwner, newOwner)
+}
+
+func (mock *MockCurveUSDVault) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*CurveUSDVaultTransferEvent, err error) {
+	if mock.FilterTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransfer not stubbed")
+		return
+	}
+
+	return mock.FilterTransferFunc(ctx, opts, from, to, tokenId)
+}
+
+func (mock *MockCurveUSDVault) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *CurveUSDVaultTransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	if mock.WatchTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransfer not stubbed")
+		return
+	}
 
+	return mock.WatchTransferFunc(ctx, opts, sink, from, to, tokenId)
 }
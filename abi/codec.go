@@ -0,0 +1,252 @@
+package abi
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/fixed"
+)
+
+// Zero-reflection codec runtime, used by code generated with binding.WithCodec.
+//
+// The Append* functions append the standard encoding of value to buff, the Decode* functions
+// decode value from the start of data and return the consumed length, both produce the same
+// result as Encoder.Marshal/Encoder.Unmarshal
+
+// AppendInt append intN/uintN value
+func AppendInt(buff []byte, v *big.Int, sign bool, bits uint) ([]byte, error) {
+	enc := integerEncoder{bits: bits, sign: sign}
+
+	return enc.appendBigInt(buff, v)
+}
+
+// AppendAddress append address value
+func AppendAddress(buff []byte, v address.Address) ([]byte, error) {
+	buff = append(buff, make([]byte, 12)...)
+
+	return append(buff, v[:]...), nil
+}
+
+// AppendBool append bool value
+func AppendBool(buff []byte, v bool) ([]byte, error) {
+	buff = append(buff, make([]byte, 32)...)
+
+	if v {
+		buff[len(buff)-1] = 1
+	}
+
+	return buff, nil
+}
+
+// AppendFixed append fixed<M>x<N>/ufixed<M>x<N> value
+func AppendFixed(buff []byte, v *fixed.Number, sign bool, M, N uint) ([]byte, error) {
+	if v == nil || v.RawValue == nil {
+		return nil, errors.Wrap(ErrValue, "nil fixed number")
+	}
+
+	if v.Decimals != int(N) {
+		return nil, errors.Wrap(ErrDecimals, "input fixed decimals %d, expect %d", v.Decimals, N)
+	}
+
+	return AppendInt(buff, v.RawValue, sign, M)
+}
+
+// AppendFixedBytes append bytesN value
+func AppendFixedBytes(buff []byte, v []byte) ([]byte, error) {
+	buff = append(buff, v...)
+
+	return append(buff, make([]byte, 32-len(v))...), nil
+}
+
+// AppendLength append length or offset word
+func AppendLength(buff []byte, n int) []byte {
+	buff = append(buff, make([]byte, 32)...)
+
+	binary.BigEndian.PutUint64(buff[len(buff)-8:], uint64(n))
+
+	return buff
+}
+
+// PutOffset write the offset word at the start of buff
+func PutOffset(buff []byte, offset int) {
+	binary.BigEndian.PutUint64(buff[24:32], uint64(offset))
+}
+
+// AppendBytes append dynamic bytes value
+func AppendBytes(buff []byte, v []byte) ([]byte, error) {
+	buff = AppendLength(buff, len(v))
+
+	buff = append(buff, v...)
+
+	return append(buff, make([]byte, (32-len(v)%32)%32)...), nil
+}
+
+// AppendString append string value
+func AppendString(buff []byte, v string) ([]byte, error) {
+	buff = AppendLength(buff, len(v))
+
+	buff = append(buff, v...)
+
+	return append(buff, make([]byte, (32-len(v)%32)%32)...), nil
+}
+
+func decodeWord(data []byte) error {
+	if len(data) < 32 {
+		return errors.Wrap(ErrLength, "abi data length < 32")
+	}
+
+	return nil
+}
+
+// DecodeInt decode intN/uintN value
+func DecodeInt(data []byte, sign bool, bits uint) (*big.Int, uint, error) {
+	if err := decodeWord(data); err != nil {
+		return nil, 0, err
+	}
+
+	enc := integerEncoder{bits: bits, sign: sign}
+
+	v, err := enc.unmarshalBigInt(data)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return v, 32, nil
+}
+
+// DecodeAddress decode address value
+func DecodeAddress(data []byte) (address.Address, uint, error) {
+	if err := decodeWord(data); err != nil {
+		return address.Address{}, 0, err
+	}
+
+	if !zeroed(data[:12]) {
+		return address.Address{}, 0, errors.Wrap(ErrPadding, "address dirty high-order bits 0x%x", data[:32])
+	}
+
+	return address.BytesToAddress(data[12:32]), 32, nil
+}
+
+// DecodeBool decode bool value
+func DecodeBool(data []byte) (bool, uint, error) {
+	if err := decodeWord(data); err != nil {
+		return false, 0, err
+	}
+
+	if !zeroed(data[:31]) || data[31] > 1 {
+		return false, 0, errors.Wrap(ErrValue, "unmarshal abi data error")
+	}
+
+	return data[31] == 1, 32, nil
+}
+
+// DecodeFixed decode fixed<M>x<N>/ufixed<M>x<N> value
+func DecodeFixed(data []byte, sign bool, M, N uint) (*fixed.Number, uint, error) {
+	v, l, err := DecodeInt(data, sign, M)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &fixed.Number{RawValue: v, Decimals: int(N)}, l, nil
+}
+
+// DecodeFixedBytes decode bytesN value into target, N is the length of target
+func DecodeFixedBytes(data []byte, target []byte) (uint, error) {
+	if err := decodeWord(data); err != nil {
+		return 0, err
+	}
+
+	copy(target, data)
+
+	return 32, nil
+}
+
+// DecodeLength decode the length word of dynamic bytes or array, elemSize is the min length of
+// each element
+func DecodeLength(data []byte, elemSize uint) (uint, error) {
+	if err := decodeWord(data); err != nil {
+		return 0, err
+	}
+
+	if !zeroed(data[:24]) {
+		return 0, errors.Wrap(ErrLength, "length 0x%x out of range", data[:32])
+	}
+
+	n := binary.BigEndian.Uint64(data[24:32])
+
+	if n > uint64(len(data)-32)/uint64(elemSize) {
+		return 0, errors.Wrap(ErrLength, "length %d out of range", n)
+	}
+
+	return uint(n), nil
+}
+
+// DecodeOffset decode the content offset word at pos of head/tail encoded data
+func DecodeOffset(data []byte, pos uint) (uint, error) {
+	if pos > uint(len(data)) {
+		return 0, errors.Wrap(ErrLength, "offset position %d out of range", pos)
+	}
+
+	if err := decodeWord(data[pos:]); err != nil {
+		return 0, err
+	}
+
+	if !zeroed(data[pos : pos+24]) {
+		return 0, errors.Wrap(ErrLength, "offset 0x%x out of range", data[pos:pos+32])
+	}
+
+	offset := binary.BigEndian.Uint64(data[pos+24 : pos+32])
+
+	if offset > uint64(len(data)) {
+		return 0, errors.Wrap(ErrLength, "offset %d out of range", offset)
+	}
+
+	return uint(offset), nil
+}
+
+// decodeBytes decode dynamic bytes content without copy
+func decodeBytes(data []byte) ([]byte, uint, error) {
+	n, err := DecodeLength(data, 1)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if n == 0 {
+		return data[32:32], 32, nil
+	}
+
+	padded := paddingLen(n)
+
+	if padded > uint(len(data))-32 {
+		return nil, 0, errors.Wrap(ErrLength, "abi data len error")
+	}
+
+	return data[32 : 32+n], 32 + padded, nil
+}
+
+// DecodeBytes decode dynamic bytes value
+func DecodeBytes(data []byte) ([]byte, uint, error) {
+	content, l, err := decodeBytes(data)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return append([]byte{}, content...), l, nil
+}
+
+// DecodeString decode string value
+func DecodeString(data []byte) (string, uint, error) {
+	content, l, err := decodeBytes(data)
+
+	if err != nil {
+		return "", 0, err
+	}
+
+	return string(content), l, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"strings"
 	"sync"
 
+	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/address"
 	"github.com/libs4go/ethers/client"
 	"github.com/libs4go/ethers/signer"
@@ -15,20 +17,67 @@ import (
 
 type Func interface {
 	Selector() []byte
+	// Name returns the abi function name
+	Name() string
+	// Signature returns the canonical function signature, e.g. transfer(address,uint256)
+	Signature() string
+	// Inputs returns the json abi parameters of the function
+	Inputs() []*JSONParam
+	// Outputs returns the json abi return values of the function
+	Outputs() []*JSONParam
 	// Call generate call bytes
 	Call(params ...interface{}) ([]byte, error)
 	// Return unmarshal return bytes
 	Return(data []byte, values interface{}) (uint, error)
+	// DecodeInputs unmarshal call bytes generated by Call, the selector prefix is checked and skipped
+	DecodeInputs(data []byte, values interface{}) (uint, error)
+	// EncodeOutputs generate return bytes
+	EncodeOutputs(values ...interface{}) ([]byte, error)
 }
 
 type Contract interface {
 	Select(selector string) (Func, bool)
+	// Funcs returns the contract functions sorted by signature
+	Funcs() []Func
+	// SelectEvent find event by hex topic0, the keccak256 hash of event signature without 0x
+	SelectEvent(topic string) (Event, bool)
+	// DecodeCall find function by the call data selector and decode the arguments
+	DecodeCall(data []byte) (*DecodedCall, error)
 }
 
 func TryGetFunc(contract Contract, signature string) (Func, bool) {
 	return contract.Select(hex.EncodeToString(Selector(signature)))
 }
 
+// DecodedCall decoded contract call data
+type DecodedCall struct {
+	Func      Func       // matched function
+	Name      string     // function name
+	Signature string     // canonical function signature
+	Args      TupleValue // decoded arguments, named by the abi parameter names
+}
+
+// Arg returns the argument value by name
+func (call *DecodedCall) Arg(name string) (Value, bool) {
+	return call.Args.Field(name)
+}
+
+// String pretty print the call, see Pretty
+func (call *DecodedCall) String() string {
+	return call.Signature + "\n" + Pretty(call.Func.Inputs(), call.Args.Elems)
+}
+
+// DecodeCallHex decode hex call data, e.g. the input field of client.Transaction
+func DecodeCallHex(contract Contract, input string) (*DecodedCall, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+
+	if err != nil {
+		return nil, errors.Wrap(ErrValue, "decode call data %s error", input)
+	}
+
+	return contract.DecodeCall(data)
+}
+
 type CallOps struct {
 	GasLimit *big.Int
 	GasPrice *big.Int
@@ -169,7 +218,7 @@ func MakeTransaction(ctx context.Context, client client.Provider, s signer.Signe
 	recipientBytes := [20]byte(address.HexToAddress(recipient))
 
 	tx := &signer.Transaction{
-		AccountNonce: 0,
+		AccountNonce: callOpts.Nonce.Uint64(),
 		Price:        callOpts.GasPrice,
 		GasLimit:     callOpts.GasLimit,
 		Recipient:    &recipientBytes,
@@ -10,8 +10,8 @@ import (
 
 	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/internal/keccak"
 	"github.com/libs4go/fixed"
-	"golang.org/x/crypto/sha3"
 )
 
 var bigIntType = reflect.TypeOf((*big.Int)(nil)).Elem()
@@ -63,11 +63,7 @@ func bitsCheck(maxBits uint, bits uint) error {
 
 // Selector function selector
 func Selector(abi string) []byte {
-	hasher := sha3.NewLegacyKeccak256()
-	hasher.Write([]byte(abi))
-	data := hasher.Sum(nil)
-
-	return data[0:4]
+	return keccak.Hash([]byte(abi))[0:4]
 }
 
 // Encoder types encoder interface
@@ -80,10 +76,11 @@ type Encoder interface {
 	GoTypeName() string
 }
 
+// Visitor visit the encoder type tree, e.g. generate code by abi types
 type Visitor interface {
 	HandleInt(sign bool, bits uint)
 	HandleBool()
-	handleFixedBytes(len uint)
+	HandleFixedBytes(len uint)
 	HandleFixedArray(len uint, elem Encoder)
 	HandleBytes()
 	HandleArray(elem Encoder)
@@ -91,6 +88,14 @@ type Visitor interface {
 	HandleTuple(name string, elems []Encoder)
 }
 
+// ElementaryVisitor optional Visitor extension which distinguishes address and fixed types, which
+// are visited as HandleInt(false, 160) and HandleInt(sign, M) by the visitor without it
+type ElementaryVisitor interface {
+	Visitor
+	HandleAddress()
+	HandleFixed(sign bool, M, N uint)
+}
+
 type integerEncoder struct {
 	bits uint
 	sign bool
@@ -132,31 +137,99 @@ func (enc *integerEncoder) Static() bool {
 	return true
 }
 
+var two256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// bounds returns the min and max value of the integer type
+func (enc *integerEncoder) bounds() (*big.Int, *big.Int) {
+	if enc.sign {
+		max := new(big.Int).Lsh(big.NewInt(1), enc.bits-1)
+
+		return new(big.Int).Neg(max), max.Sub(max, big.NewInt(1))
+	}
+
+	max := new(big.Int).Lsh(big.NewInt(1), enc.bits)
+
+	return big.NewInt(0), max.Sub(max, big.NewInt(1))
+}
+
+// inRange check value within the type range without allocation
+func (enc *integerEncoder) inRange(v *big.Int) bool {
+	if !enc.sign {
+		return v.Sign() >= 0 && uint(v.BitLen()) <= enc.bits
+	}
+
+	if uint(v.BitLen()) < enc.bits {
+		return true
+	}
+
+	// -2^(bits-1)
+	return v.Sign() < 0 && uint(v.BitLen()) == enc.bits && v.TrailingZeroBits() == enc.bits-1
+}
+
 func (enc *integerEncoder) marshalBigInt(v *big.Int) ([]byte, error) {
+	return enc.appendBigInt(make([]byte, 0, 32), v)
+}
 
-	content := paddingLeft(new(big.Int).Abs(v).Bytes())
+// appendBigInt append the 32 bytes word of v to buff
+func (enc *integerEncoder) appendBigInt(buff []byte, v *big.Int) ([]byte, error) {
+	if v == nil {
+		return nil, errors.Wrap(ErrValue, "%s value is nil", enc)
+	}
 
-	if enc.sign {
-		if v.Sign() < 0 {
-			content[0] = 0xff
-		}
+	if !enc.inRange(v) {
+		min, max := enc.bounds()
+		return nil, errors.Wrap(ErrRange, "%s out of range [%s, %s]", v, min, max)
+	}
+
+	// two's complement of negative value: 2^256 + v
+	if v.Sign() < 0 {
+		v = new(big.Int).Add(two256, v)
 	}
 
-	return content, nil
+	l := len(buff)
+
+	buff = append(buff, make([]byte, 32)...)
+
+	v.FillBytes(buff[l:])
+
+	return buff, nil
+}
+
+// unmarshalBigInt decode the 32 bytes word, the high-order bits out of the type
+// width must be zero (uintN) or the sign extension (intN)
+func (enc *integerEncoder) unmarshalBigInt(data []byte) (*big.Int, error) {
+	i := new(big.Int).SetBytes(data[:32])
+
+	if enc.sign && data[0]&0x80 != 0 {
+		i.Sub(i, two256)
+	}
+
+	if !enc.inRange(i) {
+		return nil, errors.Wrap(ErrPadding, "%s dirty high-order bits 0x%x", enc, data[:32])
+	}
+
+	return i, nil
 }
 
 func (enc *integerEncoder) Marshal(value interface{}) ([]byte, error) {
 
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	switch vv := value.(type) {
-
-	case uint, uint8, uint16, uint32, uint64:
-		v := reflect.ValueOf(value)
-		return enc.marshalBigInt(big.NewInt(0).SetUint64(v.Uint()))
-	case int, int8, int16, int32, int64:
-		v := reflect.ValueOf(value)
-		return enc.marshalBigInt(big.NewInt(v.Int()))
 	case *big.Int:
 		return enc.marshalBigInt(vv)
+	case big.Int:
+		return enc.marshalBigInt(&vv)
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return enc.marshalBigInt(new(big.Int).SetUint64(v.Uint()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return enc.marshalBigInt(big.NewInt(v.Int()))
 	default:
 		return nil, errors.Wrap(ErrValue, "invalid value type %v", reflect.TypeOf(value))
 	}
@@ -164,25 +237,18 @@ func (enc *integerEncoder) Marshal(value interface{}) ([]byte, error) {
 
 func (enc *integerEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 
-	if len(data) < 32 {
-		return 0, errors.Wrap(ErrLength, "unmarshal input data length < 32")
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
 	}
 
-	negative := false
-
-	var buff [32]byte
-
-	copy(buff[:], data)
-
-	if enc.sign && data[0] == 0xff {
-		negative = true
-		buff[0] = 0x00
+	if len(data) < 32 {
+		return 0, errors.Wrap(ErrLength, "unmarshal input data length < 32")
 	}
 
-	i := new(big.Int).SetBytes(buff[:])
+	i, err := enc.unmarshalBigInt(data)
 
-	if negative {
-		i = new(big.Int).Neg(i)
+	if err != nil {
+		return 0, err
 	}
 
 	t := reflect.ValueOf(v)
@@ -192,21 +258,29 @@ func (enc *integerEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 	}
 
 	switch t.Elem().Kind() {
-	case reflect.Uint, reflect.Uint8, reflect.Uint32, reflect.Uint64:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		if enc.sign {
 			return 0, errors.Wrap(ErrValue, "expect int/uint types ptr")
 		}
 
-		t.Elem().Set(reflect.ValueOf(i.Uint64()).Convert(t.Elem().Type()))
+		if !i.IsUint64() || t.Elem().OverflowUint(i.Uint64()) {
+			return 0, errors.Wrap(ErrRange, "%s overflow %v", i, t.Elem().Type())
+		}
+
+		t.Elem().SetUint(i.Uint64())
 
 		return 32, nil
 
-	case reflect.Int, reflect.Int8, reflect.Int32, reflect.Int64:
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		if !enc.sign {
 			return 0, errors.Wrap(ErrValue, "expect int/uint types ptr")
 		}
 
-		t.Elem().Set(reflect.ValueOf(i.Int64()).Convert(t.Elem().Type()))
+		if !i.IsInt64() || t.Elem().OverflowInt(i.Int64()) {
+			return 0, errors.Wrap(ErrRange, "%s overflow %v", i, t.Elem().Type())
+		}
+
+		t.Elem().SetInt(i.Int64())
 
 		return 32, nil
 
@@ -246,11 +320,24 @@ func (enc *addressEncoder) String() string {
 
 }
 
+func (enc *addressEncoder) Accept(visitor Visitor) {
+	if elementary, ok := visitor.(ElementaryVisitor); ok {
+		elementary.HandleAddress()
+		return
+	}
+
+	enc.Encoder.Accept(visitor)
+}
+
 func (enc *addressEncoder) GoTypeName() string {
 	return "address.Address"
 }
 
 func (enc *addressEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	addr, ok := value.(address.Address)
 
 	if !ok {
@@ -263,6 +350,10 @@ func (enc *addressEncoder) Marshal(value interface{}) ([]byte, error) {
 }
 
 func (enc *addressEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
+
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
 	vv, ok := v.(*address.Address)
 
 	if !ok {
@@ -303,11 +394,19 @@ func (enc *boolEncoder) String() string {
 	return "bool"
 }
 
+func (enc *boolEncoder) Accept(visitor Visitor) {
+	visitor.HandleBool()
+}
+
 func (enc *boolEncoder) GoTypeName() string {
 	return "bool"
 }
 
 func (enc *boolEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	b, ok := value.(bool)
 
 	if !ok {
@@ -322,6 +421,10 @@ func (enc *boolEncoder) Marshal(value interface{}) ([]byte, error) {
 }
 
 func (enc *boolEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
+
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
 	var i uint
 
 	if _, err := enc.Encoder.Unmarshal(data, &i); err != nil {
@@ -355,8 +458,13 @@ type fixedEncoder struct {
 	N uint
 }
 
+// Fixed create fixed<M>x<N> or ufixed<M>x<N> encoder, 8 <= M <= 256, M % 8 == 0 and 0 < N <= 80
 func Fixed(sign bool, M, N uint) (Encoder, error) {
-	encoder, err := Integer(sign, 256)
+	if N == 0 || N > 80 {
+		return nil, errors.Wrap(ErrDecimals, "fixed decimals %d out of range (0, 80]", N)
+	}
+
+	encoder, err := Integer(sign, M)
 
 	if err != nil {
 		return nil, err
@@ -369,7 +477,33 @@ func Fixed(sign bool, M, N uint) (Encoder, error) {
 	}, nil
 }
 
+func (enc *fixedEncoder) String() string {
+	if enc.Encoder.(*integerEncoder).sign {
+		return fmt.Sprintf("fixed%dx%d", enc.M, enc.N)
+	}
+
+	return fmt.Sprintf("ufixed%dx%d", enc.M, enc.N)
+}
+
+func (enc *fixedEncoder) Accept(visitor Visitor) {
+	if elementary, ok := visitor.(ElementaryVisitor); ok {
+		elementary.HandleFixed(enc.Encoder.(*integerEncoder).sign, enc.M, enc.N)
+		return
+	}
+
+	enc.Encoder.Accept(visitor)
+}
+
+func (enc *fixedEncoder) GoTypeName() string {
+	return "*fixed.Number"
+}
+
 func (enc *fixedEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
+
 	var err error
 	var number *fixed.Number
 
@@ -382,23 +516,40 @@ func (enc *fixedEncoder) Marshal(value interface{}) ([]byte, error) {
 		}
 
 	case *big.Float:
+		if v == nil {
+			return nil, errors.Wrap(ErrValue, "%s: nil big.Float", enc)
+		}
+
 		number, err = fixed.New(int(enc.N), fixed.BigFloat(v))
 
 		if err != nil {
 			return nil, errors.Wrap(err, "create fixed error")
 		}
+	case fixed.Number:
+		number = &v
 	case *fixed.Number:
-		if v.Decimals != int(enc.N) {
-			return nil, errors.Wrap(ErrValue, "input fixed decimals error")
-		}
-
 		number = v
+	default:
+		return nil, errors.Wrap(ErrValue, "%s: unsupported value type %T", enc, value)
+	}
+
+	if number == nil || number.RawValue == nil {
+		return nil, errors.Wrap(ErrValue, "%s: nil fixed number", enc)
+	}
+
+	if number.Decimals != int(enc.N) {
+		return nil, errors.Wrap(ErrDecimals, "%s: input fixed decimals %d", enc, number.Decimals)
 	}
 
 	return enc.Encoder.Marshal(number.RawValue)
 }
 
 func (enc *fixedEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
+
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
+
 	var i *big.Int
 
 	len, err := enc.Encoder.Unmarshal(data, &i)
@@ -410,7 +561,7 @@ func (enc *fixedEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 	t := reflect.ValueOf(v)
 
 	if t.Kind() != reflect.Ptr || t.IsNil() {
-		return 0, errors.Wrap(ErrValue, "expect bool ptr")
+		return 0, errors.Wrap(ErrValue, "expect fixed ptr")
 	}
 
 	number := &fixed.Number{
@@ -428,9 +579,13 @@ func (enc *fixedEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 		t.Elem().Set(reflect.ValueOf(f))
 		return len, nil
 	default:
-		if reflect.PtrTo(fixedType) == t.Elem().Type() {
+		switch t.Elem().Type() {
+		case reflect.PtrTo(fixedType):
 			t.Elem().Set(reflect.ValueOf(number))
 			return len, nil
+		case fixedType:
+			t.Elem().Set(reflect.ValueOf(*number))
+			return len, nil
 		}
 
 		return 0, errors.Wrap(ErrValue, "expect fixed ptr")
@@ -448,7 +603,7 @@ func FixedBytes(len uint) (Encoder, error) {
 }
 
 func (enc *fixedBytesEncoder) Accept(visitor Visitor) {
-	visitor.handleFixedBytes(enc.len)
+	visitor.HandleFixedBytes(enc.len)
 }
 
 func (enc *fixedBytesEncoder) String() string {
@@ -464,6 +619,10 @@ func (enc *fixedBytesEncoder) Static() bool {
 }
 
 func (enc *fixedBytesEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	v := reflect.ValueOf(value)
 
 	if v.Kind() != reflect.Array || reflect.TypeOf(value).Elem().Kind() != reflect.Uint8 {
@@ -479,6 +638,10 @@ func (enc *fixedBytesEncoder) Marshal(value interface{}) ([]byte, error) {
 
 func (enc *fixedBytesEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
+
 	pl := paddingLen(enc.len)
 
 	if uint(len(data)) < pl {
@@ -523,7 +686,7 @@ func (enc *fixedArrayEncoder) Accept(visitor Visitor) {
 }
 
 func (enc *fixedArrayEncoder) Static() bool {
-	return true
+	return enc.elem.Static()
 }
 
 func (enc *fixedArrayEncoder) String() string {
@@ -535,6 +698,10 @@ func (enc *fixedArrayEncoder) GoTypeName() string {
 }
 
 func (enc *fixedArrayEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	v := reflect.ValueOf(value)
 
 	if v.Kind() != reflect.Array || enc.len != uint(v.Len()) {
@@ -609,6 +776,10 @@ func (enc *fixedArrayEncoder) dynamicMarshal(value reflect.Value) ([]byte, error
 
 func (enc *fixedArrayEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
+
 	t := reflect.ValueOf(v)
 
 	if t.Kind() != reflect.Ptr || t.IsNil() {
@@ -639,7 +810,7 @@ func (enc *fixedArrayEncoder) staticUnmarshal(data []byte, v reflect.Value) (uin
 	offset := uint(0)
 
 	for i := 0; i < int(enc.len); i++ {
-		if len(data) < int(offset) {
+		if uint(len(data)) < offset {
 			return 0, errors.Wrap(ErrLength, "abi data too short")
 		}
 
@@ -679,23 +850,19 @@ func (enc *fixedArrayEncoder) dynamicUnmarshal(data []byte, v reflect.Value) (ui
 	// create array ptr
 	array := reflect.New(v.Type().Elem())
 
-	contentLen := uint(0)
+	end := headerLen
 
 	for i := 0; i < int(enc.len); i++ {
 
-		if len(data) < int(offset) {
-			return 0, errors.Wrap(ErrLength, "abi data too short")
-		}
-
 		var elemOffset uint
 		_, err := ienc.Unmarshal(data[offset:], &elemOffset)
 
 		if err != nil {
-			return 0, err
+			return 0, errors.Wrap(ErrLength, "element %d offset out of range", i)
 		}
 
-		if len(data) < int(elemOffset) {
-			return 0, errors.Wrap(ErrLength, "abi data too short")
+		if uint(len(data)) < elemOffset {
+			return 0, errors.Wrap(ErrLength, "element %d offset %d out of range", i, elemOffset)
 		}
 
 		content := reflect.New(v.Type().Elem().Elem())
@@ -708,14 +875,16 @@ func (enc *fixedArrayEncoder) dynamicUnmarshal(data []byte, v reflect.Value) (ui
 
 		offset += 32
 
-		contentLen = elemOffset + len
+		if elemOffset+len > end {
+			end = elemOffset + len
+		}
 
-		array.Elem().Index(i).Set(content)
+		array.Elem().Index(i).Set(content.Elem())
 	}
 
 	reflect.Indirect(v).Set(array.Elem())
 
-	return headerLen + contentLen, nil
+	return end, nil
 }
 
 type bytesEncoder struct {
@@ -742,12 +911,20 @@ func (enc *bytesEncoder) GoTypeName() string {
 }
 
 func (enc *bytesEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	b, ok := value.([]byte)
 
 	if !ok {
 		return nil, errors.Wrap(ErrValue, "input value must be []byte")
 	}
 
+	if len(b) == 0 {
+		return make([]byte, 32), nil
+	}
+
 	encoder, err := FixedBytes(uint(len(b)))
 
 	if err != nil {
@@ -779,10 +956,20 @@ func (enc *bytesEncoder) Marshal(value interface{}) ([]byte, error) {
 
 func (enc *bytesEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
+
 	if len(data) < 32 {
 		return 0, errors.Wrap(ErrLength, "abi data length < 32")
 	}
 
+	vv := reflect.ValueOf(v)
+
+	if vv.Kind() != reflect.Ptr || vv.IsNil() || vv.Elem().Type() != reflect.TypeOf([]byte(nil)) {
+		return 0, errors.Wrap(ErrValue, "expect *[]byte")
+	}
+
 	ienc, err := Integer(false, 256)
 
 	if err != nil {
@@ -794,11 +981,16 @@ func (enc *bytesEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 	_, err = ienc.Unmarshal(data, &k)
 
 	if err != nil {
-		return 0, err
+		return 0, errors.Wrap(ErrLength, "bytes length out of range")
 	}
 
-	if uint(len(data)) < k+32 {
-		return 0, errors.Wrap(ErrLength, "abi data length < 32")
+	if k > uint(len(data))-32 {
+		return 0, errors.Wrap(ErrLength, "bytes length %d > abi data length %d", k, len(data)-32)
+	}
+
+	if k == 0 {
+		vv.Elem().Set(reflect.ValueOf([]byte{}))
+		return 32, nil
 	}
 
 	encoder, err := FixedBytes(k)
@@ -807,15 +999,15 @@ func (enc *bytesEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 		return 0, err
 	}
 
-	vv := reflect.New(reflect.ArrayOf(int(k), reflect.TypeOf((*byte)(nil)).Elem()))
+	content := reflect.New(reflect.ArrayOf(int(k), reflect.TypeOf((*byte)(nil)).Elem()))
 
-	contentLen, err := encoder.Unmarshal(data[32:], vv.Interface())
+	contentLen, err := encoder.Unmarshal(data[32:], content.Interface())
 
 	if err != nil {
 		return 0, err
 	}
 
-	reflect.ValueOf(v).Elem().Set(vv.Elem().Slice(0, vv.Elem().Len()))
+	vv.Elem().Set(content.Elem().Slice(0, content.Elem().Len()))
 
 	return contentLen + 32, nil
 }
@@ -844,6 +1036,10 @@ func (enc *stringEncoder) String() string {
 }
 
 func (enc *stringEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	s, ok := value.(string)
 
 	if !ok {
@@ -861,6 +1057,10 @@ func (enc *stringEncoder) Marshal(value interface{}) ([]byte, error) {
 
 func (enc *stringEncoder) Unmarshal(data []byte, v interface{}) (uint, error) {
 
+	if target, ok := v.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
+
 	vv := reflect.ValueOf(v)
 
 	if vv.Kind() != reflect.Ptr || vv.Elem().Type() != stringType {
@@ -913,10 +1113,14 @@ func (enc *arrayEncoder) GoTypeName() string {
 }
 
 func (enc *arrayEncoder) Marshal(value interface{}) ([]byte, error) {
+
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 	v := reflect.ValueOf(value)
 
-	if v.Kind() != reflect.Slice || v.IsNil() {
-		return nil, errors.Wrap(ErrValue, "expect input slice not nil")
+	if v.Kind() != reflect.Slice {
+		return nil, errors.Wrap(ErrValue, "expect input slice, got %v", reflect.TypeOf(value))
 	}
 
 	l := v.Len()
@@ -939,9 +1143,11 @@ func (enc *arrayEncoder) Marshal(value interface{}) ([]byte, error) {
 		return nil, err
 	}
 
-	arrayT := reflect.PtrTo(reflect.ArrayOf(l, v.Type().Elem()))
+	array := reflect.New(reflect.ArrayOf(l, v.Type().Elem())).Elem()
 
-	content, err := encoder.Marshal(reflect.Indirect(v.Convert(arrayT)).Interface())
+	reflect.Copy(array, v)
+
+	content, err := encoder.Marshal(array.Interface())
 
 	if err != nil {
 		return nil, err
@@ -951,6 +1157,10 @@ func (enc *arrayEncoder) Marshal(value interface{}) ([]byte, error) {
 }
 
 func (enc *arrayEncoder) Unmarshal(data []byte, value interface{}) (uint, error) {
+
+	if target, ok := value.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
 	if len(data) < 32 {
 		return 0, errors.Wrap(ErrLength, "abi data length < 32")
 	}
@@ -972,11 +1182,12 @@ func (enc *arrayEncoder) Unmarshal(data []byte, value interface{}) (uint, error)
 	_, err = ienc.Unmarshal(data, &l)
 
 	if err != nil {
-		return 0, err
+		return 0, errors.Wrap(ErrLength, "array length out of range")
 	}
 
-	if uint(len(data)) < l+32 {
-		return 0, errors.Wrap(ErrLength, "abi data length < %d", l+32)
+	// each element occupies at least one word
+	if l > (uint(len(data))-32)/32 {
+		return 0, errors.Wrap(ErrLength, "array length %d out of range", l)
 	}
 
 	encoder, err := FixedArray(enc.elem, l)
@@ -1000,9 +1211,12 @@ func (enc *arrayEncoder) Unmarshal(data []byte, value interface{}) (uint, error)
 
 type tupleEncoder struct {
 	name  string
+	names []string
 	elems []Encoder
 }
 
+// Tuple create tuple encoder, which marshal []interface{} or go struct whose exported fields
+// are mapped to the tuple components in declaration order
 func Tuple(name string, elems ...Encoder) (Encoder, error) {
 
 	return &tupleEncoder{
@@ -1011,12 +1225,32 @@ func Tuple(name string, elems ...Encoder) (Encoder, error) {
 	}, nil
 }
 
+// NamedTuple create tuple encoder with component names, go struct fields tagged by `abi:"name"`
+// are mapped to the component of the same name
+func NamedTuple(name string, names []string, elems ...Encoder) (Encoder, error) {
+	if len(names) != len(elems) {
+		return nil, errors.Wrap(ErrValue, "Tuple %s: names len %d != elems len %d", name, len(names), len(elems))
+	}
+
+	return &tupleEncoder{
+		name:  name,
+		names: names,
+		elems: elems,
+	}, nil
+}
+
 func (enc *tupleEncoder) Accept(visitor Visitor) {
 	visitor.HandleTuple(enc.name, enc.elems)
 }
 
 func (enc *tupleEncoder) Static() bool {
-	return false
+	for _, elem := range enc.elems {
+		if !elem.Static() {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (enc *tupleEncoder) String() string {
@@ -1033,12 +1267,153 @@ func (enc *tupleEncoder) GoTypeName() string {
 	return fmt.Sprintf("*%s", enc.name)
 }
 
+// fieldIndexes returns the go struct field index of each tuple component
+func (enc *tupleEncoder) fieldIndexes(t reflect.Type) ([]int, error) {
+	indexes := make([]int, len(enc.elems))
+
+	for i := range indexes {
+		indexes[i] = -1
+	}
+
+	pos := 0
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tag := field.Tag.Get("abi")
+
+		if tag == "-" {
+			continue
+		}
+
+		target := pos
+
+		if tag != "" {
+			target = -1
+
+			for j, name := range enc.names {
+				if name == tag {
+					target = j
+					break
+				}
+			}
+
+			if target < 0 {
+				return nil, errors.Wrap(ErrTag, "Tuple %s: component of %v.%s tag %s not found", enc.name, t, field.Name, tag)
+			}
+		}
+
+		if target >= len(indexes) {
+			return nil, errors.Wrap(ErrValue, "Tuple %s: struct %v has more fields than %d components", enc.name, t, len(indexes))
+		}
+
+		if indexes[target] != -1 {
+			return nil, errors.Wrap(ErrTag, "Tuple %s: component %d mapped by %v.%s and %v.%s", enc.name, target, t, t.Field(indexes[target]).Name, t, field.Name)
+		}
+
+		indexes[target] = i
+
+		pos++
+	}
+
+	for i, index := range indexes {
+		if index == -1 {
+			return nil, errors.Wrap(ErrValue, "Tuple %s: component %d not mapped by struct %v", enc.name, i, t)
+		}
+	}
+
+	return indexes, nil
+}
+
+// marshalValues returns the components value of []interface{}, struct or struct ptr
+func (enc *tupleEncoder) marshalValues(value interface{}) ([]interface{}, error) {
+	if slice, ok := value.([]interface{}); ok {
+		return slice, nil
+	}
+
+	v := reflect.ValueOf(value)
+
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, errors.Wrap(ErrValue, "Tuple %s: marshal nil %v", enc.name, v.Type())
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, errors.Wrap(ErrValue, "Tuple %s: expect marshal value []interface{} or struct, got %v", enc.name, reflect.TypeOf(value))
+	}
+
+	indexes, err := enc.fieldIndexes(v.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(indexes))
+
+	for i, index := range indexes {
+		values[i] = v.Field(index).Interface()
+	}
+
+	return values, nil
+}
+
+// unmarshalValues returns the components ptr of []interface{}, struct ptr or struct ptr ptr,
+// nil struct ptr is allocated
+func (enc *tupleEncoder) unmarshalValues(value interface{}) ([]interface{}, error) {
+	if slice, ok := value.([]interface{}); ok {
+		return slice, nil
+	}
+
+	v := reflect.ValueOf(value)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil, errors.Wrap(ErrValue, "Tuple %s: expect unmarshal value []interface{} or struct ptr, got %v", enc.name, reflect.TypeOf(value))
+	}
+
+	if v.Elem().Kind() == reflect.Ptr {
+		if v.Elem().IsNil() {
+			v.Elem().Set(reflect.New(v.Elem().Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Elem().Kind() != reflect.Struct {
+		return nil, errors.Wrap(ErrValue, "Tuple %s: expect unmarshal value []interface{} or struct ptr, got %v", enc.name, reflect.TypeOf(value))
+	}
+
+	indexes, err := enc.fieldIndexes(v.Elem().Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(indexes))
+
+	for i, index := range indexes {
+		values[i] = v.Elem().Field(index).Addr().Interface()
+	}
+
+	return values, nil
+}
+
 func (enc *tupleEncoder) Marshal(value interface{}) ([]byte, error) {
 
-	slice, ok := value.([]interface{})
+	if v, ok := value.(Value); ok {
+		return EncodeValue(enc, v)
+	}
 
-	if !ok {
-		return nil, errors.Wrap(ErrValue, "Tuple: expect marshal value []interface{}")
+	slice, err := enc.marshalValues(value)
+
+	if err != nil {
+		return nil, err
 	}
 
 	if len(slice) != len(enc.elems) {
@@ -1123,10 +1498,14 @@ func (enc *tupleEncoder) Marshal(value interface{}) ([]byte, error) {
 }
 
 func (enc *tupleEncoder) Unmarshal(data []byte, value interface{}) (uint, error) {
-	slice, ok := value.([]interface{})
 
-	if !ok {
-		return 0, errors.Wrap(ErrValue, "Tuple: expect umarshal value []interface{}")
+	if target, ok := value.(*Value); ok {
+		return unmarshalValue(enc, data, target)
+	}
+	slice, err := enc.unmarshalValues(value)
+
+	if err != nil {
+		return 0, err
 	}
 
 	if len(slice) != len(enc.elems) {
@@ -1135,7 +1514,7 @@ func (enc *tupleEncoder) Unmarshal(data []byte, value interface{}) (uint, error)
 
 	offset := uint(0)
 	maxLen := uint(len(data))
-	contentLen := uint(0)
+	end := uint(0)
 
 	iEncoder, err := Integer(false, 256)
 
@@ -1162,7 +1541,7 @@ func (enc *tupleEncoder) Unmarshal(data []byte, value interface{}) (uint, error)
 			len, err := iEncoder.Unmarshal(data[offset:], &contentOffset)
 
 			if err != nil {
-				return 0, err
+				return 0, errors.Wrap(ErrLength, "Tuple: content (%d,%s) offset out of range", i, elem)
 			}
 
 			offset += len
@@ -1177,11 +1556,17 @@ func (enc *tupleEncoder) Unmarshal(data []byte, value interface{}) (uint, error)
 				return 0, err
 			}
 
-			contentLen += len
+			if contentOffset+len > end {
+				end = contentOffset + len
+			}
 		}
 	}
 
-	return offset + contentLen, nil
+	if offset > end {
+		end = offset
+	}
+
+	return end, nil
 }
 
 var builtinTypeEncoders map[string]Encoder
@@ -1218,8 +1603,20 @@ func initBuiltinTypeEncoders() {
 		builtinTypeEncoders[encoder.String()] = encoder
 	}
 
+	// fixed<M>x<N> and ufixed<M>x<N>, 8 <= M <= 256, M % 8 == 0 and 0 < N <= 80
+	for m := uint(8); m <= 256; m += 8 {
+		for n := uint(1); n <= 80; n++ {
+			for _, sign := range []bool{false, true} {
+				encoder := ensure(Fixed(sign, m, n))
+				builtinTypeEncoders[encoder.String()] = encoder
+			}
+		}
+	}
+
 	builtinTypeEncoders["uint"] = builtinTypeEncoders["uint256"]
 	builtinTypeEncoders["int"] = builtinTypeEncoders["int256"]
+	builtinTypeEncoders["fixed"] = builtinTypeEncoders["fixed128x18"]
+	builtinTypeEncoders["ufixed"] = builtinTypeEncoders["ufixed128x18"]
 }
 
 // Get Builtin type encoder
@@ -2,10 +2,14 @@ package abi
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
+	"math/rand"
 	"reflect"
 	"testing"
+	"testing/quick"
 
+	"github.com/libs4go/fixed"
 	"github.com/stretchr/testify/require"
 )
 
@@ -132,9 +136,302 @@ func TestInteger(t *testing.T) {
 
 	IntegerCheck(t, false, 32, big.NewInt(1), "0000000000000000000000000000000000000000000000000000000000000001")
 
-	IntegerCheck(t, false, 8, big.NewInt(257), "0000000000000000000000000000000000000000000000000000000000000101")
+	IntegerCheck(t, false, 16, big.NewInt(257), "0000000000000000000000000000000000000000000000000000000000000101")
 
-	IntegerCheck(t, true, 256, big.NewInt(-16), "ff00000000000000000000000000000000000000000000000000000000000010")
+	IntegerCheck(t, true, 256, big.NewInt(-16), "fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0")
+
+	IntegerCheck(t, true, 256, big.NewInt(-1), "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	IntegerCheck(t, true, 256, new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 200)), "ffffffffffffff00000000000000000000000000000000000000000000000000")
+
+	IntegerCheck(t, true, 8, int8(-128), "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80")
+
+	IntegerCheck(t, true, 16, int16(-2), "fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe")
+
+	IntegerCheck(t, false, 16, uint16(65535), "000000000000000000000000000000000000000000000000000000000000ffff")
+
+	IntegerCheck(t, true, 256, new(big.Int).Lsh(big.NewInt(1), 254), "4000000000000000000000000000000000000000000000000000000000000000")
+}
+
+func TestIntegerRange(t *testing.T) {
+	uint8Encoder, err := Integer(false, 8)
+
+	require.NoError(t, err)
+
+	_, err = uint8Encoder.Marshal(big.NewInt(256))
+	require.Error(t, err)
+
+	_, err = uint8Encoder.Marshal(-1)
+	require.Error(t, err)
+
+	int8Encoder, err := Integer(true, 8)
+
+	require.NoError(t, err)
+
+	_, err = int8Encoder.Marshal(128)
+	require.Error(t, err)
+
+	_, err = int8Encoder.Marshal(-129)
+	require.Error(t, err)
+
+	int256Encoder, err := Integer(true, 256)
+
+	require.NoError(t, err)
+
+	_, err = int256Encoder.Marshal(new(big.Int).Lsh(big.NewInt(1), 255))
+	require.Error(t, err)
+
+	_, err = int256Encoder.Marshal((*big.Int)(nil))
+	require.Error(t, err)
+
+	// dirty high-order bits
+	var u *big.Int
+
+	_, err = uint8Encoder.Unmarshal(mustDecodeHex("0000000000000000000000000000000000000000000000000000000000000100"), &u)
+	require.Error(t, err)
+
+	_, err = uint8Encoder.Unmarshal(mustDecodeHex("f0000000000000000000000000000000000000000000000000000000000000ff"), &u)
+	require.Error(t, err)
+
+	var i int8
+
+	_, err = int8Encoder.Unmarshal(mustDecodeHex("000000000000000000000000000000000000000000000000000000000000007f"), &i)
+	require.NoError(t, err)
+	require.Equal(t, int8(127), i)
+
+	_, err = int8Encoder.Unmarshal(mustDecodeHex("0000000000000000000000000000000000000000000000000000000000000080"), &i)
+	require.Error(t, err, "positive 128 is out of int8")
+
+	_, err = int8Encoder.Unmarshal(mustDecodeHex("00ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80"), &i)
+	require.Error(t, err, "broken sign extension")
+
+	// go type overflow
+	_, err = int256Encoder.Unmarshal(mustDecodeHex("0000000000000000000000000000000000000000000000000000000000000100"), &i)
+	require.Error(t, err)
+
+	type Named uint16
+
+	var named Named
+
+	uint16Encoder, err := Integer(false, 16)
+
+	require.NoError(t, err)
+
+	buff, err := uint16Encoder.Marshal(Named(1024))
+
+	require.NoError(t, err)
+
+	_, err = uint16Encoder.Unmarshal(buff, &named)
+
+	require.NoError(t, err)
+	require.Equal(t, Named(1024), named)
+}
+
+func mustDecodeHex(s string) []byte {
+	buff, err := hex.DecodeString(s)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return buff
+}
+
+// randomInteger returns random value in the range of intN/uintN
+func randomInteger(r *rand.Rand, sign bool, bits uint) *big.Int {
+	n := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), bits))
+
+	if sign {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), bits-1))
+	}
+
+	return n
+}
+
+func TestIntegerRoundTripProperty(t *testing.T) {
+	for bits := uint(8); bits <= 256; bits += 8 {
+		for _, sign := range []bool{true, false} {
+			encoder, err := Integer(sign, bits)
+
+			require.NoError(t, err)
+
+			roundTrip := func(seed int64) bool {
+				v := randomInteger(rand.New(rand.NewSource(seed)), sign, bits)
+
+				buff, err := encoder.Marshal(v)
+
+				if err != nil || len(buff) != 32 {
+					return false
+				}
+
+				// the word is the 256 bits two's complement of v
+				word := new(big.Int).SetBytes(buff)
+
+				if v.Sign() < 0 && word.Cmp(new(big.Int).Add(two256, v)) != 0 {
+					return false
+				}
+
+				var decoded *big.Int
+
+				if _, err := encoder.Unmarshal(buff, &decoded); err != nil {
+					return false
+				}
+
+				return decoded.Cmp(v) == 0
+			}
+
+			require.NoError(t, quick.Check(roundTrip, nil), "%s", encoder)
+		}
+	}
+}
+
+func TestNativeIntegerRoundTripProperty(t *testing.T) {
+	check := func(sign bool, bits uint, f interface{}) {
+		encoder, err := Integer(sign, bits)
+
+		require.NoError(t, err)
+
+		require.NoError(t, quick.Check(reflect.MakeFunc(reflect.TypeOf(f).Elem(), func(args []reflect.Value) []reflect.Value {
+			buff, err := encoder.Marshal(args[0].Interface())
+
+			if err != nil {
+				return []reflect.Value{reflect.ValueOf(false)}
+			}
+
+			decoded := reflect.New(args[0].Type())
+
+			if _, err := encoder.Unmarshal(buff, decoded.Interface()); err != nil {
+				return []reflect.Value{reflect.ValueOf(false)}
+			}
+
+			return []reflect.Value{reflect.ValueOf(decoded.Elem().Interface() == args[0].Interface())}
+		}).Interface(), nil), "%s", encoder)
+	}
+
+	check(true, 8, (*func(int8) bool)(nil))
+	check(true, 16, (*func(int16) bool)(nil))
+	check(true, 32, (*func(int32) bool)(nil))
+	check(true, 64, (*func(int64) bool)(nil))
+	check(true, 256, (*func(int) bool)(nil))
+	check(false, 8, (*func(uint8) bool)(nil))
+	check(false, 16, (*func(uint16) bool)(nil))
+	check(false, 32, (*func(uint32) bool)(nil))
+	check(false, 64, (*func(uint64) bool)(nil))
+	check(false, 256, (*func(uint) bool)(nil))
+}
+
+func TestFixed(t *testing.T) {
+	// ufixed<M>x<N>: enc(X) is enc(X * 10**N) where X * 10**N is interpreted as a uint256
+	encoder, ok := Builtin("ufixed128x18")
+
+	require.True(t, ok)
+
+	require.Equal(t, "ufixed128x18", encoder.String())
+
+	require.Equal(t, "*fixed.Number", encoder.GoTypeName())
+
+	data, err := encoder.Marshal(1.5)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "00000000000000000000000000000000000000000000000014d1120d7b160000", hex.EncodeToString(data))
+
+	var number *fixed.Number
+
+	_, err = encoder.Unmarshal(data, &number)
+
+	require.NoError(t, err)
+
+	require.Equal(t, &fixed.Number{RawValue: big.NewInt(1500000000000000000), Decimals: 18}, number)
+
+	var f float64
+
+	_, err = encoder.Unmarshal(data, &f)
+
+	require.NoError(t, err)
+
+	require.Equal(t, 1.5, f)
+
+	// fixed<M>x<N>: enc(X) is enc(X * 10**N) where X * 10**N is interpreted as a int256
+	encoder, ok = Builtin("fixed")
+
+	require.True(t, ok)
+
+	require.Equal(t, "fixed128x18", encoder.String())
+
+	data, err = encoder.Marshal(&fixed.Number{RawValue: big.NewInt(-1500000000000000000), Decimals: 18})
+
+	require.NoError(t, err)
+
+	require.Equal(t, "ffffffffffffffffffffffffffffffffffffffffffffffffeb2eedf284ea0000", hex.EncodeToString(data))
+
+	var value Value
+
+	_, err = encoder.Unmarshal(data, &value)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "fixed128x18", value.Type())
+
+	require.Equal(t, "-1.5", value.String())
+
+	encoder, ok = Builtin("ufixed")
+
+	require.True(t, ok)
+
+	require.Equal(t, "ufixed128x18", encoder.String())
+
+	// range check by M bits
+	encoder, ok = Builtin("fixed8x1")
+
+	require.True(t, ok)
+
+	data, err = encoder.Marshal(12.7)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "000000000000000000000000000000000000000000000000000000000000007f", hex.EncodeToString(data))
+
+	_, err = encoder.Marshal(12.8)
+
+	require.Error(t, err)
+
+	_, err = encoder.Unmarshal(mustDecodeHex("0000000000000000000000000000000000000000000000000000000000000080"), &number)
+
+	require.Error(t, err)
+
+	// decimals mismatch, nil and unsupported values
+	_, err = encoder.Marshal(&fixed.Number{RawValue: big.NewInt(1), Decimals: 2})
+
+	require.Error(t, err)
+
+	_, err = encoder.Marshal((*fixed.Number)(nil))
+
+	require.Error(t, err)
+
+	_, err = encoder.Marshal("1.5")
+
+	require.Error(t, err)
+
+	for _, name := range []string{"fixed7x1", "fixed8x0", "ufixed8x81", "fixed264x18", "ufixed128"} {
+		_, ok := Builtin(name)
+
+		require.False(t, ok, name)
+	}
+
+	for _, name := range []string{"fixed8x1", "ufixed8x80", "fixed256x80", "ufixed256x1"} {
+		_, ok := Builtin(name)
+
+		require.True(t, ok, name)
+	}
+
+	_, err = Fixed(true, 8, 0)
+
+	require.Error(t, err)
+
+	_, err = Fixed(false, 12, 1)
+
+	require.Error(t, err)
 }
 
 func TestBytes(t *testing.T) {
@@ -193,7 +490,7 @@ func TestArray(t *testing.T) {
 func TestTuple(t *testing.T) {
 	packed := "0000000000000000000000000000000000000000000000000000000000000001" + // struct[a]
 		"0000000000000000000000000000000000000000000000000000000000000001" + // struct[b]
-		"ff00000000000000000000000000000000000000000000000000000000000001" + // struct[c]
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff" + // struct[c]
 		"0000000000000000000000000000000000000000000000000000000000000001" + // struct[d]
 		"00000000000000000000000000000000000000000000000000000000000000a0" + // struct[e] offset
 		"0000000000000000000000000000000000000000000000000000000000000002" + // len(struct[e])
@@ -270,3 +567,150 @@ func TestArrayPtr(t *testing.T) {
 
 	require.Equal(t, data, [20]byte{1})
 }
+
+func TestStructTuple(t *testing.T) {
+	uint256, _ := Builtin("uint256")
+	stringEncoder, _ := Builtin("string")
+
+	type Point struct {
+		X *big.Int
+		Y *big.Int
+	}
+
+	type Named struct {
+		Label string   `abi:"label"`
+		Value *big.Int `abi:"value"`
+		skip  int
+		Skip  int `abi:"-"`
+	}
+
+	point, err := Tuple("Point", uint256, uint256)
+
+	require.NoError(t, err)
+
+	require.True(t, point.Static())
+
+	named, err := NamedTuple("Named", []string{"value", "label"}, uint256, stringEncoder)
+
+	require.NoError(t, err)
+
+	require.False(t, named.Static())
+
+	// static tuple is encoded inline, dynamic tuple by offset
+	enc, err := Tuple("inputs", point, named)
+
+	require.NoError(t, err)
+
+	buff, err := enc.Marshal([]interface{}{&Point{X: big.NewInt(1), Y: big.NewInt(2)}, Named{Label: "foo", Value: big.NewInt(3)}})
+
+	require.NoError(t, err)
+
+	packed := "0000000000000000000000000000000000000000000000000000000000000001" + // point.x
+		"0000000000000000000000000000000000000000000000000000000000000002" + // point.y
+		"0000000000000000000000000000000000000000000000000000000000000060" + // named offset
+		"0000000000000000000000000000000000000000000000000000000000000003" + // named.value
+		"0000000000000000000000000000000000000000000000000000000000000040" + // named.label offset
+		"0000000000000000000000000000000000000000000000000000000000000003" + // len(named.label)
+		"666f6f0000000000000000000000000000000000000000000000000000000000"
+
+	require.Equal(t, packed, hex.EncodeToString(buff))
+
+	var p *Point
+	var n Named
+
+	l, err := enc.Unmarshal(buff, []interface{}{&p, &n})
+
+	require.NoError(t, err)
+	require.Equal(t, uint(len(buff)), l)
+
+	require.Equal(t, &Point{X: big.NewInt(1), Y: big.NewInt(2)}, p)
+	require.Equal(t, Named{Label: "foo", Value: big.NewInt(3)}, n)
+
+	// unknown tag
+	_, err = point.Marshal(&struct {
+		X *big.Int `abi:"x"`
+		Y *big.Int
+	}{big.NewInt(1), big.NewInt(2)})
+
+	require.Error(t, err)
+
+	// fields count mismatch
+	_, err = point.Marshal(&struct{ X *big.Int }{big.NewInt(1)})
+
+	require.Error(t, err)
+
+	// arrays of struct
+	points, err := Array(point)
+
+	require.NoError(t, err)
+
+	buff, err = points.Marshal([]Point{{big.NewInt(1), big.NewInt(2)}, {big.NewInt(3), big.NewInt(4)}})
+
+	require.NoError(t, err)
+
+	var decoded []Point
+
+	_, err = points.Unmarshal(buff, &decoded)
+
+	require.NoError(t, err)
+
+	require.Equal(t, []Point{{big.NewInt(1), big.NewInt(2)}, {big.NewInt(3), big.NewInt(4)}}, decoded)
+}
+
+// kindVisitor records the visited type
+type kindVisitor struct {
+	kind string
+}
+
+func (visitor *kindVisitor) HandleInt(sign bool, bits uint) {
+	visitor.kind = fmt.Sprintf("int(%v,%d)", sign, bits)
+}
+
+func (visitor *kindVisitor) HandleBool()                              { visitor.kind = "bool" }
+func (visitor *kindVisitor) HandleFixedBytes(len uint)                { visitor.kind = "fixedBytes" }
+func (visitor *kindVisitor) HandleFixedArray(len uint, elem Encoder)  { visitor.kind = "fixedArray" }
+func (visitor *kindVisitor) HandleBytes()                             { visitor.kind = "bytes" }
+func (visitor *kindVisitor) HandleArray(elem Encoder)                 { visitor.kind = "array" }
+func (visitor *kindVisitor) HandleString()                            { visitor.kind = "string" }
+func (visitor *kindVisitor) HandleTuple(name string, elems []Encoder) { visitor.kind = "tuple" }
+
+type elementaryKindVisitor struct {
+	kindVisitor
+}
+
+func (visitor *elementaryKindVisitor) HandleAddress() { visitor.kind = "address" }
+
+func (visitor *elementaryKindVisitor) HandleFixed(sign bool, M, N uint) {
+	visitor.kind = fmt.Sprintf("fixed(%v,%d,%d)", sign, M, N)
+}
+
+func TestVisitor(t *testing.T) {
+	addr, err := Address()
+
+	require.NoError(t, err)
+
+	decimal, err := Fixed(true, 128, 18)
+
+	require.NoError(t, err)
+
+	boolean, err := Bool()
+
+	require.NoError(t, err)
+
+	visitor := &kindVisitor{}
+
+	// address and fixed are visited as integers without ElementaryVisitor
+	for encoder, kind := range map[Encoder]string{addr: "int(false,160)", decimal: "int(true,128)", boolean: "bool"} {
+		encoder.Accept(visitor)
+
+		require.Equal(t, kind, visitor.kind)
+	}
+
+	elementary := &elementaryKindVisitor{}
+
+	for encoder, kind := range map[Encoder]string{addr: "address", decimal: "fixed(true,128,18)", boolean: "bool"} {
+		encoder.Accept(elementary)
+
+		require.Equal(t, kind, elementary.kind)
+	}
+}
@@ -0,0 +1,35 @@
+package abi
+
+// InterfaceID returns the ERC-165 interface id of funcs, which is the XOR of the function selectors
+func InterfaceID(funcs ...Func) [4]byte {
+	var id [4]byte
+
+	for _, f := range funcs {
+		xorSelector(&id, f.Selector())
+	}
+
+	return id
+}
+
+// InterfaceIDOf returns the ERC-165 interface id of canonical function signatures,
+// e.g. InterfaceIDOf("supportsInterface(bytes4)") is 0x01ffc9a7
+func InterfaceIDOf(signatures ...string) [4]byte {
+	var id [4]byte
+
+	for _, signature := range signatures {
+		xorSelector(&id, Selector(signature))
+	}
+
+	return id
+}
+
+// ContractInterfaceID returns the ERC-165 interface id of all contract functions
+func ContractInterfaceID(contract Contract) [4]byte {
+	return InterfaceID(contract.Funcs()...)
+}
+
+func xorSelector(id *[4]byte, selector []byte) {
+	for i := range id {
+		id[i] ^= selector[i]
+	}
+}
@@ -13,4 +13,10 @@ var (
 	ErrLength     = errors.New("length error", errors.WithVendor(errVendor), errors.WithCode(-4))
 	ErrTag        = errors.New("generate tuple tag error", errors.WithVendor(errVendor), errors.WithCode(-5))
 	ErrJSON       = errors.New("parse json abi error", errors.WithVendor(errVendor), errors.WithCode(-6))
+	ErrRange      = errors.New("integer value out of range", errors.WithVendor(errVendor), errors.WithCode(-7))
+	ErrPadding    = errors.New("dirty padding bits", errors.WithVendor(errVendor), errors.WithCode(-8))
+	ErrSyntax     = errors.New("parse human-readable abi error", errors.WithVendor(errVendor), errors.WithCode(-9))
+	ErrSelector   = errors.New("function selector not found", errors.WithVendor(errVendor), errors.WithCode(-10))
+	ErrDecimals   = errors.New("fixed decimals out of range or mismatch", errors.WithVendor(errVendor), errors.WithCode(-11))
+	ErrTopic      = errors.New("event log topics mismatch", errors.WithVendor(errVendor), errors.WithCode(-12))
 )
@@ -0,0 +1,174 @@
+package abi
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/internal/keccak"
+)
+
+// Event contract event
+type Event interface {
+	// Name returns the abi event name
+	Name() string
+	// Signature returns the canonical event signature, e.g. Transfer(address,address,uint256)
+	Signature() string
+	// Topic returns topic0 of event, the keccak256 hash of signature
+	Topic() []byte
+	// Anonymous returns true if the event is declared anonymous, whose logs have no topic0
+	Anonymous() bool
+	// Inputs returns the json abi parameters of the event
+	Inputs() []*JSONParam
+	// FilterTopics encode the filter values of indexed inputs in declaration order into log filter
+	// topics, topic0 is prepended unless the event is anonymous. Each argument is a slice of values
+	// matched as OR-set, nil or empty slice matches any value
+	FilterTopics(args ...interface{}) ([][]string, error)
+	// Unpack decode log topics and data into the pointers of inputs values in declaration order,
+	// the indexed input of hashed type is decoded as [32]byte hash, see IndexedValueType
+	Unpack(topics [][]byte, data []byte, values []interface{}) error
+}
+
+// TryGetEvent find event of contract by canonical signature, e.g. Transfer(address,address,uint256)
+func TryGetEvent(contract Contract, signature string) (Event, bool) {
+	return contract.SelectEvent(hex.EncodeToString(keccak.Hash([]byte(signature))))
+}
+
+type valueTypeVisitor struct {
+	value bool
+}
+
+func (visitor *valueTypeVisitor) HandleInt(sign bool, bits uint)           { visitor.value = true }
+func (visitor *valueTypeVisitor) HandleBool()                              { visitor.value = true }
+func (visitor *valueTypeVisitor) HandleFixedBytes(len uint)                { visitor.value = true }
+func (visitor *valueTypeVisitor) HandleFixedArray(len uint, elem Encoder)  {}
+func (visitor *valueTypeVisitor) HandleBytes()                             {}
+func (visitor *valueTypeVisitor) HandleArray(elem Encoder)                 {}
+func (visitor *valueTypeVisitor) HandleString()                            {}
+func (visitor *valueTypeVisitor) HandleTuple(name string, elems []Encoder) {}
+
+// IndexedValueType returns true if the indexed event input of encoder type is stored in topic as
+// it is, the topic of other types (bytes, string, arrays and tuples) is the keccak256 hash of value
+func IndexedValueType(encoder Encoder) bool {
+	visitor := &valueTypeVisitor{}
+
+	encoder.Accept(visitor)
+
+	return visitor.value
+}
+
+// EncodeTopic encode indexed event argument into topic, string and bytes values are hashed, the
+// other hashed types only accept the [32]byte hash
+func EncodeTopic(encoder Encoder, value interface{}) ([]byte, error) {
+	if IndexedValueType(encoder) {
+		return encoder.Marshal(value)
+	}
+
+	switch v := value.(type) {
+	case [32]byte:
+		return v[:], nil
+	case string:
+		if encoder.String() == "string" {
+			return keccak.Hash([]byte(v)), nil
+		}
+	case []byte:
+		if encoder.String() == "bytes" {
+			return keccak.Hash(v), nil
+		}
+	}
+
+	return nil, errors.Wrap(ErrValue, "indexed %s topic expect value or [32]byte hash, got %T", encoder, value)
+}
+
+// DecodeTopic decode indexed event argument of topic into value pointer, the hashed types are
+// decoded into *[32]byte
+func DecodeTopic(encoder Encoder, topic []byte, value interface{}) error {
+	if len(topic) != 32 {
+		return errors.Wrap(ErrTopic, "topic length %d != 32", len(topic))
+	}
+
+	if IndexedValueType(encoder) {
+		_, err := encoder.Unmarshal(topic, value)
+
+		return err
+	}
+
+	hash, ok := value.(*[32]byte)
+
+	if !ok {
+		return errors.Wrap(ErrValue, "indexed %s topic expect *[32]byte, got %T", encoder, value)
+	}
+
+	copy(hash[:], topic)
+
+	return nil
+}
+
+// EncodeFilterTopics encode the OR-sets of indexed arguments, see Event.FilterTopics, the
+// trailing wildcard positions are trimmed
+func EncodeFilterTopics(topic0 []byte, encoders []Encoder, args []interface{}) ([][]string, error) {
+	if len(args) > len(encoders) {
+		return nil, errors.Wrap(ErrTopic, "filter args %d > indexed inputs %d", len(args), len(encoders))
+	}
+
+	var topics [][]string
+
+	if topic0 != nil {
+		topics = append(topics, []string{"0x" + hex.EncodeToString(topic0)})
+	}
+
+	for i, arg := range args {
+		var set []string
+
+		if arg != nil {
+			v := reflect.ValueOf(arg)
+
+			if v.Kind() != reflect.Slice {
+				return nil, errors.Wrap(ErrValue, "filter arg %d expect slice of values, got %T", i, arg)
+			}
+
+			for j := 0; j < v.Len(); j++ {
+				topic, err := EncodeTopic(encoders[i], v.Index(j).Interface())
+
+				if err != nil {
+					return nil, err
+				}
+
+				set = append(set, "0x"+hex.EncodeToString(topic))
+			}
+		}
+
+		topics = append(topics, set)
+	}
+
+	for len(topics) > 0 && len(topics[len(topics)-1]) == 0 {
+		topics = topics[:len(topics)-1]
+	}
+
+	return topics, nil
+}
+
+// UnpackLog decode hex topics and data of log, see Event.Unpack
+func UnpackLog(event Event, log *client.Log, values []interface{}) error {
+	var topics [][]byte
+
+	for _, topic := range log.Topics {
+		buff, err := hex.DecodeString(strings.TrimPrefix(topic, "0x"))
+
+		if err != nil {
+			return errors.Wrap(ErrTopic, "decode log topic %s error", topic)
+		}
+
+		topics = append(topics, buff)
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(log.Data, "0x"))
+
+	if err != nil {
+		return errors.Wrap(ErrValue, "decode log data %s error", log.Data)
+	}
+
+	return event.Unpack(topics, data, values)
+}
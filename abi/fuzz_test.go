@@ -0,0 +1,121 @@
+//go:build go1.18
+// +build go1.18
+
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/fixed"
+	"github.com/stretchr/testify/require"
+)
+
+// fuzzDecode decoding arbitrary data must never panic, and data accepted by the strict Validate
+// must decode and re-encode to strict valid data
+func fuzzDecode(f *testing.F, encoder Encoder, newTarget func() interface{}, seeds ...interface{}) {
+	for _, seed := range seeds {
+		data, err := encoder.Marshal(seed)
+
+		require.NoError(f, err)
+
+		f.Add(data)
+	}
+
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, typedErr := encoder.Unmarshal(data, newTarget())
+
+		var value Value
+
+		_, err := encoder.Unmarshal(data, &value)
+
+		if _, strictErr := Validate(encoder, data); strictErr != nil {
+			return
+		}
+
+		require.NoError(t, typedErr)
+		require.NoError(t, err)
+
+		buff, err := encoder.Marshal(value)
+
+		require.NoError(t, err)
+
+		l, err := Validate(encoder, buff)
+
+		require.NoError(t, err)
+
+		require.Equal(t, uint(len(buff)), l)
+
+		var decoded Value
+
+		_, err = encoder.Unmarshal(buff, &decoded)
+
+		require.NoError(t, err)
+
+		require.Equal(t, value.String(), decoded.String())
+	})
+}
+
+func FuzzInteger(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "int64"), func() interface{} { return new(*big.Int) }, int64(-1), int64(1<<40))
+}
+
+func FuzzUint(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "uint256"), func() interface{} { return new(*big.Int) }, big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), 255))
+}
+
+func FuzzAddress(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "address"), func() interface{} { return new(address.Address) }, address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"))
+}
+
+func FuzzBool(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "bool"), func() interface{} { return new(bool) }, true, false)
+}
+
+func FuzzFixed(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "fixed128x18"), func() interface{} { return new(*fixed.Number) }, -1.5, 0.25)
+}
+
+func FuzzFixedBytes(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "bytes3"), func() interface{} { return new([3]byte) }, [3]byte{1, 2, 3})
+}
+
+func FuzzBytes(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "bytes"), func() interface{} { return new([]byte) }, []byte{}, make([]byte, 33))
+}
+
+func FuzzString(f *testing.F) {
+	fuzzDecode(f, builtinMust(f, "string"), func() interface{} { return new(string) }, "", "hello")
+}
+
+func FuzzFixedArray(f *testing.F) {
+	fuzzDecode(f, ensure(ParseType("string[2]")), func() interface{} { return new([2]string) }, [2]string{"a", "bc"})
+}
+
+func FuzzArray(f *testing.F) {
+	fuzzDecode(f, ensure(ParseType("uint8[][]")), func() interface{} { return new([][]uint8) }, [][]uint8{{1}, {}, {2, 3}})
+}
+
+func FuzzTuple(f *testing.F) {
+	item := ensure(NamedTuple("Item", []string{"id", "memo"}, builtinMust(f, "uint256"), builtinMust(f, "string")))
+
+	items := ensure(Array(item))
+
+	tuple := ensure(NamedTuple("Root", []string{"ok", "items", "data"}, builtinMust(f, "bool"), items, builtinMust(f, "bytes")))
+
+	type Item struct {
+		ID   *big.Int
+		Memo string
+	}
+
+	type Root struct {
+		OK    bool
+		Items []Item
+		Data  []byte
+	}
+
+	fuzzDecode(f, tuple, func() interface{} { return new(Root) }, &Root{OK: true, Items: []Item{{big.NewInt(1), "one"}}, Data: []byte{1}})
+}
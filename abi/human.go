@@ -0,0 +1,589 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/libs4go/errors"
+)
+
+// ParseHumanReadable parse ethers.js style human-readable abi fragments into json abi fields, e.g.
+//
+//	"function transfer(address to, uint256 amount) returns (bool)"
+//	"event Transfer(address indexed from, address indexed to, uint256 value)"
+//	"error Insufficient(uint256 needed)"
+//	"struct Order { address maker; uint256 amount; }"
+//
+// Struct declarations can be referenced by name in any other fragment, tuple parameters
+// can also be declared inline as "tuple(uint256 a, address b)[]" or "(uint256,address)[]"
+func ParseHumanReadable(fragments ...string) ([]*JSONField, error) {
+	parser := &humanParser{
+		structs:   make(map[string][]string),
+		resolved:  make(map[string][]*JSONParam),
+		resolving: make(map[string]bool),
+	}
+
+	var others [][]string
+
+	for _, fragment := range fragments {
+		tokens, err := tokenize(fragment)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(tokens) == 0 {
+			continue
+		}
+
+		if tokens[0] == "struct" {
+			if len(tokens) < 2 || !isIdentifier(tokens[1]) {
+				return nil, errors.Wrap(ErrSyntax, "invalid struct declaration: %s", fragment)
+			}
+
+			if _, ok := parser.structs[tokens[1]]; ok {
+				return nil, errors.Wrap(ErrSyntax, "duplicate struct %s", tokens[1])
+			}
+
+			parser.structs[tokens[1]] = tokens
+
+			continue
+		}
+
+		others = append(others, tokens)
+	}
+
+	var fields []*JSONField
+
+	for _, tokens := range others {
+		parser.tokens = tokens
+		parser.pos = 0
+
+		field, err := parser.parseFragment()
+
+		if err != nil {
+			return nil, errors.Wrap(err, "parse fragment '%s' error", strings.Join(tokens, " "))
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func isIdentifier(token string) bool {
+	if token == "" {
+		return false
+	}
+
+	for i, c := range token {
+		if c == '_' || c == '$' || unicode.IsLetter(c) || (i > 0 && unicode.IsDigit(c)) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func tokenize(fragment string) ([]string, error) {
+	var tokens []string
+
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, c := range fragment {
+		switch {
+		case unicode.IsSpace(c):
+			flush()
+		case strings.ContainsRune("()[],;{}", c):
+			flush()
+			tokens = append(tokens, string(c))
+		case c == '_' || c == '$' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			current.WriteRune(c)
+		default:
+			return nil, errors.Wrap(ErrSyntax, "unexpected character '%c' in '%s'", c, fragment)
+		}
+	}
+
+	flush()
+
+	return tokens, nil
+}
+
+type humanParser struct {
+	tokens    []string
+	pos       int
+	structs   map[string][]string     // struct declaration tokens
+	resolved  map[string][]*JSONParam // resolved struct components
+	resolving map[string]bool         // struct recursion detection
+}
+
+func (parser *humanParser) peek() string {
+	if parser.pos < len(parser.tokens) {
+		return parser.tokens[parser.pos]
+	}
+
+	return ""
+}
+
+func (parser *humanParser) next() string {
+	token := parser.peek()
+
+	if parser.pos < len(parser.tokens) {
+		parser.pos++
+	}
+
+	return token
+}
+
+func (parser *humanParser) expect(token string) error {
+	if got := parser.next(); got != token {
+		if got == "" {
+			got = "end of fragment"
+		}
+
+		return errors.Wrap(ErrSyntax, "expect '%s', got '%s'", token, got)
+	}
+
+	return nil
+}
+
+func (parser *humanParser) parseFragment() (*JSONField, error) {
+	field := &JSONField{}
+
+	kind := parser.peek()
+
+	switch kind {
+	case "function", "event", "error", "constructor", "fallback", "receive":
+		parser.next()
+	default:
+		// bare function signature, e.g. "balanceOf(address)"
+		kind = "function"
+	}
+
+	field.Type = JSONFieldType(kind)
+
+	switch field.Type {
+	case JSONTypeFunc, JSONTypeEvent, JSONTypeError:
+		field.Name = parser.next()
+
+		if !isIdentifier(field.Name) {
+			return nil, errors.Wrap(ErrSyntax, "invalid %s name '%s'", kind, field.Name)
+		}
+	}
+
+	var err error
+
+	field.Inputs, err = parser.parseParams(field.Type == JSONTypeEvent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field.Type == JSONTypeEvent || field.Type == JSONTypeError {
+		if field.Type == JSONTypeEvent {
+			anonymous := false
+
+			if parser.peek() == "anonymous" {
+				parser.next()
+				anonymous = true
+			}
+
+			field.Anonymous = &anonymous
+		}
+
+		return field, parser.end()
+	}
+
+	mutability := StateMutabilityNonpayable
+
+	for parser.peek() != "" && parser.peek() != "returns" && parser.peek() != ";" {
+		switch modifier := parser.next(); modifier {
+		case "view", "pure", "payable", "nonpayable":
+			mutability = StateMutability(modifier)
+		case "constant":
+			mutability = StateMutabilityView
+		case "external", "public", "internal", "private", "virtual":
+		case "override":
+			if parser.peek() == "(" {
+				for parser.peek() != ")" && parser.peek() != "" {
+					parser.next()
+				}
+
+				if err := parser.expect(")"); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			return nil, errors.Wrap(ErrSyntax, "unexpected modifier '%s'", modifier)
+		}
+	}
+
+	field.StateMutability = &mutability
+
+	if parser.peek() == "returns" {
+		if field.Type != JSONTypeFunc {
+			return nil, errors.Wrap(ErrSyntax, "%s can't have returns", kind)
+		}
+
+		parser.next()
+
+		field.Outputs, err = parser.parseParams(false)
+
+		if err != nil {
+			return nil, err
+		}
+	} else if field.Type == JSONTypeFunc {
+		field.Outputs = []*JSONParam{}
+	}
+
+	return field, parser.end()
+}
+
+func (parser *humanParser) end() error {
+	if parser.peek() == ";" {
+		parser.next()
+	}
+
+	if token := parser.peek(); token != "" {
+		return errors.Wrap(ErrSyntax, "unexpected token '%s'", token)
+	}
+
+	return nil
+}
+
+// parseParams parse "(param, param ...)"
+func (parser *humanParser) parseParams(event bool) ([]*JSONParam, error) {
+	if err := parser.expect("("); err != nil {
+		return nil, err
+	}
+
+	params := []*JSONParam{}
+
+	if parser.peek() == ")" {
+		parser.next()
+		return params, nil
+	}
+
+	for {
+		param, err := parser.parseParam(event)
+
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, param)
+
+		switch token := parser.next(); token {
+		case ",":
+			continue
+		case ")":
+			return params, nil
+		default:
+			return nil, errors.Wrap(ErrSyntax, "expect ',' or ')', got '%s'", token)
+		}
+	}
+}
+
+// parseParam parse "type [indexed] [location] [name]"
+func (parser *humanParser) parseParam(event bool) (*JSONParam, error) {
+	param, err := parser.parseType()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if event {
+		indexed := false
+
+		if parser.peek() == "indexed" {
+			parser.next()
+			indexed = true
+		}
+
+		param.Indexed = &indexed
+	}
+
+	switch parser.peek() {
+	case "memory", "calldata", "storage":
+		parser.next()
+	}
+
+	if token := parser.peek(); token != "," && token != ")" && token != ";" && token != "" {
+		if !isIdentifier(token) {
+			return nil, errors.Wrap(ErrSyntax, "invalid parameter name '%s'", token)
+		}
+
+		param.Name = parser.next()
+	}
+
+	return param, nil
+}
+
+var elementaryAliases = map[string]string{
+	"uint":   "uint256",
+	"int":    "int256",
+	"byte":   "bytes1",
+	"fixed":  "fixed128x18",
+	"ufixed": "ufixed128x18",
+}
+
+// parseType parse elementary, struct or tuple type with array suffixes
+func (parser *humanParser) parseType() (*JSONParam, error) {
+	param := &JSONParam{}
+
+	token := parser.peek()
+
+	switch {
+	case token == "(" || (token == "tuple" && parser.pos+1 < len(parser.tokens) && parser.tokens[parser.pos+1] == "("):
+		if token == "tuple" {
+			parser.next()
+		}
+
+		components, err := parser.parseParams(false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		param.Type = "tuple"
+		param.InternalType = "tuple"
+		param.Components = components
+	case isIdentifier(token):
+		parser.next()
+
+		if _, ok := parser.structs[token]; ok {
+			components, err := parser.resolveStruct(token)
+
+			if err != nil {
+				return nil, err
+			}
+
+			param.Type = "tuple"
+			param.InternalType = "struct " + token
+			param.Components = components
+
+			break
+		}
+
+		if alias, ok := elementaryAliases[token]; ok {
+			token = alias
+		}
+
+		if _, ok := Builtin(token); !ok {
+			return nil, errors.Wrap(ErrSyntax, "unknown type '%s'", token)
+		}
+
+		if token == "address" && parser.peek() == "payable" {
+			parser.next()
+		}
+
+		param.Type = token
+		param.InternalType = token
+	default:
+		return nil, errors.Wrap(ErrSyntax, "expect type, got '%s'", token)
+	}
+
+	for parser.peek() == "[" {
+		parser.next()
+
+		suffix := "[]"
+
+		if token := parser.peek(); token != "]" {
+			parser.next()
+
+			var size uint
+
+			if _, err := fmt.Sscanf(token, "%d", &size); err != nil || size == 0 || fmt.Sprint(size) != token {
+				return nil, errors.Wrap(ErrSyntax, "invalid array length '%s'", token)
+			}
+
+			suffix = fmt.Sprintf("[%d]", size)
+		}
+
+		if err := parser.expect("]"); err != nil {
+			return nil, err
+		}
+
+		param.Type += suffix
+		param.InternalType += suffix
+	}
+
+	return param, nil
+}
+
+// resolveStruct parse "struct Name { type name; ... }" declaration
+func (parser *humanParser) resolveStruct(name string) ([]*JSONParam, error) {
+	if components, ok := parser.resolved[name]; ok {
+		return cloneParams(components), nil
+	}
+
+	if parser.resolving[name] {
+		return nil, errors.Wrap(ErrSyntax, "recursive struct %s", name)
+	}
+
+	parser.resolving[name] = true
+	defer delete(parser.resolving, name)
+
+	tokens, pos := parser.tokens, parser.pos
+
+	defer func() {
+		parser.tokens, parser.pos = tokens, pos
+	}()
+
+	parser.tokens = parser.structs[name]
+	parser.pos = 2
+
+	if err := parser.expect("{"); err != nil {
+		return nil, errors.Wrap(err, "struct %s", name)
+	}
+
+	var components []*JSONParam
+
+	for parser.peek() != "}" {
+		param, err := parser.parseParam(false)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "struct %s", name)
+		}
+
+		if param.Name == "" {
+			return nil, errors.Wrap(ErrSyntax, "struct %s member name expect", name)
+		}
+
+		if err := parser.expect(";"); err != nil {
+			return nil, errors.Wrap(err, "struct %s", name)
+		}
+
+		components = append(components, param)
+	}
+
+	parser.next()
+
+	if len(components) == 0 {
+		return nil, errors.Wrap(ErrSyntax, "empty struct %s", name)
+	}
+
+	if err := parser.end(); err != nil {
+		return nil, errors.Wrap(err, "struct %s", name)
+	}
+
+	parser.resolved[name] = components
+
+	return cloneParams(components), nil
+}
+
+func cloneParams(params []*JSONParam) []*JSONParam {
+	cloned := make([]*JSONParam, 0, len(params))
+
+	for _, param := range params {
+		p := *param
+		p.Components = cloneParams(param.Components)
+		cloned = append(cloned, &p)
+	}
+
+	return cloned
+}
+
+// CanonicalType returns the canonical type used by signature, tuple is expanded to
+// its components, e.g. "(uint256,address)[]"
+func (param *JSONParam) CanonicalType() string {
+	if !strings.HasPrefix(param.Type, "tuple") {
+		return param.Type
+	}
+
+	var types []string
+
+	for _, component := range param.Components {
+		types = append(types, component.CanonicalType())
+	}
+
+	return fmt.Sprintf("(%s)%s", strings.Join(types, ","), strings.TrimPrefix(param.Type, "tuple"))
+}
+
+// Signature returns the canonical signature of function, event or error, e.g. "transfer(address,uint256)"
+func (field *JSONField) Signature() string {
+	var types []string
+
+	for _, param := range field.Inputs {
+		types = append(types, param.CanonicalType())
+	}
+
+	return fmt.Sprintf("%s(%s)", field.Name, strings.Join(types, ","))
+}
+
+func (param *JSONParam) humanReadable() string {
+	typ := param.Type
+
+	if strings.HasPrefix(param.Type, "tuple") {
+		var components []string
+
+		for _, component := range param.Components {
+			components = append(components, component.humanReadable())
+		}
+
+		typ = fmt.Sprintf("tuple(%s)%s", strings.Join(components, ", "), strings.TrimPrefix(param.Type, "tuple"))
+	}
+
+	if param.Indexed != nil && *param.Indexed {
+		typ += " indexed"
+	}
+
+	if param.Name != "" {
+		typ += " " + param.Name
+	}
+
+	return typ
+}
+
+func humanReadableParams(params []*JSONParam) string {
+	var elems []string
+
+	for _, param := range params {
+		elems = append(elems, param.humanReadable())
+	}
+
+	return strings.Join(elems, ", ")
+}
+
+// HumanReadable returns the human-readable fragment of field, which can be parsed by ParseHumanReadable
+func (field *JSONField) HumanReadable() string {
+	var buff strings.Builder
+
+	buff.WriteString(string(field.Type))
+
+	if field.Name != "" {
+		buff.WriteString(" ")
+		buff.WriteString(field.Name)
+	}
+
+	buff.WriteString("(")
+	buff.WriteString(humanReadableParams(field.Inputs))
+	buff.WriteString(")")
+
+	if field.Anonymous != nil && *field.Anonymous {
+		buff.WriteString(" anonymous")
+	}
+
+	if field.StateMutability != nil && *field.StateMutability != StateMutabilityNonpayable {
+		buff.WriteString(" ")
+		buff.WriteString(string(*field.StateMutability))
+	}
+
+	if len(field.Outputs) > 0 {
+		buff.WriteString(" returns (")
+		buff.WriteString(humanReadableParams(field.Outputs))
+		buff.WriteString(")")
+	}
+
+	return buff.String()
+}
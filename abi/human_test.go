@@ -0,0 +1,149 @@
+package abi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHumanReadable(t *testing.T) {
+	fields, err := ParseHumanReadable(
+		"function transfer(address to, uint256 amount) returns (bool)",
+		"function balanceOf(address owner) view returns (uint)",
+		"event Transfer(address indexed from, address indexed to, uint256 value)",
+		"error Insufficient(uint256 needed)",
+		"constructor(string name_, string symbol_)",
+		"receive() external payable",
+		"allowance(address,address)",
+	)
+
+	require.NoError(t, err)
+
+	require.Len(t, fields, 7)
+
+	var signatures []string
+
+	for _, field := range fields {
+		signatures = append(signatures, field.Signature())
+	}
+
+	require.Equal(t, []string{
+		"transfer(address,uint256)",
+		"balanceOf(address)",
+		"Transfer(address,address,uint256)",
+		"Insufficient(uint256)",
+		"(string,string)",
+		"()",
+		"allowance(address,address)",
+	}, signatures)
+
+	data, err := json.Marshal(fields[:4])
+
+	require.NoError(t, err)
+
+	require.JSONEq(t, `[
+		{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		 "inputs":[{"name":"to","type":"address","internalType":"address"},{"name":"amount","type":"uint256","internalType":"uint256"}],
+		 "outputs":[{"name":"","type":"bool","internalType":"bool"}]},
+		{"type":"function","name":"balanceOf","stateMutability":"view",
+		 "inputs":[{"name":"owner","type":"address","internalType":"address"}],
+		 "outputs":[{"name":"","type":"uint256","internalType":"uint256"}]},
+		{"type":"event","name":"Transfer","anonymous":false,
+		 "inputs":[{"name":"from","type":"address","internalType":"address","indexed":true},{"name":"to","type":"address","internalType":"address","indexed":true},{"name":"value","type":"uint256","internalType":"uint256","indexed":false}]},
+		{"type":"error","name":"Insufficient","inputs":[{"name":"needed","type":"uint256","internalType":"uint256"}]}
+	]`, string(data))
+
+	require.Equal(t, StateMutabilityPayable, *fields[5].StateMutability)
+
+	// human-readable round trip
+	for _, field := range fields {
+		parsed, err := ParseHumanReadable(field.HumanReadable())
+
+		require.NoError(t, err, field.HumanReadable())
+
+		require.Equal(t, field.Signature(), parsed[0].Signature())
+		require.Equal(t, field.StateMutability, parsed[0].StateMutability)
+	}
+}
+
+func TestJSONFieldOutputs(t *testing.T) {
+	mutability := StateMutabilityNonpayable
+
+	data, err := json.Marshal([]*JSONField{
+		{Type: JSONTypeFunc, Name: "sync", Inputs: []*JSONParam{}, StateMutability: &mutability},
+		{Type: JSONTypeConstructor, Inputs: []*JSONParam{}, StateMutability: &mutability},
+	})
+
+	require.NoError(t, err)
+
+	require.JSONEq(t, `[
+		{"type":"function","name":"sync","inputs":[],"outputs":[],"stateMutability":"nonpayable"},
+		{"type":"constructor","inputs":[],"stateMutability":"nonpayable"}
+	]`, string(data))
+
+	var fields []*JSONField
+
+	require.NoError(t, json.Unmarshal(data, &fields))
+
+	require.NotNil(t, fields[0].Outputs)
+	require.Nil(t, fields[1].Outputs)
+}
+
+func TestParseHumanReadableStruct(t *testing.T) {
+	fields, err := ParseHumanReadable(
+		"function fill(Order[] calldata orders, (uint8 v, bytes32 r, bytes32 s) sig, tuple(address, uint256)[2] fees) payable returns (Receipt memory receipt)",
+		"struct Order { Asset asset; address maker; uint256[] amounts; }",
+		"struct Asset { address token; uint256 id; }",
+		"struct Receipt { bytes32 hash; Asset[] assets; };",
+	)
+
+	require.NoError(t, err)
+
+	require.Len(t, fields, 1)
+
+	require.Equal(t, "fill(((address,uint256),address,uint256[])[],(uint8,bytes32,bytes32),(address,uint256)[2])", fields[0].Signature())
+
+	orders := fields[0].Inputs[0]
+
+	require.Equal(t, "tuple[]", orders.Type)
+	require.Equal(t, "struct Order[]", orders.InternalType)
+	require.Equal(t, "struct Asset", orders.Components[0].InternalType)
+	require.Equal(t, "orders", orders.Name)
+
+	require.Equal(t, "tuple", fields[0].Inputs[1].InternalType)
+	require.Equal(t, "tuple[2]", fields[0].Inputs[2].Type)
+
+	require.Equal(t, "(bytes32,(address,uint256)[])", fields[0].Outputs[0].CanonicalType())
+
+	parsed, err := ParseHumanReadable(fields[0].HumanReadable())
+
+	require.NoError(t, err)
+
+	require.Equal(t, fields[0].Signature(), parsed[0].Signature())
+}
+
+func TestParseHumanReadableError(t *testing.T) {
+	for _, fragment := range []string{
+		"function transfer(address to, uint257 amount)",
+		"function transfer(address to",
+		"function transfer(address to) returns bool",
+		"function transfer(address[0] to)",
+		"function transfer(Unknown to)",
+		"event Transfer(address indexed from) view",
+		"function 1transfer()",
+		"function transfer() foo",
+	} {
+		_, err := ParseHumanReadable(fragment)
+
+		require.Error(t, err, fragment)
+	}
+
+	_, err := ParseHumanReadable("function f(A a)", "struct A { B b; }", "struct B { A a; }")
+
+	require.Error(t, err)
+
+	_, err = ParseHumanReadable("struct A { uint256 a; }", "struct A { uint256 b; }")
+
+	require.Error(t, err)
+}
@@ -1,5 +1,7 @@
 package abi
 
+import "encoding/json"
+
 type JSONFieldType string
 
 const (
@@ -22,18 +24,41 @@ const (
 
 type JSONField struct {
 	Type JSONFieldType `json:"type"`
-	Name string        `json:"name"`
+	Name string        `json:"name,omitempty"`
 
 	Inputs          []*JSONParam     `json:"inputs"`
 	Outputs         []*JSONParam     `json:"outputs"`
-	StateMutability *StateMutability `json:"stateMutability"`
-	Anonymous       *bool            `json:"anonymous"`
+	StateMutability *StateMutability `json:"stateMutability,omitempty"`
+	Anonymous       *bool            `json:"anonymous,omitempty"`
+}
+
+// MarshalJSON marshal field as canonical json abi, outputs is always present for function, e.g.
+// "outputs":[], and is omitted by the other entries
+func (field JSONField) MarshalJSON() ([]byte, error) {
+	type jsonField JSONField
+
+	value := struct {
+		*jsonField
+		Outputs *[]*JSONParam `json:"outputs,omitempty"`
+	}{jsonField: (*jsonField)(&field)}
+
+	if field.Type == JSONTypeFunc {
+		outputs := field.Outputs
+
+		if outputs == nil {
+			outputs = []*JSONParam{}
+		}
+
+		value.Outputs = &outputs
+	}
+
+	return json.Marshal(value)
 }
 
 type JSONParam struct {
 	Name         string       `json:"name"`
 	Type         string       `json:"type"`
-	InternalType string       `json:"internalType"`
-	Components   []*JSONParam `json:"components"`
-	Indexed      *bool        `json:"indexed"`
+	InternalType string       `json:"internalType,omitempty"`
+	Components   []*JSONParam `json:"components,omitempty"`
+	Indexed      *bool        `json:"indexed,omitempty"`
 }
@@ -0,0 +1,258 @@
+package abi
+
+import (
+	"crypto/sha256"
+	"regexp"
+	"strconv"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/internal/keccak"
+)
+
+// Non-standard packed mode, see https://docs.soliditylang.org/en/latest/abi-spec.html#non-standard-packed-mode
+//
+// - types shorter than 32 bytes are concatenated directly, without padding or sign extension
+// - dynamic types are encoded in-place and without the length
+// - array elements are padded, but still encoded in-place
+// - structs and nested arrays are not supported
+
+// PackedEncoder optional Encoder extension of non-standard packed mode, see MarshalPacked
+type PackedEncoder interface {
+	Encoder
+	// MarshalPacked encode value in non-standard packed mode, abi.encodePacked
+	MarshalPacked(value interface{}) ([]byte, error)
+}
+
+// MarshalPacked encode value in packed mode, the encoder must implement PackedEncoder
+func MarshalPacked(encoder Encoder, value interface{}) ([]byte, error) {
+	packed, ok := encoder.(PackedEncoder)
+
+	if !ok {
+		return nil, errors.Wrap(ErrValue, "%s: packed mode not supported", encoder)
+	}
+
+	return packed.MarshalPacked(value)
+}
+
+func (enc *integerEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data[32-enc.bits/8:], nil
+}
+
+func (enc *addressEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data[12:], nil
+}
+
+func (enc *boolEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data[31:], nil
+}
+
+func (enc *fixedEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data[32-enc.M/8:], nil
+}
+
+func (enc *fixedBytesEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data[:enc.len], nil
+}
+
+// packedDynamicBytes strip the length prefix and the right padding of dynamic bytes encoding
+func packedDynamicBytes(data []byte) ([]byte, error) {
+	length, err := decodeLength(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(len(data)-32) < length {
+		return nil, errors.Wrap(ErrLength, "packed bytes length %d out of range", length)
+	}
+
+	return data[32 : 32+length], nil
+}
+
+func (enc *bytesEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return packedDynamicBytes(data)
+}
+
+func (enc *stringEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return packedDynamicBytes(data)
+}
+
+// packedElemCheck check the array element is supported by packed mode,
+// only static elementary types are allowed
+func packedElemCheck(array Encoder, elem Encoder) error {
+	switch elem.(type) {
+	case *fixedArrayEncoder, *arrayEncoder, *tupleEncoder, *bytesEncoder, *stringEncoder:
+		return errors.Wrap(ErrValue, "%s: packed mode not support element type %s", array, elem)
+	}
+
+	return nil
+}
+
+func (enc *fixedArrayEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	if err := packedElemCheck(enc, enc.elem); err != nil {
+		return nil, err
+	}
+
+	// static elements are padded to 32 bytes, which is the standard encoding
+	return enc.Marshal(value)
+}
+
+func (enc *arrayEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	if err := packedElemCheck(enc, enc.elem); err != nil {
+		return nil, err
+	}
+
+	data, err := enc.Marshal(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// skip the length prefix
+	return data[32:], nil
+}
+
+func (enc *tupleEncoder) MarshalPacked(value interface{}) ([]byte, error) {
+	return nil, errors.Wrap(ErrValue, "%s: packed mode not support tuple", enc)
+}
+
+// EncodePacked encode values in packed mode, abi.encodePacked(...)
+func EncodePacked(encoders []Encoder, values []interface{}) ([]byte, error) {
+	if len(encoders) != len(values) {
+		return nil, errors.Wrap(ErrLength, "encoders len %d != values len %d", len(encoders), len(values))
+	}
+
+	var buff []byte
+
+	for i, encoder := range encoders {
+		data, err := MarshalPacked(encoder, values[i])
+
+		if err != nil {
+			return nil, errors.Wrap(err, "encode packed value(%d) error", i)
+		}
+
+		buff = append(buff, data...)
+	}
+
+	return buff, nil
+}
+
+var typeArrayRegex = regexp.MustCompile(`^(.*)\[(\d*)\]$`)
+
+// ParseType get encoder of elementary type or array of elementary type, e.g. "uint256[2][]"
+func ParseType(typ string) (Encoder, error) {
+	if alias, ok := elementaryAliases[typ]; ok {
+		typ = alias
+	}
+
+	if encoder, ok := Builtin(typ); ok {
+		return encoder, nil
+	}
+
+	match := typeArrayRegex.FindStringSubmatch(typ)
+
+	if match == nil {
+		return nil, errors.Wrap(ErrSyntax, "unknown type '%s'", typ)
+	}
+
+	elem, err := ParseType(match[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if match[2] == "" {
+		return Array(elem)
+	}
+
+	size, err := strconv.ParseUint(match[2], 10, 32)
+
+	if err != nil || size == 0 {
+		return nil, errors.Wrap(ErrSyntax, "invalid array length of type '%s'", typ)
+	}
+
+	return FixedArray(elem, uint(size))
+}
+
+// SolidityPacked encode values by solidity type names in packed mode, like ethers solidityPacked
+func SolidityPacked(types []string, values []interface{}) ([]byte, error) {
+	var encoders []Encoder
+
+	for _, typ := range types {
+		encoder, err := ParseType(typ)
+
+		if err != nil {
+			return nil, err
+		}
+
+		encoders = append(encoders, encoder)
+	}
+
+	return EncodePacked(encoders, values)
+}
+
+// SolidityKeccak returns keccak256(abi.encodePacked(...)), like ethers solidityPackedKeccak256
+func SolidityKeccak(types []string, values []interface{}) ([]byte, error) {
+	data, err := SolidityPacked(types, values)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keccak.Hash(data), nil
+}
+
+// SoliditySha256 returns sha256(abi.encodePacked(...)), like ethers solidityPackedSha256
+func SoliditySha256(types []string, values []interface{}) ([]byte, error) {
+	data, err := SolidityPacked(types, values)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hash[:], nil
+}
@@ -0,0 +1,146 @@
+package abi
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolidityPacked(t *testing.T) {
+	// abi.encodePacked(int16(-1), bytes1(0x42), uint16(0x03), string("Hello, world!")), from solidity abi spec
+	data, err := SolidityPacked(
+		[]string{"int16", "bytes1", "uint16", "string"},
+		[]interface{}{int16(-1), [1]byte{0x42}, uint16(3), "Hello, world!"},
+	)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "ffff42000348656c6c6f2c20776f726c6421", hex.EncodeToString(data))
+
+	hash, err := SolidityKeccak(
+		[]string{"int16", "bytes1", "uint16", "string"},
+		[]interface{}{int16(-1), [1]byte{0x42}, uint16(3), "Hello, world!"},
+	)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "a61ecacd5de1490dcd3f7dad8f517cb383f00d6839207a7d8587ded6965e7889", hex.EncodeToString(hash))
+
+	hash, err = SoliditySha256(
+		[]string{"int16", "bytes1", "uint16", "string"},
+		[]interface{}{int16(-1), [1]byte{0x42}, uint16(3), "Hello, world!"},
+	)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "ff14471951451962996f0a30b1545597babb982d18bf27c04e4fa2f0a6b40195", hex.EncodeToString(hash))
+
+	// abi.encodePacked("a", "bc") == abi.encodePacked("ab", "c"), from solidity abi spec
+	a, err := SolidityPacked([]string{"string", "string"}, []interface{}{"a", "bc"})
+
+	require.NoError(t, err)
+
+	b, err := SolidityPacked([]string{"string", "string"}, []interface{}{"ab", "c"})
+
+	require.NoError(t, err)
+
+	require.Equal(t, a, b)
+}
+
+func TestSolidityPackedTypes(t *testing.T) {
+	owner := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	data, err := SolidityPacked(
+		[]string{"address", "bool", "uint", "int8", "bytes", "ufixed8x1"},
+		[]interface{}{owner, true, big.NewInt(1), int8(-1), []byte{0x01, 0x02}, 1.5},
+	)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "44a347cf7278685320a05cb39e903c42e472e262"+
+		"01"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"ff"+
+		"0102"+
+		"0f", hex.EncodeToString(data))
+
+	// array elements are padded to 32 bytes, without length prefix
+	data, err = SolidityPacked(
+		[]string{"uint16[]", "bool[2]", "address[]", "bytes2[1]"},
+		[]interface{}{[]uint16{1, 2}, [2]bool{true, false}, []address.Address{owner}, [1][2]byte{{0xab, 0xcd}}},
+	)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000002"+
+		"0000000000000000000000000000000000000000000000000000000000000001"+
+		"0000000000000000000000000000000000000000000000000000000000000000"+
+		"00000000000000000000000044a347cf7278685320a05cb39e903c42e472e262"+
+		"abcd000000000000000000000000000000000000000000000000000000000000", hex.EncodeToString(data))
+
+	data, err = SolidityPacked([]string{"uint8[]"}, []interface{}{[]uint8{}})
+
+	require.NoError(t, err)
+
+	require.Empty(t, data)
+
+	// unsupported types
+	for _, typ := range []string{"string[]", "bytes[2]", "uint256[2][]", "uint7", "tuple", "uint256[0]"} {
+		_, err = SolidityPacked([]string{typ}, []interface{}{nil})
+
+		require.Error(t, err, typ)
+	}
+
+	tuple, err := Tuple("tuple", builtinMust(t, "uint256"))
+
+	require.NoError(t, err)
+
+	_, err = EncodePacked([]Encoder{tuple}, []interface{}{[]interface{}{1}})
+
+	require.Error(t, err)
+
+	_, err = SolidityPacked([]string{"uint8", "uint8"}, []interface{}{1})
+
+	require.Error(t, err)
+
+	_, err = SolidityPacked([]string{"uint8"}, []interface{}{256})
+
+	require.True(t, err != nil && strings.Contains(err.Error(), "out of range"))
+}
+
+func builtinMust(t testing.TB, name string) Encoder {
+	encoder, ok := Builtin(name)
+
+	require.True(t, ok, name)
+
+	return encoder
+}
+
+// plainEncoder hides the packed mode of wrapped encoder
+type plainEncoder struct {
+	Encoder
+}
+
+func TestMarshalPacked(t *testing.T) {
+	encoder, err := Integer(false, 16)
+
+	require.NoError(t, err)
+
+	data, err := MarshalPacked(encoder, uint16(3))
+
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 3}, data)
+
+	_, err = MarshalPacked(&plainEncoder{encoder}, uint16(3))
+
+	require.Error(t, err)
+
+	_, err = EncodePacked([]Encoder{&plainEncoder{encoder}}, []interface{}{uint16(3)})
+
+	require.Error(t, err)
+}
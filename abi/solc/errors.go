@@ -0,0 +1,12 @@
+package solc
+
+import "github.com/libs4go/errors"
+
+// ScopeOfAPIError .
+const errVendor = "ethers-abi-solc"
+
+// errors
+var (
+	ErrNotFound = errors.New("Solc compiler not found", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrCompile  = errors.New("Solc compile error", errors.WithVendor(errVendor), errors.WithCode(-2))
+)
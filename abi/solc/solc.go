@@ -0,0 +1,288 @@
+// Package solc compile solidity sources with local installed solc through the standard json interface
+package solc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/libs4go/errors"
+)
+
+// Contract compiled contract
+type Contract struct {
+	Name             string          // contract name
+	SourceName       string          // source unit name, e.g. contracts/Token.sol
+	ABI              json.RawMessage // json abi array
+	Bytecode         string          // 0x prefixed creation bytecode, empty for abstract contract and interface
+	DeployedBytecode string          // 0x prefixed runtime bytecode
+	UserDoc          json.RawMessage // NatSpec userdoc
+	DevDoc           json.RawMessage // NatSpec devdoc
+}
+
+// Artifact returns compiler artifact json of contract, which can be parsed by binding.Parse
+func (contract *Contract) Artifact() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"abi":              contract.ABI,
+		"bytecode":         contract.Bytecode,
+		"deployedBytecode": contract.DeployedBytecode,
+		"userdoc":          rawOrNull(contract.UserDoc),
+		"devdoc":           rawOrNull(contract.DevDoc),
+	})
+}
+
+func rawOrNull(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+
+	return raw
+}
+
+type optimizer struct {
+	Enabled bool `json:"enabled"`
+	Runs    int  `json:"runs,omitempty"`
+}
+
+type settings struct {
+	Remappings      []string                       `json:"remappings,omitempty"`
+	Optimizer       optimizer                      `json:"optimizer"`
+	EVMVersion      string                         `json:"evmVersion,omitempty"`
+	OutputSelection map[string]map[string][]string `json:"outputSelection"`
+}
+
+type source struct {
+	Content string `json:"content"`
+}
+
+type input struct {
+	Language string            `json:"language"`
+	Sources  map[string]source `json:"sources"`
+	Settings settings          `json:"settings"`
+}
+
+type bytecode struct {
+	Object string `json:"object"`
+}
+
+type outputContract struct {
+	ABI     json.RawMessage `json:"abi"`
+	UserDoc json.RawMessage `json:"userdoc"`
+	DevDoc  json.RawMessage `json:"devdoc"`
+	EVM     struct {
+		Bytecode         bytecode `json:"bytecode"`
+		DeployedBytecode bytecode `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+type outputError struct {
+	Severity         string `json:"severity"`
+	Message          string `json:"message"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+type output struct {
+	Errors    []*outputError                        `json:"errors"`
+	Contracts map[string]map[string]*outputContract `json:"contracts"`
+}
+
+// Option compile option
+type Option func(*compiler)
+
+type compiler struct {
+	solc       string
+	remappings []string
+	optimizer  optimizer
+	evmVersion string
+	allowPaths []string
+}
+
+// WithSolc set solc executable name or path, default is solc
+func WithSolc(path string) Option {
+	return func(c *compiler) {
+		c.solc = path
+	}
+}
+
+// WithRemappings add import remappings, e.g. @openzeppelin/=node_modules/@openzeppelin/
+func WithRemappings(remappings ...string) Option {
+	return func(c *compiler) {
+		c.remappings = append(c.remappings, remappings...)
+	}
+}
+
+// WithOptimizer enable or disable optimizer with runs
+func WithOptimizer(enabled bool, runs int) Option {
+	return func(c *compiler) {
+		c.optimizer = optimizer{Enabled: enabled, Runs: runs}
+	}
+}
+
+// WithEVMVersion set target evm version, e.g. london
+func WithEVMVersion(version string) Option {
+	return func(c *compiler) {
+		c.evmVersion = version
+	}
+}
+
+// WithAllowPaths add paths solc is allowed to import from, the dirs of sources and remapping
+// targets are always allowed
+func WithAllowPaths(paths ...string) Option {
+	return func(c *compiler) {
+		c.allowPaths = append(c.allowPaths, paths...)
+	}
+}
+
+// Compile compile solidity source files with solc --standard-json, returns all contracts of the
+// sources and their imports sorted by source name and contract name
+func Compile(ctx context.Context, files []string, options ...Option) ([]*Contract, error) {
+	c := &compiler{
+		solc: "solc",
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	solc, err := exec.LookPath(c.solc)
+
+	if err != nil {
+		return nil, errors.Wrap(ErrNotFound, "solc executable '%s' not found, install solc or set the solc path", c.solc)
+	}
+
+	request, err := c.input(files)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, solc, "--standard-json", "--allow-paths", strings.Join(c.allowed(files), ","))
+
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrap(ErrCompile, "run %s error: %s %s", solc, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return parseOutput(stdout.Bytes())
+}
+
+// input returns standard json input of source files
+func (c *compiler) input(files []string) ([]byte, error) {
+	request := &input{
+		Language: "Solidity",
+		Sources:  make(map[string]source),
+		Settings: settings{
+			Remappings: c.remappings,
+			Optimizer:  c.optimizer,
+			EVMVersion: c.evmVersion,
+			OutputSelection: map[string]map[string][]string{
+				"*": {
+					"*": {"abi", "evm.bytecode.object", "evm.deployedBytecode.object", "userdoc", "devdoc"},
+				},
+			},
+		},
+	}
+
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "read source %s error", file)
+		}
+
+		request.Sources[filepath.ToSlash(file)] = source{Content: string(content)}
+	}
+
+	return json.Marshal(request)
+}
+
+// allowed returns the allow paths of solc import callback
+func (c *compiler) allowed(files []string) []string {
+	paths := append([]string{"."}, c.allowPaths...)
+
+	for _, file := range files {
+		paths = append(paths, filepath.Dir(file))
+	}
+
+	for _, remapping := range c.remappings {
+		if i := strings.Index(remapping, "="); i >= 0 {
+			paths = append(paths, remapping[i+1:])
+		}
+	}
+
+	return paths
+}
+
+// parseOutput returns contracts of standard json output, the output errors with error severity
+// are reported as ErrCompile
+func parseOutput(data []byte) ([]*Contract, error) {
+	var result output
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, errors.Wrap(err, "parse solc output error")
+	}
+
+	var messages []string
+
+	for _, e := range result.Errors {
+		if e.Severity != "error" {
+			continue
+		}
+
+		message := e.FormattedMessage
+
+		if message == "" {
+			message = e.Message
+		}
+
+		messages = append(messages, strings.TrimSpace(message))
+	}
+
+	if len(messages) > 0 {
+		return nil, errors.Wrap(ErrCompile, "%s", strings.Join(messages, "\n"))
+	}
+
+	var contracts []*Contract
+
+	for sourceName, outputs := range result.Contracts {
+		for name, out := range outputs {
+			contracts = append(contracts, &Contract{
+				Name:             name,
+				SourceName:       sourceName,
+				ABI:              out.ABI,
+				Bytecode:         hexPrefix(out.EVM.Bytecode.Object),
+				DeployedBytecode: hexPrefix(out.EVM.DeployedBytecode.Object),
+				UserDoc:          out.UserDoc,
+				DevDoc:           out.DevDoc,
+			})
+		}
+	}
+
+	sort.Slice(contracts, func(i, j int) bool {
+		if contracts[i].SourceName != contracts[j].SourceName {
+			return contracts[i].SourceName < contracts[j].SourceName
+		}
+
+		return contracts[i].Name < contracts[j].Name
+	})
+
+	return contracts, nil
+}
+
+func hexPrefix(object string) string {
+	if object = strings.TrimPrefix(object, "0x"); object == "" {
+		return ""
+	}
+
+	return "0x" + object
+}
@@ -0,0 +1,93 @@
+package solc
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	inputFile := filepath.Join(t.TempDir(), "input.json")
+
+	os.Setenv("SOLC_STUB_INPUT", inputFile)
+
+	defer os.Unsetenv("SOLC_STUB_INPUT")
+
+	contracts, err := Compile(context.Background(), []string{"testdata/Counter.sol"},
+		WithSolc("./testdata/solc.sh"),
+		WithRemappings("@lib/=testdata/lib/"),
+		WithOptimizer(true, 200),
+		WithEVMVersion("london"),
+	)
+
+	require.NoError(t, err)
+
+	require.Len(t, contracts, 2)
+
+	require.Equal(t, "Counter", contracts[0].Name)
+	require.Equal(t, "testdata/Counter.sol", contracts[0].SourceName)
+	require.Equal(t, "0x608060405234801561001057600080fd5b50", contracts[0].Bytecode)
+	require.Equal(t, "0x6080604052348015600f57600080fd5b50", contracts[0].DeployedBytecode)
+	require.Contains(t, string(contracts[0].UserDoc), "Add to counter")
+
+	require.Equal(t, "Ownable", contracts[1].Name)
+	require.Equal(t, "", contracts[1].Bytecode)
+
+	buff, err := ioutil.ReadFile(inputFile)
+
+	require.NoError(t, err)
+
+	var request input
+
+	require.NoError(t, json.Unmarshal(buff, &request))
+
+	require.Equal(t, "Solidity", request.Language)
+	require.Contains(t, request.Sources["testdata/Counter.sol"].Content, "contract Counter is Ownable")
+	require.Equal(t, []string{"@lib/=testdata/lib/"}, request.Settings.Remappings)
+	require.Equal(t, optimizer{Enabled: true, Runs: 200}, request.Settings.Optimizer)
+	require.Equal(t, "london", request.Settings.EVMVersion)
+	require.Contains(t, request.Settings.OutputSelection["*"]["*"], "evm.deployedBytecode.object")
+}
+
+func TestCompileError(t *testing.T) {
+	os.Setenv("SOLC_STUB_OUTPUT", "error.json")
+
+	defer os.Unsetenv("SOLC_STUB_OUTPUT")
+
+	_, err := Compile(context.Background(), []string{"testdata/Counter.sol"}, WithSolc("./testdata/solc.sh"))
+
+	require.True(t, errors.Is(err, ErrCompile))
+
+	require.Contains(t, err.Error(), "Expected ';'")
+}
+
+func TestSolcNotFound(t *testing.T) {
+	_, err := Compile(context.Background(), []string{"testdata/Counter.sol"}, WithSolc("./testdata/solc-not-installed"))
+
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	_, err = Compile(context.Background(), []string{"testdata/Missing.sol"}, WithSolc("./testdata/solc.sh"))
+
+	require.Error(t, err)
+}
+
+func TestArtifact(t *testing.T) {
+	contract := &Contract{
+		Name:     "Ownable",
+		ABI:      json.RawMessage(`[]`),
+		Bytecode: "0x00",
+		UserDoc:  json.RawMessage(`{"notice":"Ownable"}`),
+	}
+
+	buff, err := contract.Artifact()
+
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"abi":[],"bytecode":"0x00","deployedBytecode":"","userdoc":{"notice":"Ownable"},"devdoc":null}`, string(buff))
+}
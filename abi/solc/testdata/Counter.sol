@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@lib/Ownable.sol";
+
+/// @title Counter
+/// @notice Simple counter
+contract Counter is Ownable {
+    uint256 public count;
+
+    /// @notice Add to counter
+    /// @param delta The amount added
+    function increment(uint256 delta) external {
+        count += delta;
+    }
+}
@@ -0,0 +1,16 @@
+#!/bin/sh
+# stub solc for tests, save standard json input to $SOLC_STUB_INPUT and emit canned output
+# $SOLC_STUB_OUTPUT (default output.json) of testdata dir
+
+if [ "$1" != "--standard-json" ]; then
+	echo "stub solc: --standard-json expect" >&2
+	exit 1
+fi
+
+if [ -n "$SOLC_STUB_INPUT" ]; then
+	cat > "$SOLC_STUB_INPUT"
+else
+	cat > /dev/null
+fi
+
+cat "$(dirname "$0")/${SOLC_STUB_OUTPUT:-output.json}"
@@ -0,0 +1,201 @@
+package abi
+
+import (
+	"github.com/libs4go/errors"
+)
+
+// Validate check abi data of encoder in strict mode without allocating the decoded values,
+// returns the consumed length:
+//
+// - offsets and lengths are within the data bounds
+// - dynamic content offsets point into the tail, and never go backwards or overlap
+// - integer, bool, address, fixed bytes and dynamic bytes paddings are zeroed
+func Validate(encoder Encoder, data []byte) (uint, error) {
+	switch enc := encoder.(type) {
+	case *integerEncoder:
+		return validateWord(enc, data)
+	case *addressEncoder:
+		return validateWord(enc.Encoder.(*integerEncoder), data)
+	case *fixedEncoder:
+		return validateWord(enc.Encoder.(*integerEncoder), data)
+	case *boolEncoder:
+		if _, err := validateWord(enc.Encoder.(*integerEncoder), data); err != nil {
+			return 0, err
+		}
+
+		if data[31] > 1 {
+			return 0, errors.Wrap(ErrValue, "invalid bool value %d", data[31])
+		}
+
+		return 32, nil
+	case *fixedBytesEncoder:
+		if len(data) < 32 {
+			return 0, errors.Wrap(ErrLength, "%s: abi data length < 32", enc)
+		}
+
+		if !zeroed(data[enc.len:32]) {
+			return 0, errors.Wrap(ErrPadding, "%s: dirty right padding", enc)
+		}
+
+		return 32, nil
+	case *bytesEncoder, *stringEncoder:
+		n, err := decodeLength(data)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if n > uint64(len(data)-32) {
+			return 0, errors.Wrap(ErrLength, "%s: length %d out of range", enc, n)
+		}
+
+		padded := (n + 31) / 32 * 32
+
+		if padded > uint64(len(data)-32) {
+			return 0, errors.Wrap(ErrLength, "%s: padding of length %d out of range", enc, n)
+		}
+
+		if !zeroed(data[32+n : 32+padded]) {
+			return 0, errors.Wrap(ErrPadding, "%s: dirty right padding", enc)
+		}
+
+		return uint(32 + padded), nil
+	case *fixedArrayEncoder:
+		return validateSequence(enc, repeatEncoder(enc.elem, int(enc.len)), data)
+	case *arrayEncoder:
+		n, err := decodeLength(data)
+
+		if err != nil {
+			return 0, err
+		}
+
+		// each element occupies at least one word
+		if n > uint64(len(data)-32)/32 {
+			return 0, errors.Wrap(ErrLength, "%s: array length %d out of range", enc, n)
+		}
+
+		l, err := validateSequence(enc, repeatEncoder(enc.elem, int(n)), data[32:])
+
+		if err != nil {
+			return 0, err
+		}
+
+		return l + 32, nil
+	case *tupleEncoder:
+		return validateSequence(enc, enc.elems, data)
+	}
+
+	return 0, errors.Wrap(ErrValue, "unsupport encoder %s", encoder)
+}
+
+// UnmarshalStrict validate data in strict mode before unmarshal, see Validate
+func UnmarshalStrict(encoder Encoder, data []byte, v interface{}) (uint, error) {
+	if _, err := Validate(encoder, data); err != nil {
+		return 0, err
+	}
+
+	return encoder.Unmarshal(data, v)
+}
+
+func validateWord(enc *integerEncoder, data []byte) (uint, error) {
+	if len(data) < 32 {
+		return 0, errors.Wrap(ErrLength, "%s: abi data length < 32", enc)
+	}
+
+	if _, err := enc.unmarshalBigInt(data); err != nil {
+		return 0, err
+	}
+
+	return 32, nil
+}
+
+func zeroed(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// headSize returns the head length of static encoder, or 32 for the offset of dynamic encoder
+func headSize(encoder Encoder) uint64 {
+	if !encoder.Static() {
+		return 32
+	}
+
+	switch enc := encoder.(type) {
+	case *fixedArrayEncoder:
+		return uint64(enc.len) * headSize(enc.elem)
+	case *tupleEncoder:
+		size := uint64(0)
+
+		for _, elem := range enc.elems {
+			size += headSize(elem)
+		}
+
+		return size
+	}
+
+	return 32
+}
+
+// validateSequence check head/tail encoded values sequence of tuple or array
+func validateSequence(parent Encoder, encoders []Encoder, data []byte) (uint, error) {
+	head := uint64(0)
+
+	for _, encoder := range encoders {
+		head += headSize(encoder)
+
+		if head > uint64(len(data)) {
+			return 0, errors.Wrap(ErrLength, "%s: head length out of range", parent)
+		}
+	}
+
+	offset := uint(0)
+	end := head
+
+	for i, encoder := range encoders {
+		if encoder.Static() {
+			l, err := Validate(encoder, data[offset:])
+
+			if err != nil {
+				return 0, err
+			}
+
+			offset += l
+
+			continue
+		}
+
+		contentOffset, err := decodeLength(data[offset:])
+
+		if err != nil {
+			return 0, err
+		}
+
+		if contentOffset < head {
+			return 0, errors.Wrap(ErrLength, "%s: element %d offset %d points into head", parent, i, contentOffset)
+		}
+
+		if contentOffset < end {
+			return 0, errors.Wrap(ErrLength, "%s: element %d offset %d overlaps previous content", parent, i, contentOffset)
+		}
+
+		if contentOffset > uint64(len(data)) {
+			return 0, errors.Wrap(ErrLength, "%s: element %d offset %d out of range", parent, i, contentOffset)
+		}
+
+		l, err := Validate(encoder, data[contentOffset:])
+
+		if err != nil {
+			return 0, err
+		}
+
+		offset += 32
+		end = contentOffset + uint64(l)
+	}
+
+	return uint(end), nil
+}
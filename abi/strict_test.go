@@ -0,0 +1,189 @@
+package abi
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func words(ws ...string) []byte {
+	var buff []byte
+
+	for _, w := range ws {
+		buff = append(buff, mustDecodeHex(strings.Repeat("0", 64-len(w))+w)...)
+	}
+
+	return buff
+}
+
+func TestEmptyBytes(t *testing.T) {
+	encoder := builtinMust(t, "bytes")
+
+	data, err := encoder.Marshal([]byte{})
+
+	require.NoError(t, err)
+
+	require.Equal(t, words("0"), data)
+
+	var b []byte
+
+	l, err := encoder.Unmarshal(data, &b)
+
+	require.NoError(t, err)
+
+	require.Equal(t, uint(32), l)
+
+	require.Equal(t, []byte{}, b)
+
+	var s string
+
+	_, err = builtinMust(t, "string").Unmarshal(data, &s)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "", s)
+}
+
+func TestValidate(t *testing.T) {
+	uint256s, err := ParseType("uint256[]")
+
+	require.NoError(t, err)
+
+	strs, err := ParseType("string[2]")
+
+	require.NoError(t, err)
+
+	tuple, err := Tuple("tuple", builtinMust(t, "bool"), builtinMust(t, "bytes"), uint256s, strs, builtinMust(t, "bytes3"))
+
+	require.NoError(t, err)
+
+	data, err := tuple.Marshal([]interface{}{
+		true,
+		[]byte{1, 2, 3},
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+		[2]string{"", "hello"},
+		[3]byte{4, 5, 6},
+	})
+
+	require.NoError(t, err)
+
+	l, err := Validate(tuple, data)
+
+	require.NoError(t, err)
+
+	require.Equal(t, uint(len(data)), l)
+
+	var v Value
+
+	l, err = UnmarshalStrict(tuple, data, &v)
+
+	require.NoError(t, err)
+
+	require.Equal(t, uint(len(data)), l)
+
+	// trailing data is allowed
+	_, err = Validate(tuple, append(data, make([]byte, 32)...))
+
+	require.NoError(t, err)
+
+	for i := 0; i < len(data); i++ {
+		_, err = Validate(tuple, data[:i])
+
+		require.Error(t, err, "truncated %d", i)
+
+		// must not panic, missing right padding of the last content is tolerated
+		_, _ = tuple.Unmarshal(data[:i], &v)
+	}
+}
+
+func TestValidateHostile(t *testing.T) {
+	bytesEncoder := builtinMust(t, "bytes")
+
+	dynamic, err := Tuple("tuple", bytesEncoder, bytesEncoder)
+
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		encoder Encoder
+		data    []byte
+		strict  bool // fails only in strict mode
+	}{
+		{"bool dirty", builtinMust(t, "bool"), words("2"), false},
+		{"uint8 dirty", builtinMust(t, "uint8"), words("100"), false},
+		{"address dirty", builtinMust(t, "address"), words("1" + strings.Repeat("0", 40)), false},
+		{"bytes huge length", bytesEncoder, words("ffffffffffffffff"), false},
+		{"bytes length overflow", bytesEncoder, words(strings.Repeat("f", 64)), false},
+		{"bytes length", bytesEncoder, words("21", "0"), false},
+		{"bytes dirty padding", bytesEncoder, words("1", "0101"), true},
+		{"bytes missing padding", bytesEncoder, append(words("1"), 0x01), true},
+		{"bytes3 dirty padding", builtinMust(t, "bytes3"), words("01"), true},
+		{"array huge length", ensure(ParseType("uint256[]")), words("ffffffffffffffff", "1"), false},
+		{"array length", ensure(ParseType("uint256[]")), words("2", "1"), false},
+		{"fixed array offset overflow", ensure(ParseType("string[1]")), words("8000000000000000", "0"), false},
+		{"tuple offset out of range", dynamic, words("40", "1000", "0"), false},
+		{"tuple offset into head", dynamic, words("40", "20", "0"), true},
+		{"tuple backwards offset", dynamic, words("60", "40", "0", "0"), true},
+		{"tuple overlapping offset", dynamic, words("40", "40", "0"), true},
+		{"nested array overlapping offset", ensure(ParseType("uint256[][]")), words("2", "40", "40", "0"), true},
+	}
+
+	for _, c := range cases {
+		_, err := Validate(c.encoder, c.data)
+
+		require.Error(t, err, c.name)
+
+		var v Value
+
+		_, err = c.encoder.Unmarshal(c.data, &v)
+
+		if c.strict {
+			require.NoError(t, err, c.name)
+		} else {
+			require.Error(t, err, c.name)
+		}
+
+		_, err = UnmarshalStrict(c.encoder, c.data, &v)
+
+		require.Error(t, err, c.name)
+	}
+}
+
+func TestUnmarshalHostile(t *testing.T) {
+	var b []byte
+
+	_, err := builtinMust(t, "bytes").Unmarshal(words(strings.Repeat("f", 16)), &b)
+
+	require.Error(t, err)
+
+	var s string
+
+	_, err = builtinMust(t, "string").Unmarshal(words(strings.Repeat("f", 16)), &s)
+
+	require.Error(t, err)
+
+	var ids []*big.Int
+
+	_, err = ensure(ParseType("uint256[]")).Unmarshal(words(strings.Repeat("f", 16)), &ids)
+
+	require.Error(t, err)
+
+	var strs [1]string
+
+	_, err = ensure(ParseType("string[1]")).Unmarshal(words("8000000000000000", "0"), &strs)
+
+	require.Error(t, err)
+
+	_, err = ensure(ParseType("string[1]")).Unmarshal(words(strings.Repeat("f", 64)), &strs)
+
+	require.Error(t, err)
+
+	_, err = builtinMust(t, "bytes").Unmarshal(words("1", "01"), &s)
+
+	require.Error(t, err)
+
+	require.Equal(t, "", hex.EncodeToString(b))
+}
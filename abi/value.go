@@ -0,0 +1,747 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/fixed"
+)
+
+// Value dynamic abi value, which can be decoded from and encoded by any Encoder without
+// knowing the go types at compile time, e.g.
+//
+//	var v abi.Value
+//	encoder.Unmarshal(data, &v)
+type Value interface {
+	// Type returns the canonical abi type, e.g. "(uint256,address)[]"
+	Type() string
+	// Interface returns the natural go value, arrays and tuples are returned as []interface{}
+	Interface() interface{}
+	fmt.Stringer
+	json.Marshaler
+	value()
+}
+
+// IntValue intN value
+type IntValue struct {
+	Bits  uint
+	Value *big.Int
+}
+
+// UintValue uintN value
+type UintValue struct {
+	Bits  uint
+	Value *big.Int
+}
+
+// FixedValue fixedMxN/ufixedMxN value
+type FixedValue struct {
+	Signed bool
+	M      uint
+	Value  *fixed.Number
+}
+
+// AddressValue address value
+type AddressValue address.Address
+
+// BoolValue bool value
+type BoolValue bool
+
+// BytesValue dynamic bytes value
+type BytesValue []byte
+
+// FixedBytesValue bytesN value, N is the slice length
+type FixedBytesValue []byte
+
+// StringValue string value
+type StringValue string
+
+// ArrayValue T[] or T[k] value
+type ArrayValue struct {
+	Elem  string // element canonical abi type
+	Fixed bool   // T[k] if true
+	Elems []Value
+}
+
+// TupleValue tuple value, Names are the optional components name
+type TupleValue struct {
+	Names []string
+	Elems []Value
+}
+
+func (IntValue) value()        {}
+func (UintValue) value()       {}
+func (FixedValue) value()      {}
+func (AddressValue) value()    {}
+func (BoolValue) value()       {}
+func (BytesValue) value()      {}
+func (FixedBytesValue) value() {}
+func (StringValue) value()     {}
+func (ArrayValue) value()      {}
+func (TupleValue) value()      {}
+
+func (v IntValue) Type() string           { return fmt.Sprintf("int%d", v.Bits) }
+func (v IntValue) Interface() interface{} { return v.Value }
+func (v IntValue) String() string         { return v.Value.String() }
+
+func (v IntValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value.String())
+}
+
+func (v UintValue) Type() string           { return fmt.Sprintf("uint%d", v.Bits) }
+func (v UintValue) Interface() interface{} { return v.Value }
+func (v UintValue) String() string         { return v.Value.String() }
+
+func (v UintValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value.String())
+}
+
+func (v FixedValue) Type() string {
+	if v.Signed {
+		return fmt.Sprintf("fixed%dx%d", v.M, v.Value.Decimals)
+	}
+
+	return fmt.Sprintf("ufixed%dx%d", v.M, v.Value.Decimals)
+}
+
+func (v FixedValue) Interface() interface{} { return v.Value }
+func (v FixedValue) String() string         { return fixedString(v.Value) }
+
+func (v FixedValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fixedString(v.Value))
+}
+
+// fixedString format fixed number as decimal string without trailing zeros, e.g. "-1.5"
+func fixedString(number *fixed.Number) string {
+	digits := new(big.Int).Abs(number.RawValue).String()
+
+	if len(digits) <= number.Decimals {
+		digits = strings.Repeat("0", number.Decimals-len(digits)+1) + digits
+	}
+
+	point := len(digits) - number.Decimals
+
+	result := digits[:point]
+
+	if fraction := strings.TrimRight(digits[point:], "0"); fraction != "" {
+		result += "." + fraction
+	}
+
+	if number.RawValue.Sign() < 0 {
+		result = "-" + result
+	}
+
+	return result
+}
+
+func (v AddressValue) Type() string           { return "address" }
+func (v AddressValue) Interface() interface{} { return address.Address(v) }
+func (v AddressValue) String() string         { return address.Address(v).Hex() }
+
+func (v AddressValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+func (v BoolValue) Type() string           { return "bool" }
+func (v BoolValue) Interface() interface{} { return bool(v) }
+func (v BoolValue) String() string         { return fmt.Sprint(bool(v)) }
+
+func (v BoolValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bool(v))
+}
+
+func (v BytesValue) Type() string           { return "bytes" }
+func (v BytesValue) Interface() interface{} { return []byte(v) }
+func (v BytesValue) String() string         { return "0x" + hex.EncodeToString(v) }
+
+func (v BytesValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+func (v FixedBytesValue) Type() string           { return fmt.Sprintf("bytes%d", len(v)) }
+func (v FixedBytesValue) Interface() interface{} { return []byte(v) }
+func (v FixedBytesValue) String() string         { return "0x" + hex.EncodeToString(v) }
+
+func (v FixedBytesValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+func (v StringValue) Type() string           { return "string" }
+func (v StringValue) Interface() interface{} { return string(v) }
+func (v StringValue) String() string         { return string(v) }
+
+func (v StringValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+func (v ArrayValue) Type() string {
+	if v.Fixed {
+		return fmt.Sprintf("%s[%d]", v.Elem, len(v.Elems))
+	}
+
+	return v.Elem + "[]"
+}
+
+func (v ArrayValue) Interface() interface{} {
+	elems := make([]interface{}, len(v.Elems))
+
+	for i, elem := range v.Elems {
+		elems[i] = elem.Interface()
+	}
+
+	return elems
+}
+
+func (v ArrayValue) String() string {
+	var elems []string
+
+	for _, elem := range v.Elems {
+		elems = append(elems, elem.String())
+	}
+
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+func (v ArrayValue) MarshalJSON() ([]byte, error) {
+	if v.Elems == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(v.Elems)
+}
+
+func (v TupleValue) Type() string {
+	var types []string
+
+	for _, elem := range v.Elems {
+		types = append(types, elem.Type())
+	}
+
+	return "(" + strings.Join(types, ",") + ")"
+}
+
+func (v TupleValue) Interface() interface{} {
+	elems := make([]interface{}, len(v.Elems))
+
+	for i, elem := range v.Elems {
+		elems[i] = elem.Interface()
+	}
+
+	return elems
+}
+
+func (v TupleValue) String() string {
+	var elems []string
+
+	for _, elem := range v.Elems {
+		elems = append(elems, elem.String())
+	}
+
+	return "(" + strings.Join(elems, ", ") + ")"
+}
+
+// Field returns the component value by name
+func (v TupleValue) Field(name string) (Value, bool) {
+	for i, n := range v.Names {
+		if n == name && i < len(v.Elems) {
+			return v.Elems[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// named check if all components have unique names
+func (v TupleValue) named() bool {
+	if len(v.Names) != len(v.Elems) || len(v.Names) == 0 {
+		return false
+	}
+
+	names := make(map[string]bool)
+
+	for _, name := range v.Names {
+		if name == "" || names[name] {
+			return false
+		}
+
+		names[name] = true
+	}
+
+	return true
+}
+
+// MarshalJSON render tuple as json object if all components are named, otherwise as json array
+func (v TupleValue) MarshalJSON() ([]byte, error) {
+	if !v.named() {
+		if v.Elems == nil {
+			return []byte("[]"), nil
+		}
+
+		return json.Marshal(v.Elems)
+	}
+
+	var buff bytes.Buffer
+
+	buff.WriteString("{")
+
+	for i, elem := range v.Elems {
+		if i > 0 {
+			buff.WriteString(",")
+		}
+
+		name, err := json.Marshal(v.Names[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := elem.MarshalJSON()
+
+		if err != nil {
+			return nil, err
+		}
+
+		buff.Write(name)
+		buff.WriteString(":")
+		buff.Write(content)
+	}
+
+	buff.WriteString("}")
+
+	return buff.Bytes(), nil
+}
+
+// DecodeValue decode abi data into dynamic value, returns the value and the consumed length
+func DecodeValue(encoder Encoder, data []byte) (Value, uint, error) {
+	switch enc := encoder.(type) {
+	case *integerEncoder:
+		if len(data) < 32 {
+			return nil, 0, errors.Wrap(ErrLength, "%s: abi data length < 32", enc)
+		}
+
+		i, err := enc.unmarshalBigInt(data)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if enc.sign {
+			return IntValue{Bits: enc.bits, Value: i}, 32, nil
+		}
+
+		return UintValue{Bits: enc.bits, Value: i}, 32, nil
+	case *addressEncoder, *boolEncoder, *fixedEncoder:
+		if len(data) < 32 {
+			return nil, 0, errors.Wrap(ErrLength, "%s: abi data length < 32", enc)
+		}
+
+		var integer *integerEncoder
+
+		switch enc := enc.(type) {
+		case *addressEncoder:
+			integer = enc.Encoder.(*integerEncoder)
+		case *boolEncoder:
+			integer = enc.Encoder.(*integerEncoder)
+		case *fixedEncoder:
+			integer = enc.Encoder.(*integerEncoder)
+		}
+
+		i, err := integer.unmarshalBigInt(data)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch enc := enc.(type) {
+		case *addressEncoder:
+			return AddressValue(address.BytesToAddress(i.Bytes())), 32, nil
+		case *boolEncoder:
+			if i.Cmp(big.NewInt(1)) > 0 {
+				return nil, 0, errors.Wrap(ErrValue, "invalid bool value %s", i)
+			}
+
+			return BoolValue(i.Sign() == 1), 32, nil
+		default:
+			return FixedValue{Signed: integer.sign, M: enc.(*fixedEncoder).M, Value: &fixed.Number{RawValue: i, Decimals: int(enc.(*fixedEncoder).N)}}, 32, nil
+		}
+	case *fixedBytesEncoder:
+		if uint(len(data)) < 32 {
+			return nil, 0, errors.Wrap(ErrLength, "%s: abi data length < 32", enc)
+		}
+
+		return FixedBytesValue(append([]byte{}, data[:enc.len]...)), 32, nil
+	case *bytesEncoder, *stringEncoder:
+		content, l, err := decodeDynamicBytes(data)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if _, ok := enc.(*stringEncoder); ok {
+			return StringValue(content), l, nil
+		}
+
+		return BytesValue(content), l, nil
+	case *fixedArrayEncoder:
+		encoders := make([]Encoder, enc.len)
+
+		for i := range encoders {
+			encoders[i] = enc.elem
+		}
+
+		elems, l, err := decodeValues(encoders, data)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return ArrayValue{Elem: enc.elem.String(), Fixed: true, Elems: elems}, l, nil
+	case *arrayEncoder:
+		n, err := decodeLength(data)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// each element occupies at least one word
+		if n > uint64(len(data)-32)/32 {
+			return nil, 0, errors.Wrap(ErrLength, "%s: array length %d out of range", enc, n)
+		}
+
+		encoders := make([]Encoder, n)
+
+		for i := range encoders {
+			encoders[i] = enc.elem
+		}
+
+		elems, l, err := decodeValues(encoders, data[32:])
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return ArrayValue{Elem: enc.elem.String(), Elems: elems}, l + 32, nil
+	case *tupleEncoder:
+		elems, l, err := decodeValues(enc.elems, data)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return TupleValue{Names: enc.names, Elems: elems}, l, nil
+	}
+
+	return nil, 0, errors.Wrap(ErrValue, "unsupport encoder %s", encoder)
+}
+
+// decodeLength decode the length or offset word
+func decodeLength(data []byte) (uint64, error) {
+	if len(data) < 32 {
+		return 0, errors.Wrap(ErrLength, "abi data length < 32")
+	}
+
+	n := new(big.Int).SetBytes(data[:32])
+
+	if !n.IsUint64() {
+		return 0, errors.Wrap(ErrLength, "length/offset 0x%x out of range", data[:32])
+	}
+
+	return n.Uint64(), nil
+}
+
+func decodeDynamicBytes(data []byte) ([]byte, uint, error) {
+	n, err := decodeLength(data)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if n > uint64(len(data)-32) {
+		return nil, 0, errors.Wrap(ErrLength, "bytes length %d out of range", n)
+	}
+
+	return append([]byte{}, data[32:32+n]...), 32 + uint((n+31)/32*32), nil
+}
+
+// decodeValues decode head/tail encoded values sequence of tuple or array
+func decodeValues(encoders []Encoder, data []byte) ([]Value, uint, error) {
+	values := make([]Value, len(encoders))
+
+	offset := uint(0)
+	end := uint(0)
+
+	for i, encoder := range encoders {
+		if offset > uint(len(data)) {
+			return nil, 0, errors.Wrap(ErrLength, "element %d offset out of range", i)
+		}
+
+		if encoder.Static() {
+			value, l, err := DecodeValue(encoder, data[offset:])
+
+			if err != nil {
+				return nil, 0, err
+			}
+
+			values[i] = value
+			offset += l
+
+			continue
+		}
+
+		contentOffset, err := decodeLength(data[offset:])
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if contentOffset > uint64(len(data)) {
+			return nil, 0, errors.Wrap(ErrLength, "element %d content offset %d out of range", i, contentOffset)
+		}
+
+		value, l, err := DecodeValue(encoder, data[contentOffset:])
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		values[i] = value
+		offset += 32
+
+		if uint(contentOffset)+l > end {
+			end = uint(contentOffset) + l
+		}
+	}
+
+	if offset > end {
+		end = offset
+	}
+
+	return values, end, nil
+}
+
+// unmarshalValue the Encoder.Unmarshal implementation of *Value target
+func unmarshalValue(encoder Encoder, data []byte, target *Value) (uint, error) {
+	value, l, err := DecodeValue(encoder, data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	*target = value
+
+	return l, nil
+}
+
+// EncodeValue encode dynamic value by encoder, the value type must match the encoder
+func EncodeValue(encoder Encoder, value Value) ([]byte, error) {
+	if value == nil {
+		return nil, errors.Wrap(ErrValue, "%s: nil value", encoder)
+	}
+
+	switch enc := encoder.(type) {
+	case *integerEncoder:
+		switch v := value.(type) {
+		case IntValue:
+			return enc.marshalBigInt(v.Value)
+		case UintValue:
+			return enc.marshalBigInt(v.Value)
+		}
+	case *addressEncoder:
+		if v, ok := value.(AddressValue); ok {
+			return enc.Marshal(address.Address(v))
+		}
+	case *boolEncoder:
+		if v, ok := value.(BoolValue); ok {
+			return enc.Marshal(bool(v))
+		}
+	case *fixedEncoder:
+		if v, ok := value.(FixedValue); ok {
+			return enc.Marshal(v.Value)
+		}
+	case *fixedBytesEncoder:
+		if v, ok := value.(FixedBytesValue); ok {
+			if uint(len(v)) != enc.len {
+				return nil, errors.Wrap(ErrFixedBytes, "%s: value length %d", enc, len(v))
+			}
+
+			return paddingRight(append([]byte{}, v...)), nil
+		}
+	case *bytesEncoder:
+		if v, ok := value.(BytesValue); ok {
+			return encodeDynamicBytes(v), nil
+		}
+	case *stringEncoder:
+		if v, ok := value.(StringValue); ok {
+			return encodeDynamicBytes([]byte(v)), nil
+		}
+	case *fixedArrayEncoder:
+		if v, ok := value.(ArrayValue); ok {
+			if uint(len(v.Elems)) != enc.len {
+				return nil, errors.Wrap(ErrValue, "%s: value length %d", enc, len(v.Elems))
+			}
+
+			return encodeValues(repeatEncoder(enc.elem, len(v.Elems)), v.Elems)
+		}
+	case *arrayEncoder:
+		if v, ok := value.(ArrayValue); ok {
+			content, err := encodeValues(repeatEncoder(enc.elem, len(v.Elems)), v.Elems)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return append(new(big.Int).SetUint64(uint64(len(v.Elems))).FillBytes(make([]byte, 32)), content...), nil
+		}
+	case *tupleEncoder:
+		if v, ok := value.(TupleValue); ok {
+			if len(v.Elems) != len(enc.elems) {
+				return nil, errors.Wrap(ErrValue, "%s: value length %d", enc, len(v.Elems))
+			}
+
+			return encodeValues(enc.elems, v.Elems)
+		}
+	default:
+		return nil, errors.Wrap(ErrValue, "unsupport encoder %s", encoder)
+	}
+
+	return nil, errors.Wrap(ErrValue, "%s: can't encode %s value", encoder, value.Type())
+}
+
+func repeatEncoder(encoder Encoder, n int) []Encoder {
+	encoders := make([]Encoder, n)
+
+	for i := range encoders {
+		encoders[i] = encoder
+	}
+
+	return encoders
+}
+
+func encodeDynamicBytes(content []byte) []byte {
+	header := new(big.Int).SetUint64(uint64(len(content))).FillBytes(make([]byte, 32))
+
+	if len(content) == 0 {
+		return header
+	}
+
+	return append(header, paddingRight(append([]byte{}, content...))...)
+}
+
+// encodeValues head/tail encode values sequence of tuple or array
+func encodeValues(encoders []Encoder, values []Value) ([]byte, error) {
+	var head, tail bytes.Buffer
+
+	contents := make([][]byte, len(encoders))
+
+	headLen := 0
+
+	for i, encoder := range encoders {
+		content, err := EncodeValue(encoder, values[i])
+
+		if err != nil {
+			return nil, err
+		}
+
+		contents[i] = content
+
+		if encoder.Static() {
+			headLen += len(content)
+		} else {
+			headLen += 32
+		}
+	}
+
+	for i, encoder := range encoders {
+		if encoder.Static() {
+			head.Write(contents[i])
+			continue
+		}
+
+		head.Write(new(big.Int).SetUint64(uint64(headLen + tail.Len())).FillBytes(make([]byte, 32)))
+		tail.Write(contents[i])
+	}
+
+	head.Write(tail.Bytes())
+
+	return head.Bytes(), nil
+}
+
+// Pretty format values with the parameters name and type, e.g.
+//
+//	to address: 0x44A347Cf7278685320a05Cb39e903C42e472e262
+//	orders (uint256,address)[]:
+//	  [0] (uint256,address):
+//	    id uint256: 1
+//	    maker address: 0x44A347Cf7278685320a05Cb39e903C42e472e262
+func Pretty(params []*JSONParam, values []Value) string {
+	var buff strings.Builder
+
+	for i, value := range values {
+		var param *JSONParam
+
+		if i < len(params) {
+			param = params[i]
+		}
+
+		prettyValue(&buff, 0, param, fmt.Sprintf("[%d]", i), value)
+	}
+
+	return buff.String()
+}
+
+func prettyValue(buff *strings.Builder, indent int, param *JSONParam, name string, value Value) {
+	if param != nil && param.Name != "" {
+		name = param.Name
+	}
+
+	buff.WriteString(strings.Repeat("  ", indent))
+	buff.WriteString(name)
+	buff.WriteString(" ")
+	buff.WriteString(value.Type())
+	buff.WriteString(":")
+
+	switch v := value.(type) {
+	case ArrayValue:
+		var elem *JSONParam
+
+		if param != nil {
+			if i := strings.LastIndex(param.Type, "["); i > 0 {
+				elem = &JSONParam{Type: param.Type[:i], Components: param.Components}
+			}
+		}
+
+		buff.WriteString("\n")
+
+		for i, value := range v.Elems {
+			prettyValue(buff, indent+1, elem, fmt.Sprintf("[%d]", i), value)
+		}
+	case TupleValue:
+		buff.WriteString("\n")
+
+		for i, value := range v.Elems {
+			var component *JSONParam
+
+			if param != nil && i < len(param.Components) {
+				component = param.Components[i]
+			}
+
+			name := fmt.Sprintf("[%d]", i)
+
+			if i < len(v.Names) && v.Names[i] != "" {
+				name = v.Names[i]
+			}
+
+			prettyValue(buff, indent+1, component, name, value)
+		}
+	default:
+		buff.WriteString(" ")
+		buff.WriteString(value.String())
+		buff.WriteString("\n")
+	}
+}
@@ -0,0 +1,220 @@
+package abi
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+func newValueTestEncoder(t *testing.T) Encoder {
+	i8, err := Integer(true, 8)
+	require.NoError(t, err)
+
+	u256, err := Integer(false, 256)
+	require.NoError(t, err)
+
+	addr, err := Address()
+	require.NoError(t, err)
+
+	b, err := Bool()
+	require.NoError(t, err)
+
+	bytes3, err := FixedBytes(3)
+	require.NoError(t, err)
+
+	u256s, err := Array(u256)
+	require.NoError(t, err)
+
+	strs, err := FixedArray(&stringEncoder{}, 2)
+	require.NoError(t, err)
+
+	item, err := NamedTuple("Item", []string{"id", "memo"}, u256, &stringEncoder{})
+	require.NoError(t, err)
+
+	items, err := Array(item)
+	require.NoError(t, err)
+
+	encoder, err := NamedTuple("Root",
+		[]string{"a", "b", "owner", "ok", "data", "tag", "name", "ids", "names", "items"},
+		i8, u256, addr, b, &bytesEncoder{}, bytes3, &stringEncoder{}, u256s, strs, items)
+	require.NoError(t, err)
+
+	return encoder
+}
+
+func newValueTestValue() TupleValue {
+	owner := address.BytesToAddress([]byte{0x11, 0x22, 0x33})
+
+	return TupleValue{
+		Names: []string{"a", "b", "owner", "ok", "data", "tag", "name", "ids", "names", "items"},
+		Elems: []Value{
+			IntValue{Bits: 8, Value: big.NewInt(-2)},
+			UintValue{Bits: 256, Value: big.NewInt(1000)},
+			AddressValue(owner),
+			BoolValue(true),
+			BytesValue{0x01, 0x02},
+			FixedBytesValue{0x0a, 0x0b, 0x0c},
+			StringValue("hello"),
+			ArrayValue{Elem: "uint256", Elems: []Value{
+				UintValue{Bits: 256, Value: big.NewInt(1)},
+				UintValue{Bits: 256, Value: big.NewInt(2)},
+			}},
+			ArrayValue{Elem: "string", Fixed: true, Elems: []Value{StringValue("x"), StringValue("y")}},
+			ArrayValue{Elem: "(uint256,string)", Elems: []Value{
+				TupleValue{Names: []string{"id", "memo"}, Elems: []Value{
+					UintValue{Bits: 256, Value: big.NewInt(7)},
+					StringValue("seven"),
+				}},
+			}},
+		},
+	}
+}
+
+func TestValueRoundTrip(t *testing.T) {
+	encoder := newValueTestEncoder(t)
+
+	value := newValueTestValue()
+
+	data, err := encoder.Marshal(value)
+
+	require.NoError(t, err)
+
+	var decoded Value
+
+	l, err := encoder.Unmarshal(data, &decoded)
+
+	require.NoError(t, err)
+
+	require.Equal(t, uint(len(data)), l)
+
+	require.Equal(t, value.String(), decoded.String())
+
+	require.Equal(t, value.Type(), decoded.Type())
+
+	tuple, ok := decoded.(TupleValue)
+
+	require.True(t, ok)
+
+	name, ok := tuple.Field("name")
+
+	require.True(t, ok)
+
+	require.Equal(t, StringValue("hello"), name)
+
+	buff, err := encoder.Marshal(decoded)
+
+	require.NoError(t, err)
+
+	require.Equal(t, data, buff)
+
+	// the dynamic encoding must match the encoding of native go values
+	native, err := encoder.Marshal([]interface{}{
+		int8(-2),
+		big.NewInt(1000),
+		address.BytesToAddress([]byte{0x11, 0x22, 0x33}),
+		true,
+		[]byte{0x01, 0x02},
+		[3]byte{0x0a, 0x0b, 0x0c},
+		"hello",
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+		[2]string{"x", "y"},
+		[]struct {
+			ID   *big.Int
+			Memo string
+		}{{big.NewInt(7), "seven"}},
+	})
+
+	require.NoError(t, err)
+
+	require.Equal(t, native, data)
+}
+
+func TestValueJSON(t *testing.T) {
+	buff, err := json.Marshal(newValueTestValue())
+
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{
+		"a":"-2",
+		"b":"1000",
+		"owner":"`+address.BytesToAddress([]byte{0x11, 0x22, 0x33}).Hex()+`",
+		"ok":true,
+		"data":"0x0102",
+		"tag":"0x0a0b0c",
+		"name":"hello",
+		"ids":["1","2"],
+		"names":["x","y"],
+		"items":[{"id":"7","memo":"seven"}]
+	}`, string(buff))
+
+	buff, err = json.Marshal(TupleValue{Elems: []Value{BoolValue(false), StringValue("a")}})
+
+	require.NoError(t, err)
+
+	require.Equal(t, `[false,"a"]`, string(buff))
+}
+
+func TestValuePretty(t *testing.T) {
+	fields, err := ParseHumanReadable(
+		"struct Item { uint256 id; string memo; }",
+		"function f(uint256 amount, Item[] items, bool)",
+	)
+
+	require.NoError(t, err)
+
+	values := []Value{
+		UintValue{Bits: 256, Value: big.NewInt(10)},
+		ArrayValue{Elem: "(uint256,string)", Elems: []Value{
+			TupleValue{Elems: []Value{UintValue{Bits: 256, Value: big.NewInt(1)}, StringValue("one")}},
+		}},
+		BoolValue(true),
+	}
+
+	require.Equal(t, `amount uint256: 10
+items (uint256,string)[]:
+  [0] (uint256,string):
+    id uint256: 1
+    memo string: one
+[2] bool: true
+`, Pretty(fields[0].Inputs, values))
+}
+
+func TestValueErrors(t *testing.T) {
+	u256, err := Integer(false, 256)
+	require.NoError(t, err)
+
+	_, err = u256.Marshal(StringValue("1"))
+	require.Error(t, err)
+
+	_, err = u256.Marshal(IntValue{Bits: 256, Value: big.NewInt(-1)})
+	require.Error(t, err)
+
+	var v Value
+
+	_, err = u256.Unmarshal(make([]byte, 31), &v)
+	require.Error(t, err)
+
+	b, err := Bool()
+	require.NoError(t, err)
+
+	_, err = b.Unmarshal(mustDecodeHex("0000000000000000000000000000000000000000000000000000000000000002"), &v)
+	require.Error(t, err)
+
+	array, err := Array(u256)
+	require.NoError(t, err)
+
+	// offset 0x20, length 0xffff with no elements
+	_, err = array.Unmarshal(mustDecodeHex(
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+			"000000000000000000000000000000000000000000000000000000000000ffff"), &v)
+	require.Error(t, err)
+
+	bytes3, err := FixedBytes(3)
+	require.NoError(t, err)
+
+	_, err = bytes3.Marshal(FixedBytesValue{0x01})
+	require.Error(t, err)
+}
@@ -5,7 +5,7 @@ import (
 	"encoding/hex"
 
 	ecdsax "github.com/libs4go/crypto/ecdsa"
-	"golang.org/x/crypto/sha3"
+	"github.com/libs4go/ethers/internal/keccak"
 )
 
 const (
@@ -101,9 +101,7 @@ func (a *Address) checksumHex() []byte {
 	buf := a.hex()
 
 	// compute checksum
-	sha := sha3.NewLegacyKeccak256()
-	sha.Write(buf[2:])
-	hash := sha.Sum(nil)
+	hash := keccak.Hash(buf[2:])
 	for i := 2; i < len(buf); i++ {
 		hashByte := hash[(i-2)/2]
 		if i%2 == 0 {
@@ -130,11 +128,5 @@ func FromPublicKey(key *ecdsa.PublicKey) Address {
 
 	buff := ecdsax.PublicKeyBytes(key)
 
-	hasher := sha3.NewLegacyKeccak256()
-
-	hasher.Write(buff[1:])
-
-	buff = hasher.Sum(nil)[12:]
-
-	return BytesToAddress(buff)
+	return BytesToAddress(keccak.Hash(buff[1:])[12:])
 }
@@ -0,0 +1,588 @@
+// Package clienttest provides in-memory client.Provider for unit tests
+package clienttest
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/encoding/rlp"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+	"github.com/libs4go/fixed"
+)
+
+// CallHandler handle eth_call input data (without selector) and returns the output data
+type CallHandler func(data []byte) ([]byte, error)
+
+// TransactionHandler handle transaction input data (without selector), returns the emitted logs,
+// returning error reverts the transaction
+type TransactionHandler func(data []byte) ([]*client.Log, error)
+
+// Provider in-memory provider, eth_call is dispatched to the handler registered by contract address and function signature,
+// logs added by AddLogs are returned by eth_getLogs and delivered to log subscriptions, sent transactions are mined
+// immediately by the registered transaction handlers
+type Provider struct {
+	sync.RWMutex
+	chainID     uint64
+	blockNumber uint64
+	calls       map[string]CallHandler
+	logs        []*client.Log
+	queries     []*client.FilterQuery
+	subs        []*logSubscription
+	storage     map[string][]*storageValue
+	txHandlers  map[string]TransactionHandler
+	sent        []*signer.Transaction
+	receipts    map[string]*client.TransactionReceipt
+	gas         uint64
+	estimated   []*client.CallSite
+}
+
+// storageValue storage slot value written at block
+type storageValue struct {
+	block uint64
+	value [32]byte
+}
+
+// New create in-memory provider of chainID
+func New(chainID uint64) *Provider {
+	return &Provider{
+		chainID:    chainID,
+		calls:      make(map[string]CallHandler),
+		storage:    make(map[string][]*storageValue),
+		txHandlers: make(map[string]TransactionHandler),
+		receipts:   make(map[string]*client.TransactionReceipt),
+		gas:        DefaultGasEstimate,
+	}
+}
+
+var _ client.Subscriber = (*Provider)(nil)
+
+func callKey(to string, selector []byte) string {
+	return strings.ToLower(strings.TrimPrefix(to, "0x")) + hex.EncodeToString(selector)
+}
+
+// HandleCall register eth_call handler of contract function signature, e.g. "balanceOf(address)"
+func (provider *Provider) HandleCall(to string, signature string, handler CallHandler) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	provider.calls[callKey(to, abi.Selector(signature))] = handler
+}
+
+func (provider *Provider) Call(ctx context.Context, callsite *client.CallSite) (string, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(callsite.Data, "0x"))
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) < 4 {
+		return "0x", nil
+	}
+
+	provider.RLock()
+	handler, ok := provider.calls[callKey(callsite.To, data[:4])]
+	provider.RUnlock()
+
+	if !ok {
+		// calling not implemented function of contract returns empty data
+		return "0x", nil
+	}
+
+	ret, err := handler(data[4:])
+
+	if err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(ret), nil
+}
+
+// CallAt eth_call ignoring block number, the registered handlers are not block aware
+func (provider *Provider) CallAt(ctx context.Context, callsite *client.CallSite, number *uint64) (string, error) {
+	return provider.Call(ctx, callsite)
+}
+
+func storageKey(address string, slot string) (string, error) {
+	buff, err := hex.DecodeString(strings.TrimPrefix(slot, "0x"))
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(buff) > 32 {
+		return "", fmt.Errorf("clienttest: invalid storage slot %s", slot)
+	}
+
+	return strings.ToLower(strings.TrimPrefix(address, "0x")) + hex.EncodeToString(append(make([]byte, 32-len(buff)), buff...)), nil
+}
+
+// SetStorageAt write storage slot of address at the current block number, value is left padded to 32 bytes
+func (provider *Provider) SetStorageAt(address string, slot string, value []byte) error {
+	key, err := storageKey(address, slot)
+
+	if err != nil {
+		return err
+	}
+
+	if len(value) > 32 {
+		return fmt.Errorf("clienttest: storage value length %d exceeds 32 bytes", len(value))
+	}
+
+	provider.Lock()
+	defer provider.Unlock()
+
+	written := &storageValue{block: provider.blockNumber}
+
+	copy(written.value[32-len(value):], value)
+
+	provider.storage[key] = append(provider.storage[key], written)
+
+	return nil
+}
+
+// GetStorageAt returns the last value written at or before block number
+func (provider *Provider) GetStorageAt(ctx context.Context, address string, slot string, number *uint64) (string, error) {
+	key, err := storageKey(address, slot)
+
+	if err != nil {
+		return "", err
+	}
+
+	provider.RLock()
+	defer provider.RUnlock()
+
+	var value [32]byte
+
+	for _, written := range provider.storage[key] {
+		if number != nil && written.block > *number {
+			break
+		}
+
+		value = written.value
+	}
+
+	return "0x" + hex.EncodeToString(value[:]), nil
+}
+
+func (provider *Provider) ChainID(ctx context.Context) (uint64, error) {
+	return provider.chainID, nil
+}
+
+// Nonce returns the count of sent transactions, the provider assumes single sender
+func (provider *Provider) Nonce(ctx context.Context, address string) (uint64, error) {
+	provider.RLock()
+	defer provider.RUnlock()
+
+	return uint64(len(provider.sent)), nil
+}
+
+func (provider *Provider) GetBalance(ctx context.Context, address string) (*fixed.Number, error) {
+	return fixed.New(18, fixed.HexRawValue("0x0"))
+}
+
+// DefaultGasEstimate gas returned by EstimateGas by default
+const DefaultGasEstimate = 60000
+
+// SetGasEstimate set the gas returned by EstimateGas
+func (provider *Provider) SetGasEstimate(gas uint64) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	provider.gas = gas
+}
+
+// EstimateGas returns the gas set by SetGasEstimate, the call is executed by the registered handler
+// and its error is returned as reverted
+func (provider *Provider) EstimateGas(ctx context.Context, callsite *client.CallSite) (*big.Int, error) {
+	if _, err := provider.Call(ctx, callsite); err != nil {
+		return nil, err
+	}
+
+	provider.Lock()
+	defer provider.Unlock()
+
+	estimated := *callsite
+
+	provider.estimated = append(provider.estimated, &estimated)
+
+	return new(big.Int).SetUint64(provider.gas), nil
+}
+
+// Estimated returns the callsites of EstimateGas in order
+func (provider *Provider) Estimated() []*client.CallSite {
+	provider.RLock()
+	defer provider.RUnlock()
+
+	return append([]*client.CallSite(nil), provider.estimated...)
+}
+
+// SetBlockNumber set the latest block number returned by BlockNumber
+func (provider *Provider) SetBlockNumber(number uint64) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	provider.blockNumber = number
+}
+
+func (provider *Provider) BlockNumber(ctx context.Context) (uint64, error) {
+	provider.RLock()
+	defer provider.RUnlock()
+
+	return provider.blockNumber, nil
+}
+
+func (provider *Provider) GetBlockByNumber(ctx context.Context, number uint64, full bool) (*client.Block, error) {
+	return nil, errNotSupport("GetBlockByNumber")
+}
+
+func (provider *Provider) GetTransactionByHash(ctx context.Context, tx string) (*client.Transaction, error) {
+	return nil, errNotSupport("GetTransactionByHash")
+}
+
+// HandleTransaction register transaction handler of contract function signature, e.g. "transfer(address,uint256)"
+func (provider *Provider) HandleTransaction(to string, signature string, handler TransactionHandler) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	provider.txHandlers[callKey(to, abi.Selector(signature))] = handler
+}
+
+// Transactions returns the sent transactions
+func (provider *Provider) Transactions() []*signer.Transaction {
+	provider.RLock()
+	defer provider.RUnlock()
+
+	return append([]*signer.Transaction{}, provider.sent...)
+}
+
+// SendRawTransaction mine legacy transaction in the current block, the transaction without handler succeeds
+// without logs
+func (provider *Provider) SendRawTransaction(ctx context.Context, raw []byte) (string, error) {
+	var tx signer.Transaction
+
+	if err := rlp.DecodeBytes(raw, &tx); err != nil {
+		return "", err
+	}
+
+	hash := tx.Hash()
+
+	var handler TransactionHandler
+	var to string
+
+	if tx.Recipient != nil {
+		to = address.Address(*tx.Recipient).Hex()
+
+		if len(tx.Payload) >= 4 {
+			provider.RLock()
+			handler = provider.txHandlers[callKey(to, tx.Payload[:4])]
+			provider.RUnlock()
+		}
+	}
+
+	var logs []*client.Log
+
+	status := "0x1"
+
+	if handler != nil {
+		var err error
+
+		logs, err = handler(tx.Payload[4:])
+
+		if err != nil {
+			status = "0x0"
+			logs = nil
+		}
+	}
+
+	provider.Lock()
+
+	block := fmt.Sprintf("0x%x", provider.blockNumber)
+
+	for i, log := range logs {
+		if log.Address == "" {
+			log.Address = to
+		}
+
+		log.BlockNumber = block
+		log.BlockHash = fmt.Sprintf("0x%064x", provider.blockNumber)
+		log.TransactionHash = hash
+		log.LogIndex = fmt.Sprintf("0x%x", i)
+	}
+
+	provider.sent = append(provider.sent, &tx)
+
+	provider.receipts[hash] = &client.TransactionReceipt{
+		Hash:        hash,
+		BlockNumber: block,
+		BlockHash:   fmt.Sprintf("0x%064x", provider.blockNumber),
+		Logs:        logs,
+		Status:      status,
+	}
+
+	provider.Unlock()
+
+	provider.AddLogs(logs...)
+
+	return hash, nil
+}
+
+// GetTransactionReceipt returns nil for unknown transaction, which is the pending transaction of node
+func (provider *Provider) GetTransactionReceipt(ctx context.Context, tx string) (*client.TransactionReceipt, error) {
+	provider.RLock()
+	defer provider.RUnlock()
+
+	return provider.receipts[strings.ToLower(tx)], nil
+}
+
+func (provider *Provider) GasPrice(ctx context.Context) (*fixed.Number, error) {
+	return fixed.New(18, fixed.HexRawValue("0x0"))
+}
+
+func (provider *Provider) GetBlockTransactionCountByHash(ctx context.Context, blockHash string) (uint64, error) {
+	return 0, errNotSupport("GetBlockTransactionCountByHash")
+}
+
+func (provider *Provider) GetBlockTransactionCountByNumber(ctx context.Context, number uint64) (uint64, error) {
+	return 0, errNotSupport("GetBlockTransactionCountByNumber")
+}
+
+func (provider *Provider) GetBlockByHash(ctx context.Context, blockHash string, full bool) (*client.Block, error) {
+	return nil, errNotSupport("GetBlockByHash")
+}
+
+// AddLogs append logs, which are returned by GetLogs and delivered to the matching log subscriptions,
+// the removed log retracts the stored log of same block hash and log index
+func (provider *Provider) AddLogs(logs ...*client.Log) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	for _, log := range logs {
+		if log.Removed {
+			provider.retract(log)
+		} else {
+			provider.logs = append(provider.logs, log)
+		}
+	}
+
+	subs := provider.subs[:0]
+
+	for _, sub := range provider.subs {
+		if sub.deliver(logs) {
+			subs = append(subs, sub)
+		}
+	}
+
+	provider.subs = subs
+}
+
+// retract drop the stored log of removed log by block hash and log index
+func (provider *Provider) retract(removed *client.Log) {
+	logs := provider.logs[:0]
+
+	for _, log := range provider.logs {
+		if strings.EqualFold(log.BlockHash, removed.BlockHash) && strings.EqualFold(log.LogIndex, removed.LogIndex) {
+			continue
+		}
+
+		logs = append(logs, log)
+	}
+
+	provider.logs = logs
+}
+
+// LogQueries returns the filter queries of GetLogs calls
+func (provider *Provider) LogQueries() []*client.FilterQuery {
+	provider.RLock()
+	defer provider.RUnlock()
+
+	return append([]*client.FilterQuery{}, provider.queries...)
+}
+
+func (provider *Provider) GetLogs(ctx context.Context, query *client.FilterQuery) ([]*client.Log, error) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	provider.queries = append(provider.queries, query)
+
+	from, err := blockArg(query.FromBlock, 0, provider.blockNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := blockArg(query.ToBlock, provider.blockNumber, provider.blockNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*client.Log
+
+	for _, log := range provider.logs {
+		if matchLog(query, log, from, to) {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs, nil
+}
+
+// blockArg parse hex block number or tag of filter query
+func blockArg(arg string, defaultValue uint64, latest uint64) (uint64, error) {
+	switch arg {
+	case "":
+		return defaultValue, nil
+	case "latest", "pending", "safe", "finalized":
+		return latest, nil
+	case "earliest":
+		return 0, nil
+	}
+
+	return strconv.ParseUint(strings.TrimPrefix(arg, "0x"), 16, 64)
+}
+
+func matchLog(query *client.FilterQuery, log *client.Log, from uint64, to uint64) bool {
+	if query.BlockHash != "" && !strings.EqualFold(query.BlockHash, log.BlockHash) {
+		return false
+	}
+
+	if query.BlockHash == "" {
+		number, err := strconv.ParseUint(strings.TrimPrefix(log.BlockNumber, "0x"), 16, 64)
+
+		if err != nil || number < from || number > to {
+			return false
+		}
+	}
+
+	if len(query.Addresses) > 0 && !containsFold(query.Addresses, log.Address) {
+		return false
+	}
+
+	for i, set := range query.Topics {
+		if len(set) == 0 {
+			continue
+		}
+
+		if i >= len(log.Topics) || !containsFold(set, log.Topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subscriptionQueueSize notifications buffered per log subscription, the subscription overflowed
+// is closed with error as the subscription of client does
+const subscriptionQueueSize = 256
+
+type logSubscription struct {
+	provider *Provider
+	query    *client.FilterQuery
+	queue    chan *client.Log
+	err      chan error
+	quit     chan struct{}
+	overflow chan struct{}
+	once     sync.Once
+}
+
+// deliver queue the matching logs, returns false if the queue overflows
+func (sub *logSubscription) deliver(logs []*client.Log) bool {
+	for _, log := range logs {
+		if !matchLog(sub.query, log, 0, ^uint64(0)) {
+			continue
+		}
+
+		select {
+		case sub.queue <- log:
+		default:
+			close(sub.overflow)
+			return false
+		}
+	}
+
+	return true
+}
+
+// SubscribeLogs deliver logs added after subscribing, the removed logs are delivered too
+func (provider *Provider) SubscribeLogs(ctx context.Context, query *client.FilterQuery, sink chan<- *client.Log) (client.Subscription, error) {
+	sub := &logSubscription{
+		provider: provider,
+		query:    query,
+		queue:    make(chan *client.Log, subscriptionQueueSize),
+		err:      make(chan error),
+		quit:     make(chan struct{}),
+		overflow: make(chan struct{}),
+	}
+
+	provider.Lock()
+	provider.subs = append(provider.subs, sub)
+	provider.Unlock()
+
+	go func() {
+		defer close(sub.err)
+
+		for {
+			select {
+			case <-sub.quit:
+				return
+			case <-sub.overflow:
+				select {
+				case sub.err <- fmt.Errorf("clienttest: notification queue overflow"):
+				case <-sub.quit:
+				}
+
+				return
+			case log := <-sub.queue:
+				select {
+				case sink <- log:
+				case <-sub.quit:
+					return
+				}
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+func (sub *logSubscription) Unsubscribe() {
+	sub.once.Do(func() {
+		sub.provider.Lock()
+		defer sub.provider.Unlock()
+
+		for i, other := range sub.provider.subs {
+			if other == sub {
+				sub.provider.subs = append(sub.provider.subs[:i], sub.provider.subs[i+1:]...)
+				break
+			}
+		}
+
+		close(sub.quit)
+	})
+}
+
+func (sub *logSubscription) Err() <-chan error {
+	return sub.err
+}
+
+func errNotSupport(method string) error {
+	return fmt.Errorf("clienttest: %s not support", method)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/signer"
+)
+
+// erc1271MagicValue bytes4(keccak256("isValidSignature(bytes32,bytes)"))
+var erc1271MagicValue = []byte{0x16, 0x26, 0xba, 0x7e}
+
+// IsValidSignature call ERC-1271 isValidSignature(bytes32,bytes) of contract account,
+// returns true if the contract returns the magic value 0x1626ba7e
+func IsValidSignature(ctx context.Context, provider Provider, contract string, digest []byte, sig []byte) (bool, error) {
+	if len(digest) != 32 {
+		return false, errors.Wrap(signer.ErrSignature, "invalid digest length %d", len(digest))
+	}
+
+	var data bytes.Buffer
+
+	data.Write(erc1271MagicValue)
+	data.Write(digest)
+	data.Write(new(big.Int).SetUint64(0x40).FillBytes(make([]byte, 32)))
+	data.Write(new(big.Int).SetUint64(uint64(len(sig))).FillBytes(make([]byte, 32)))
+	data.Write(sig)
+	data.Write(make([]byte, (32-len(sig)%32)%32))
+
+	ret, err := provider.Call(ctx, &CallSite{
+		To:   contract,
+		Data: "0x" + hex.EncodeToString(data.Bytes()),
+	})
+
+	if err != nil {
+		return false, errors.Wrap(err, "call %s isValidSignature error", contract)
+	}
+
+	buff, err := hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return false, errors.Wrap(err, "decode isValidSignature result %s error", ret)
+	}
+
+	// accounts without code return empty data
+	if len(buff) < 32 {
+		return false, nil
+	}
+
+	return bytes.Equal(buff[:4], erc1271MagicValue), nil
+}
+
+// VerifySignature check sig of digest is signed by account, the EOA signature is
+// checked by ecrecover first, otherwise the account is treated as ERC-1271 contract wallet
+func VerifySignature(ctx context.Context, provider Provider, account string, digest []byte, sig []byte) (bool, error) {
+	if signature, err := signer.ParseSignature(sig); err == nil {
+		addr, err := signature.RecoverAddress(digest)
+
+		if err == nil && strings.EqualFold(addr, account) {
+			return true, nil
+		}
+	}
+
+	return IsValidSignature(ctx, provider, account, digest, sig)
+}
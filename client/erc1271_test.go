@@ -0,0 +1,83 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/libs4go/ethers/signer"
+	"github.com/stretchr/testify/require"
+)
+
+const walletContract = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+
+func TestERC1271(t *testing.T) {
+	owner, err := signer.OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	typedData := &signer.TypedData{
+		Types: eip712.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      eip712.TypedDataDomain{Name: "Wallet"},
+		Message:     map[string]interface{}{"contents": "Hello"},
+	}
+
+	digest, err := (*eip712.TypedData)(typedData).Digest()
+
+	require.NoError(t, err)
+
+	sig, err := owner.SignTypedData(typedData)
+
+	require.NoError(t, err)
+
+	provider := clienttest.New(1)
+
+	// contract wallet accepts signatures of its owner
+	provider.HandleCall(walletContract, "isValidSignature(bytes32,bytes)", func(data []byte) ([]byte, error) {
+		length := int(data[95])
+
+		signature, err := signer.ParseSignature(data[96 : 96+length])
+
+		ret := make([]byte, 32)
+
+		if err != nil {
+			return ret, nil
+		}
+
+		addr, err := signature.RecoverAddress(data[:32])
+
+		if err == nil && addr == owner.Addresss() {
+			copy(ret, []byte{0x16, 0x26, 0xba, 0x7e})
+		}
+
+		return ret, nil
+	})
+
+	ok, err := client.IsValidSignature(context.Background(), provider, walletContract, digest, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = client.VerifySignature(context.Background(), provider, walletContract, digest, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = client.VerifySignature(context.Background(), provider, owner.Addresss(), digest, sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = client.IsValidSignature(context.Background(), provider, walletContract, bytes.Repeat([]byte{1}, 32), sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// EOA without code
+	ok, err = client.IsValidSignature(context.Background(), provider, owner.Addresss(), digest, sig)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
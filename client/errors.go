@@ -0,0 +1,36 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/jsonrpc"
+)
+
+// ScopeOfAPIError .
+const errVendor = "ethers-client"
+
+// errors
+var (
+	ErrSubscription = errors.New("Subscription error", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrReverted     = errors.New("Transaction reverted", errors.WithVendor(errVendor), errors.WithCode(-2))
+)
+
+// IsReverted check if err is the execution reverted error of eth_call or eth_estimateGas, which is
+// code 3 returned by geth, or -32000 server error with revert message returned by other nodes
+func IsReverted(err error) bool {
+	var rpcError *jsonrpc.RPCError
+
+	if !errors.As(err, &rpcError) {
+		return false
+	}
+
+	switch rpcError.Code {
+	case 3:
+		return true
+	case jsonrpc.RPCServerError:
+		return strings.Contains(strings.ToLower(rpcError.Message), "revert")
+	}
+
+	return false
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReverted(t *testing.T) {
+	require.True(t, IsReverted(&jsonrpc.RPCError{Code: 3, Message: "execution reverted"}))
+	require.True(t, IsReverted(&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "execution reverted: paused"}))
+	require.True(t, IsReverted(errors.Wrap(&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "VM Exception while processing transaction: revert"}, "call error")))
+	require.False(t, IsReverted(&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "header not found"}))
+	require.False(t, IsReverted(&jsonrpc.RPCError{Code: jsonrpc.RPCMethodNotFound, Message: "the method eth_call does not exist"}))
+	require.False(t, IsReverted(fmt.Errorf("connection refused")))
+	require.False(t, IsReverted(nil))
+}
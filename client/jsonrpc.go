@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/libs4go/errors"
 	"github.com/libs4go/fixed"
 	"github.com/libs4go/jsonrpc"
 	"github.com/libs4go/jsonrpc/client"
+	"github.com/libs4go/jsonrpc/transport"
 	"github.com/libs4go/slf4go"
 )
 
@@ -123,6 +125,42 @@ func (client *jsonrpcProvider) Call(ctx context.Context, callsite *CallSite) (va
 	return
 }
 
+// CallAt eth_call at block number, nil is the latest block
+func (client *jsonrpcProvider) CallAt(ctx context.Context, callsite *CallSite, number *uint64) (val string, err error) {
+
+	err = client.rpcCall(ctx, "eth_call", &val, callsite, BlockTag(number))
+
+	return
+}
+
+// EstimateGas returns the gas required by callsite, the reverted call returns error
+func (client *jsonrpcProvider) EstimateGas(ctx context.Context, callsite *CallSite) (*big.Int, error) {
+
+	var data string
+
+	err := client.rpcCall(ctx, "eth_estimateGas", &data, callsite)
+
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := fixed.New(0, fixed.HexRawValue(data))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "decode %s error", data)
+	}
+
+	return val.RawValue, nil
+}
+
+// GetStorageAt returns the storage slot of address at block number, nil is the latest block
+func (client *jsonrpcProvider) GetStorageAt(ctx context.Context, address string, slot string, number *uint64) (val string, err error) {
+
+	err = client.rpcCall(ctx, "eth_getStorageAt", &val, address, slot, BlockTag(number))
+
+	return
+}
+
 // BlockByNumber get block by number
 func (client *jsonrpcProvider) GetBlockByNumber(ctx context.Context, number uint64, full bool) (val *Block, err error) {
 
@@ -172,6 +210,32 @@ func (client *jsonrpcProvider) GetBlockByHash(ctx context.Context, blockHash str
 	return
 }
 
+// ChainID get the chain id used for signing replay-protected transactions
+func (client *jsonrpcProvider) ChainID(ctx context.Context) (uint64, error) {
+	var data string
+
+	err := client.rpcCall(ctx, "eth_chainId", &data)
+
+	if err != nil {
+		return 0, err
+	}
+
+	val, err := fixed.New(0, fixed.HexRawValue(data))
+
+	if err != nil {
+		return 0, errors.Wrap(err, "decode %s error", data)
+	}
+
+	return uint64(val.RawValue.Int64()), nil
+}
+
+// GetLogs returns logs matching filter query
+func (client *jsonrpcProvider) GetLogs(ctx context.Context, query *FilterQuery) (val []*Log, err error) {
+	err = client.rpcCall(ctx, "eth_getLogs", &val, query)
+
+	return
+}
+
 // HttpProvider create http jsonrpc provider
 func HttpProvider(remote string, ops ...client.ClientOpt) (Provider, error) {
 	c, err := client.HTTPConnect(remote, ops...)
@@ -183,12 +247,21 @@ func HttpProvider(remote string, ops ...client.ClientOpt) (Provider, error) {
 	return NewJSONRPCProvider(c)
 }
 
+// WebsocketProvider create websocket jsonrpc provider, which implements Subscriber
 func WebsocketProvider(remote string, ops ...client.ClientOpt) (Provider, error) {
-	c, err := client.WebSocketConnect(remote, ops...)
+	inner, err := transport.NewWebSocketClientTransport(remote)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return NewJSONRPCProvider(c)
+	notifications := newNotificationTransport(inner)
+
+	c, err := client.New(append(ops, client.ClientTrans(notifications))...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newSubscriptionProvider(c, notifications), nil
 }
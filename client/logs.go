@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Log eth log object
+type Log struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"` // true if the log was removed by chain reorganization
+}
+
+// FilterQuery eth_getLogs and eth_subscribe logs filter
+type FilterQuery struct {
+	BlockHash string   `json:"blockHash,omitempty"`
+	FromBlock string   `json:"fromBlock,omitempty"`
+	ToBlock   string   `json:"toBlock,omitempty"`
+	Addresses []string `json:"address,omitempty"`
+	// Topics positional topic filters, each position is an OR-set and nil or empty set matches any topic
+	Topics [][]string `json:"topics,omitempty"`
+}
+
+// MarshalJSON marshal topics position of empty set as null
+func (query *FilterQuery) MarshalJSON() ([]byte, error) {
+	type filterQuery FilterQuery
+
+	var topics []interface{}
+
+	for _, set := range query.Topics {
+		if len(set) == 0 {
+			topics = append(topics, nil)
+		} else {
+			topics = append(topics, set)
+		}
+	}
+
+	return json.Marshal(&struct {
+		*filterQuery
+		Topics []interface{} `json:"topics,omitempty"`
+	}{
+		filterQuery: (*filterQuery)(query),
+		Topics:      topics,
+	})
+}
+
+// Subscription live notification subscription
+type Subscription interface {
+	// Unsubscribe cancel the subscription, the Err channel is closed
+	Unsubscribe()
+	// Err returns the channel receiving the error terminating the subscription, e.g. connection lost
+	Err() <-chan error
+}
+
+// Subscriber optional Provider interface implemented by websocket provider, which streams logs
+// of eth_subscribe logs notifications
+type Subscriber interface {
+	SubscribeLogs(ctx context.Context, query *FilterQuery, sink chan<- *Log) (Subscription, error)
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 
 	"github.com/libs4go/fixed"
 )
@@ -46,16 +48,16 @@ type Transaction struct {
 
 // TransactionReceipt .
 type TransactionReceipt struct {
-	Hash              string        `json:"transactionHash"`
-	BlockHash         string        `json:"blockHash"`
-	BlockNumber       string        `json:"blockNumber"`
-	TransactionIndex  string        `json:"transactionIndex"`
-	CumulativeGasUsed string        `json:"cumulativeGasUsed"`
-	GasUsed           string        `json:"gasUsed"`
-	ContractAddress   string        `json:"contractAddress"`
-	Logs              []interface{} `json:"logs"`
-	LogsBloom         string        `json:"logsBloom"`
-	Status            string        `json:"status"`
+	Hash              string `json:"transactionHash"`
+	BlockHash         string `json:"blockHash"`
+	BlockNumber       string `json:"blockNumber"`
+	TransactionIndex  string `json:"transactionIndex"`
+	CumulativeGasUsed string `json:"cumulativeGasUsed"`
+	GasUsed           string `json:"gasUsed"`
+	ContractAddress   string `json:"contractAddress"`
+	Logs              []*Log `json:"logs"`
+	LogsBloom         string `json:"logsBloom"`
+	Status            string `json:"status"`
 }
 
 // CallSite .
@@ -82,4 +84,22 @@ type Provider interface {
 	GetBlockTransactionCountByHash(ctx context.Context, blockHash string) (uint64, error)
 	GetBlockTransactionCountByNumber(ctx context.Context, number uint64) (uint64, error)
 	GetBlockByHash(ctx context.Context, blockHash string, full bool) (val *Block, err error)
+	ChainID(ctx context.Context) (uint64, error)
+	// GetLogs returns logs matching filter query
+	GetLogs(ctx context.Context, query *FilterQuery) ([]*Log, error)
+	// GetStorageAt returns the 32 bytes storage slot of address at block number, nil is the latest block
+	GetStorageAt(ctx context.Context, address string, slot string, number *uint64) (val string, err error)
+	// CallAt eth_call at block number, nil is the latest block
+	CallAt(ctx context.Context, callsite *CallSite, number *uint64) (val string, err error)
+	// EstimateGas returns the gas required by callsite, the reverted call returns error
+	EstimateGas(ctx context.Context, callsite *CallSite) (*big.Int, error)
+}
+
+// BlockTag returns the hex block number parameter of rpc call, nil is "latest"
+func BlockTag(number *uint64) string {
+	if number == nil {
+		return "latest"
+	}
+
+	return fmt.Sprintf("0x%x", *number)
 }
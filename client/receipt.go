@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/libs4go/errors"
+)
+
+// DefaultReceiptInterval eth_getTransactionReceipt polling interval of WaitReceipt
+const DefaultReceiptInterval = time.Second
+
+// Succeeded check receipt status is 0x1
+func (receipt *TransactionReceipt) Succeeded() bool {
+	return receipt.Status == "0x1"
+}
+
+// WaitReceipt poll eth_getTransactionReceipt until tx is mined or ctx is done, interval 0 is
+// DefaultReceiptInterval. The receipt of reverted tx is returned with ErrReverted
+func WaitReceipt(ctx context.Context, provider Provider, tx string, interval time.Duration) (*TransactionReceipt, error) {
+	if interval == 0 {
+		interval = DefaultReceiptInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := provider.GetTransactionReceipt(ctx, tx)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "get receipt of %s error", tx)
+		}
+
+		// pending tx has null receipt
+		if receipt != nil && receipt.BlockNumber != "" {
+			if !receipt.Succeeded() {
+				return receipt, errors.Wrap(ErrReverted, "tx %s reverted in block %s", tx, receipt.BlockNumber)
+			}
+
+			return receipt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "wait receipt of %s error", tx)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/jsonrpc"
+	"github.com/libs4go/slf4go"
+)
+
+// maxPendingNotifications notifications buffered per unknown subscription id, which may arrive
+// before the eth_subscribe reply is handled
+const maxPendingNotifications = 256
+
+// notificationQueue buffered notifications of subscription, reason is set before the channel is
+// closed by the transport
+type notificationQueue struct {
+	ch     chan json.RawMessage
+	reason string
+}
+
+type notification struct {
+	Method string `json:"method"`
+	Params struct {
+		Subscription string          `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+	} `json:"params"`
+}
+
+// notificationTransport client transport dispatching eth_subscription notifications to the
+// registered subscriptions, other messages are forwarded to the jsonrpc client
+type notificationTransport struct {
+	sync.Mutex
+	slf4go.Logger
+	jsonrpc.ClientTransport
+	recv    chan []byte
+	subs    map[string]*notificationQueue
+	pending map[string][]json.RawMessage
+	closed  bool
+	once    sync.Once
+}
+
+func newNotificationTransport(inner jsonrpc.ClientTransport) *notificationTransport {
+	return &notificationTransport{
+		Logger:          slf4go.Get("ethers-client-subscription"),
+		ClientTransport: inner,
+		recv:            make(chan []byte, 100),
+		subs:            make(map[string]*notificationQueue),
+		pending:         make(map[string][]json.RawMessage),
+	}
+}
+
+func (transport *notificationTransport) Recv() <-chan []byte {
+	transport.once.Do(func() {
+		go transport.runLoop()
+	})
+
+	return transport.recv
+}
+
+func (transport *notificationTransport) Close() error {
+	switch inner := transport.ClientTransport.(type) {
+	case jsonrpc.ClientTransportCloser:
+		return inner.Close()
+	case interface{ Close() }:
+		inner.Close()
+	}
+
+	return nil
+}
+
+func (transport *notificationTransport) runLoop() {
+	defer transport.closeAll()
+
+	for buff := range transport.ClientTransport.Recv() {
+		var message notification
+
+		if json.Unmarshal(buff, &message) == nil && message.Method == "eth_subscription" {
+			transport.dispatch(message.Params.Subscription, message.Params.Result)
+			continue
+		}
+
+		transport.recv <- buff
+	}
+}
+
+// dispatch queue notification of subscription, the subscription not consuming notifications in
+// time is closed instead of blocking the connection
+func (transport *notificationTransport) dispatch(id string, result json.RawMessage) {
+	transport.Lock()
+	defer transport.Unlock()
+
+	queue, ok := transport.subs[id]
+
+	if !ok {
+		if len(transport.pending[id]) < maxPendingNotifications {
+			transport.pending[id] = append(transport.pending[id], result)
+		} else {
+			transport.W("drop notification of unknown subscription {@id}", id)
+		}
+
+		return
+	}
+
+	select {
+	case queue.ch <- result:
+	default:
+		transport.remove(id, "notification queue overflow")
+	}
+}
+
+func (transport *notificationTransport) remove(id string, reason string) {
+	if queue, ok := transport.subs[id]; ok {
+		queue.reason = reason
+		close(queue.ch)
+		delete(transport.subs, id)
+	}
+}
+
+func (transport *notificationTransport) closeAll() {
+	transport.Lock()
+	defer transport.Unlock()
+
+	transport.closed = true
+
+	for id := range transport.subs {
+		transport.remove(id, "connection lost")
+	}
+
+	close(transport.recv)
+}
+
+// register returns the notification queue of subscription id, the queue is closed when the
+// connection is lost or the subscription is unregistered
+func (transport *notificationTransport) register(id string) (*notificationQueue, error) {
+	transport.Lock()
+	defer transport.Unlock()
+
+	if transport.closed {
+		return nil, errors.Wrap(ErrSubscription, "connection closed")
+	}
+
+	pending := transport.pending[id]
+
+	delete(transport.pending, id)
+
+	queue := &notificationQueue{
+		ch: make(chan json.RawMessage, len(pending)+maxPendingNotifications),
+	}
+
+	for _, result := range pending {
+		queue.ch <- result
+	}
+
+	transport.subs[id] = queue
+
+	return queue, nil
+}
+
+func (transport *notificationTransport) unregister(id string) {
+	transport.Lock()
+	defer transport.Unlock()
+
+	transport.remove(id, "unsubscribed")
+}
+
+type subscriptionProvider struct {
+	*jsonrpcProvider
+	transport *notificationTransport
+}
+
+func newSubscriptionProvider(client jsonrpc.Client, transport *notificationTransport) Provider {
+	return &subscriptionProvider{
+		jsonrpcProvider: &jsonrpcProvider{
+			Logger: slf4go.Get("JSONRPC-PROVIDER"),
+			client: client,
+		},
+		transport: transport,
+	}
+}
+
+// SubscribeLogs subscribe logs matching query by eth_subscribe, the removed logs of chain
+// reorganization are delivered with Removed flag
+func (provider *subscriptionProvider) SubscribeLogs(ctx context.Context, query *FilterQuery, sink chan<- *Log) (Subscription, error) {
+	var id string
+
+	if err := provider.rpcCall(ctx, "eth_subscribe", &id, "logs", query); err != nil {
+		return nil, err
+	}
+
+	queue, err := provider.transport.register(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &logSubscription{
+		provider: provider,
+		id:       id,
+		err:      make(chan error, 1),
+		quit:     make(chan struct{}),
+	}
+
+	go sub.run(queue, sink)
+
+	return sub, nil
+}
+
+type logSubscription struct {
+	provider *subscriptionProvider
+	id       string
+	err      chan error
+	quit     chan struct{}
+	once     sync.Once
+}
+
+func (sub *logSubscription) run(queue *notificationQueue, sink chan<- *Log) {
+	defer close(sub.err)
+
+	for {
+		select {
+		case <-sub.quit:
+			return
+		case result, ok := <-queue.ch:
+			if !ok {
+				select {
+				case <-sub.quit:
+				default:
+					sub.err <- errors.Wrap(ErrSubscription, "subscription %s closed: %s", sub.id, queue.reason)
+				}
+
+				return
+			}
+
+			log := &Log{}
+
+			if err := json.Unmarshal(result, log); err != nil {
+				sub.err <- errors.Wrap(err, "decode subscription %s log error", sub.id)
+				sub.unsubscribe()
+				return
+			}
+
+			select {
+			case sink <- log:
+			case <-sub.quit:
+				return
+			}
+		}
+	}
+}
+
+func (sub *logSubscription) unsubscribe() {
+	sub.provider.transport.unregister(sub.id)
+
+	var ok bool
+
+	if err := sub.provider.rpcCall(context.Background(), "eth_unsubscribe", &ok, sub.id); err != nil {
+		sub.provider.W("eth_unsubscribe {@id} error {@err}", sub.id, err)
+	}
+}
+
+func (sub *logSubscription) Unsubscribe() {
+	sub.once.Do(func() {
+		close(sub.quit)
+		sub.unsubscribe()
+	})
+}
+
+func (sub *logSubscription) Err() <-chan error {
+	return sub.err
+}
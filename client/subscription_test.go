@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/libs4go/jsonrpc"
+	"github.com/libs4go/jsonrpc/client"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransport answers eth_subscribe with notifications sent before the reply
+type fakeTransport struct {
+	recv          chan []byte
+	notifications []string
+	unsubscribed  chan string
+}
+
+func (transport *fakeTransport) Send(ctx context.Context, buff []byte) error {
+	var request jsonrpc.RPCRequest
+
+	if err := json.Unmarshal(buff, &request); err != nil {
+		return err
+	}
+
+	params := request.Params.([]interface{})
+
+	switch request.Method {
+	case "eth_subscribe":
+		// notifications may arrive before the subscription id reply
+		for _, result := range transport.notifications {
+			transport.recv <- []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":%s}}`, result))
+		}
+
+		transport.recv <- []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":"0x1"}`, *request.ID))
+	case "eth_unsubscribe":
+		transport.unsubscribed <- params[0].(string)
+		transport.recv <- []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":true}`, *request.ID))
+	}
+
+	return nil
+}
+
+func (transport *fakeTransport) Recv() <-chan []byte {
+	return transport.recv
+}
+
+func TestSubscribeLogs(t *testing.T) {
+	inner := &fakeTransport{
+		recv: make(chan []byte, 10),
+		notifications: []string{
+			`{"address":"0x55d398326f99059ff775485246999027b3197955","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],"data":"0x","blockNumber":"0x10","logIndex":"0x0","removed":false}`,
+			`{"address":"0x55d398326f99059ff775485246999027b3197955","topics":["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],"data":"0x","blockNumber":"0x10","logIndex":"0x0","removed":true}`,
+		},
+		unsubscribed: make(chan string, 1),
+	}
+
+	transport := newNotificationTransport(inner)
+
+	c, err := client.New(client.ClientTrans(transport))
+
+	require.NoError(t, err)
+
+	provider := newSubscriptionProvider(c, transport)
+
+	subscriber, ok := provider.(Subscriber)
+
+	require.True(t, ok)
+
+	sink := make(chan *Log)
+
+	sub, err := subscriber.SubscribeLogs(context.Background(), &FilterQuery{
+		Addresses: []string{"0x55d398326f99059ff775485246999027b3197955"},
+	}, sink)
+
+	require.NoError(t, err)
+
+	log := <-sink
+
+	require.Equal(t, "0x10", log.BlockNumber)
+	require.False(t, log.Removed)
+
+	log = <-sink
+
+	require.True(t, log.Removed)
+
+	sub.Unsubscribe()
+
+	require.Equal(t, "0x1", <-inner.unsubscribed)
+
+	_, ok = <-sub.Err()
+
+	require.False(t, ok, "err channel closed by unsubscribe")
+}
+
+func TestFilterQueryJSON(t *testing.T) {
+	buff, err := json.Marshal(&FilterQuery{
+		FromBlock: "0x1",
+		Topics:    [][]string{{"0xaa"}, nil, {"0xbb", "0xcc"}},
+	})
+
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"fromBlock":"0x1","topics":[["0xaa"],null,["0xbb","0xcc"]]}`, string(buff))
+}
@@ -1 +1,430 @@
+// abigen generate go bindings of contract json abi
+//
+// usage: abigen -pkg <package> [-out file.go | -dir dir] [-codec [-strict]] [-config abigen.json] [-rename sig=GoName]...
+// [-natspec Name=doc.json]... [-import types.json]... [-manifest types.json -importpath path]
+// [-solc solc] [-remap prefix=path]... [-optimize -optimize-runs 200] [-evm-version london] [Name=]file.json|file.sol...
+//
+// the abi file is json abi array or compiler artifact with userdoc/devdoc NatSpec, Name=a.json,b.json
+// merges the abi files and their NatSpec into one contract, e.g. the facets of EIP-2535 diamond, the
+// Name= is required by the merged files list, the solidity
+// source is compiled by local installed solc and all contracts declared in it are bound, -dir writes
+// types.go and one file per contract, -manifest writes the struct manifest of generated package,
+// which can be imported by the generation of other packages with -import
 package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/abi/solc"
+)
+
+// config abigen config file
+type config struct {
+	Names map[string]string `json:"names"` // func name overrides, see binding.WithNames
+}
+
+// natspecs repeatable -natspec flag, contract name to userdoc/devdoc json files
+type natspecs map[string][]string
+
+func (n natspecs) String() string {
+	var pairs []string
+
+	for k, files := range n {
+		for _, file := range files {
+			pairs = append(pairs, k+"="+file)
+		}
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func (n natspecs) Set(value string) error {
+	i := strings.Index(value, "=")
+
+	if i <= 0 || i == len(value)-1 {
+		return fmt.Errorf("invalid natspec '%s', expect Name=doc.json", value)
+	}
+
+	n[value[:i]] = append(n[value[:i]], value[i+1:])
+
+	return nil
+}
+
+// files repeatable file flag
+type files []string
+
+func (f *files) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *files) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
+
+// renames repeatable -rename flag
+type renames map[string]string
+
+func (r renames) String() string {
+	var pairs []string
+
+	for k, v := range r {
+		pairs = append(pairs, k+"="+v)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func (r renames) Set(value string) error {
+	i := strings.LastIndex(value, "=")
+
+	if i <= 0 || i == len(value)-1 {
+		return fmt.Errorf("invalid rename '%s', expect signature=GoName", value)
+	}
+
+	r[value[:i]] = value[i+1:]
+
+	return nil
+}
+
+func loadConfig(path string) (*config, error) {
+	cfg := &config{}
+
+	if path == "" {
+		return cfg, nil
+	}
+
+	buff, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "read config %s error", path)
+	}
+
+	if err := json.Unmarshal(buff, cfg); err != nil {
+		return nil, errors.Wrap(err, "parse config %s error", path)
+	}
+
+	return cfg, nil
+}
+
+// contractSource split positional arg [Name=]file.json, the default name is the file base name,
+// Name= is required by the merged files list a.json,b.json
+func contractSource(arg string) (string, string, error) {
+	if i := strings.Index(arg, "="); i > 0 {
+		return arg[:i], arg[i+1:], nil
+	}
+
+	if strings.Contains(arg, ",") {
+		return "", "", fmt.Errorf("contract name expect by merged abi files '%s', e.g. Diamond=%s", arg, arg)
+	}
+
+	return strings.TrimSuffix(filepath.Base(arg), filepath.Ext(arg)), arg, nil
+}
+
+// mergedNatSpec merge the userdoc/devdoc of merged abi files, returns nil if no file is artifact
+// with NatSpec
+func mergedNatSpec(files []string) (*binding.NatSpec, error) {
+	var merged *binding.NatSpec
+
+	for _, file := range files {
+		buff, err := ioutil.ReadFile(file)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "read file: %s error", file)
+		}
+
+		artifact, err := binding.ParseArtifact(buff)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if artifact.NatSpec == nil {
+			continue
+		}
+
+		if merged == nil {
+			merged = binding.NewNatSpec()
+		}
+
+		merged.Merge(artifact.NatSpec)
+	}
+
+	return merged, nil
+}
+
+func run() error {
+	pkg := flag.String("pkg", "", "go package name of generated code")
+	out := flag.String("out", "", "output file, default stdout")
+	dir := flag.String("dir", "", "output dir, write types.go and one file per contract")
+	manifestFile := flag.String("manifest", "", "write struct manifest of generated package to file")
+	importPath := flag.String("importpath", "", "import path of generated package, required by -manifest")
+	codec := flag.Bool("codec", false, "generate zero-reflection codec")
+	strict := flag.Bool("strict", false, "validate return data in strict mode before the generated codec decodes it")
+	configFile := flag.String("config", "", "config file, e.g. {\"names\":{\"safeTransferFrom(address,address,uint256,bytes)\":\"SafeTransferFromAndCall\"}}")
+
+	overrides := make(renames)
+
+	flag.Var(overrides, "rename", "override func go name, signature=GoName or Contract.signature=GoName, repeatable")
+
+	docs := make(natspecs)
+
+	flag.Var(docs, "natspec", "contract userdoc/devdoc json file, Name=doc.json, repeatable")
+
+	var imports files
+
+	flag.Var(&imports, "import", "struct manifest of previously generated package, repeatable")
+
+	solcPath := flag.String("solc", "solc", "solc executable used to compile .sol files")
+	optimize := flag.Bool("optimize", false, "enable solc optimizer")
+	optimizeRuns := flag.Int("optimize-runs", 200, "solc optimizer runs")
+	evmVersion := flag.String("evm-version", "", "solc target evm version, default is the solc default")
+
+	var remappings files
+
+	flag.Var(&remappings, "remap", "solc import remapping, prefix=path, repeatable")
+
+	flag.Parse()
+
+	if *pkg == "" || flag.NArg() == 0 {
+		flag.Usage()
+		return fmt.Errorf("-pkg and abi files expect")
+	}
+
+	if *out != "" && *dir != "" {
+		return fmt.Errorf("-out and -dir are exclusive")
+	}
+
+	if *manifestFile != "" && *importPath == "" {
+		return fmt.Errorf("-importpath expect by -manifest")
+	}
+
+	cfg, err := loadConfig(*configFile)
+
+	if err != nil {
+		return err
+	}
+
+	// command line renames take precedence over config
+	options := []binding.GenOption{binding.WithNames(cfg.Names), binding.WithNames(overrides)}
+
+	if *codec {
+		options = append(options, binding.WithCodec())
+	}
+
+	if *strict {
+		options = append(options, binding.WithStrict())
+	}
+
+	for name, files := range docs {
+		doc, err := binding.ParseNatSpecFile(files...)
+
+		if err != nil {
+			return err
+		}
+
+		options = append(options, binding.WithNatSpec(name, doc))
+	}
+
+	for _, arg := range flag.Args() {
+		if isSolidity(arg) {
+			continue
+		}
+
+		name, file, err := contractSource(arg)
+
+		if err != nil {
+			return err
+		}
+
+		if !strings.Contains(file, ",") {
+			continue
+		}
+
+		doc, err := mergedNatSpec(strings.Split(file, ","))
+
+		if err != nil {
+			return err
+		}
+
+		if doc != nil {
+			options = append(options, binding.WithNatSpec(name, doc))
+		}
+	}
+
+	for _, file := range imports {
+		manifest, err := binding.ParseManifestFile(file)
+
+		if err != nil {
+			return err
+		}
+
+		options = append(options, binding.WithImport(manifest))
+	}
+
+	generator := binding.NewGen(options...)
+
+	compiled, err := compile(flag.Args(),
+		solc.WithSolc(*solcPath),
+		solc.WithRemappings(remappings...),
+		solc.WithOptimizer(*optimize, *optimizeRuns),
+		solc.WithEVMVersion(*evmVersion),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range flag.Args() {
+		if isSolidity(arg) {
+			if err := bindSolidity(generator, arg, compiled); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		name, file, err := contractSource(arg)
+
+		if err != nil {
+			return err
+		}
+
+		if strings.Contains(file, ",") {
+			if err := bindMerged(generator, name, strings.Split(file, ",")); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if _, err := binding.ParseFile(name, file, generator); err != nil {
+			return err
+		}
+	}
+
+	if err := write(generator, *pkg, *out, *dir); err != nil {
+		return err
+	}
+
+	if *manifestFile != "" {
+		buff, err := json.MarshalIndent(generator.Manifest(*importPath, *pkg), "", "  ")
+
+		if err != nil {
+			return err
+		}
+
+		return ioutil.WriteFile(*manifestFile, append(buff, '\n'), 0644)
+	}
+
+	return nil
+}
+
+func isSolidity(arg string) bool {
+	return filepath.Ext(arg) == ".sol"
+}
+
+// compile solidity source args with solc, returns nil if there is no solidity source
+func compile(args []string, options ...solc.Option) ([]*solc.Contract, error) {
+	var sources []string
+
+	for _, arg := range args {
+		if isSolidity(arg) {
+			sources = append(sources, arg)
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	return solc.Compile(context.Background(), sources, options...)
+}
+
+// bindSolidity bind the contracts declared in source file, the contracts of imported sources
+// are skipped
+func bindSolidity(generator *binding.Generator, file string, compiled []*solc.Contract) error {
+	sourceName := filepath.ToSlash(file)
+
+	for _, contract := range compiled {
+		if contract.SourceName != sourceName {
+			continue
+		}
+
+		artifact, err := contract.Artifact()
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := binding.Parse(contract.Name, artifact, generator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bindMerged bind the merged abi of files as one contract
+func bindMerged(generator *binding.Generator, name string, files []string) error {
+	var abis [][]byte
+
+	for _, file := range files {
+		buff, err := ioutil.ReadFile(file)
+
+		if err != nil {
+			return errors.Wrap(err, "read file: %s error", file)
+		}
+
+		abis = append(abis, buff)
+	}
+
+	merged, err := binding.MergeABI(abis...)
+
+	if err != nil {
+		return errors.Wrap(err, "merge abi of %s error", name)
+	}
+
+	_, err = binding.Parse(name, merged, generator)
+
+	return err
+}
+
+// write generated code to dir, file or stdout
+func write(generator *binding.Generator, pkg string, out string, dir string) error {
+	if dir != "" {
+		return generator.WriteDir(pkg, dir)
+	}
+
+	var buff bytes.Buffer
+
+	if err := generator.Write(pkg, &buff); err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err := os.Stdout.Write(buff.Bytes())
+
+		return err
+	}
+
+	return ioutil.WriteFile(out, buff.Bytes(), 0644)
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "abigen: %s\n", err)
+		os.Exit(1)
+	}
+}
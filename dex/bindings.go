@@ -0,0 +1,3774 @@
+package dex
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// FactoryCaller view/pure funcs of contract Factory
+type FactoryCaller interface {
+	AllPairs(ctx context.Context, param0 *big.Int) (pair address.Address, err error)
+	AllPairsLength(ctx context.Context) (ret0 *big.Int, err error)
+	FeeTo(ctx context.Context) (ret0 address.Address, err error)
+	FeeToSetter(ctx context.Context) (ret0 address.Address, err error)
+	GetPair(ctx context.Context, tokenA address.Address, tokenB address.Address) (pair address.Address, err error)
+}
+
+// FactoryTransactor state-changing funcs of contract Factory
+type FactoryTransactor interface {
+	CreatePair(ctx context.Context, tokenA address.Address, tokenB address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetFeeTo(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetFeeToSetter(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// FactoryFilterer event filterers and watchers of contract Factory
+type FactoryFilterer interface {
+	FilterPairCreated(ctx context.Context, opts *binding.FilterOpts, token0 []address.Address, token1 []address.Address) (events []*FactoryPairCreatedEvent, err error)
+	WatchPairCreated(ctx context.Context, opts *binding.WatchOpts, sink chan<- *FactoryPairCreatedEvent, token0 []address.Address, token1 []address.Address) (sub client.Subscription, err error)
+}
+
+// Factory contract Factory binding interface
+type Factory interface {
+	FactoryCaller
+	FactoryTransactor
+	FactoryFilterer
+}
+
+// FactoryPairCreatedEvent event PairCreated(address,address,address,uint256) of contract Factory
+type FactoryPairCreatedEvent struct {
+	Token0 address.Address
+	Token1 address.Address
+	Pair   address.Address
+	Arg3   *big.Int
+	Raw    *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// FactoryABI json abi of contract Factory
+const FactoryABI = `[{"inputs":[{"internalType":"uint256","name":"available","type":"uint256"},{"internalType":"uint256","name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"token0","type":"address"},{"indexed":true,"internalType":"address","name":"token1","type":"address"},{"indexed":false,"internalType":"address","name":"pair","type":"address"},{"indexed":false,"internalType":"uint256","name":"","type":"uint256"}],"name":"PairCreated","type":"event"},{"inputs":[{"internalType":"uint256","name":"","type":"uint256"}],"name":"allPairs","outputs":[{"internalType":"address","name":"pair","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"allPairsLength","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"}],"name":"createPair","outputs":[{"internalType":"address","name":"pair","type":"address"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"feeTo","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"feeToSetter","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"}],"name":"getPair","outputs":[{"internalType":"address","name":"pair","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"}],"name":"setFeeTo","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"}],"name":"setFeeToSetter","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+var (
+	parsedFactoryOnce sync.Once
+	parsedFactory     abi.Contract
+	parsedFactoryErr  error
+)
+
+// FactoryContract returns the abi.Contract of FactoryABI, which is parsed once on first use
+func FactoryContract() (abi.Contract, error) {
+	parsedFactoryOnce.Do(func() {
+		parsedFactory, parsedFactoryErr = binding.Parse("Factory", []byte(FactoryABI), binding.NewSymbols())
+	})
+
+	return parsedFactory, parsedFactoryErr
+}
+
+// NewFactory create Factory binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewFactory(recipient address.Address, provider client.Provider, signer signer.Signer) (*FactoryImpl, error) {
+	contract, err := FactoryContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFactoryImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewFactoryCaller create FactoryCaller of contract deployed at recipient
+func NewFactoryCaller(recipient address.Address, provider client.Provider) (*FactoryCallerImpl, error) {
+	contract, err := FactoryContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FactoryCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewFactoryFilterer create FactoryFilterer of contract deployed at recipient
+func NewFactoryFilterer(recipient address.Address, provider client.Provider) (*FactoryFiltererImpl, error) {
+	contract, err := FactoryContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FactoryFiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// FactoryCallerImpl FactoryCaller implementation calling contract via provider
+type FactoryCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// FactoryTransactorImpl FactoryTransactor implementation sending signed transactions via provider
+type FactoryTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// FactoryFiltererImpl FactoryFilterer implementation querying and subscribing logs via provider
+type FactoryFiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// FactoryImpl Factory implementation
+type FactoryImpl struct {
+	*FactoryCallerImpl
+	*FactoryTransactorImpl
+	*FactoryFiltererImpl
+}
+
+// NewFactoryImpl create Factory implementation of contract deployed at recipient
+func NewFactoryImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *FactoryImpl {
+	return &FactoryImpl{
+		FactoryCallerImpl: &FactoryCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		FactoryTransactorImpl: &FactoryTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		FactoryFiltererImpl: &FactoryFiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ Factory = (*FactoryImpl)(nil)
+
+func (impl *FactoryCallerImpl) AllPairs(ctx context.Context, param0 *big.Int) (pair address.Address, err error) {
+	f, ok := impl.Contract.Select("1e3dd18b")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func AllPairs not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(param0)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&pair})
+
+	return
+}
+
+func (impl *FactoryCallerImpl) AllPairsLength(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("574f2ba3")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func AllPairsLength not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *FactoryCallerImpl) FeeTo(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("017e7e58")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func FeeTo not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *FactoryCallerImpl) FeeToSetter(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("094b7415")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func FeeToSetter not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *FactoryCallerImpl) GetPair(ctx context.Context, tokenA address.Address, tokenB address.Address) (pair address.Address, err error) {
+	f, ok := impl.Contract.Select("e6a43905")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetPair not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&pair})
+
+	return
+}
+
+func (impl *FactoryTransactorImpl) CreatePair(ctx context.Context, tokenA address.Address, tokenB address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("c9c65396")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func CreatePair not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *FactoryTransactorImpl) SetFeeTo(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("f46901ed")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SetFeeTo not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(param0)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *FactoryTransactorImpl) SetFeeToSetter(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a2e74af6")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SetFeeToSetter not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(param0)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterPairCreated returns PairCreated events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *FactoryFiltererImpl) FilterPairCreated(ctx context.Context, opts *binding.FilterOpts, token0 []address.Address, token1 []address.Address) (events []*FactoryPairCreatedEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event PairCreated not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(token0, token1)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &FactoryPairCreatedEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Token0, &event.Token1, &event.Pair, &event.Arg3}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchPairCreated stream PairCreated events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *FactoryFiltererImpl) WatchPairCreated(ctx context.Context, opts *binding.WatchOpts, sink chan<- *FactoryPairCreatedEvent, token0 []address.Address, token1 []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event PairCreated not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(token0, token1)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &FactoryPairCreatedEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Token0, &event.Token1, &event.Pair, &event.Arg3}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockFactory in-memory Factory implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockFactory struct {
+	AllPairsFunc          func(ctx context.Context, param0 *big.Int) (pair address.Address, err error)
+	AllPairsLengthFunc    func(ctx context.Context) (ret0 *big.Int, err error)
+	CreatePairFunc        func(ctx context.Context, tokenA address.Address, tokenB address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FeeToFunc             func(ctx context.Context) (ret0 address.Address, err error)
+	FeeToSetterFunc       func(ctx context.Context) (ret0 address.Address, err error)
+	GetPairFunc           func(ctx context.Context, tokenA address.Address, tokenB address.Address) (pair address.Address, err error)
+	SetFeeToFunc          func(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetFeeToSetterFunc    func(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FilterPairCreatedFunc func(ctx context.Context, opts *binding.FilterOpts, token0 []address.Address, token1 []address.Address) (events []*FactoryPairCreatedEvent, err error)
+	WatchPairCreatedFunc  func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *FactoryPairCreatedEvent, token0 []address.Address, token1 []address.Address) (sub client.Subscription, err error)
+}
+
+var _ Factory = (*MockFactory)(nil)
+
+func (mock *MockFactory) AllPairs(ctx context.Context, param0 *big.Int) (pair address.Address, err error) {
+	if mock.AllPairsFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func AllPairs not stubbed")
+		return
+	}
+
+	return mock.AllPairsFunc(ctx, param0)
+}
+
+func (mock *MockFactory) AllPairsLength(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.AllPairsLengthFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func AllPairsLength not stubbed")
+		return
+	}
+
+	return mock.AllPairsLengthFunc(ctx)
+}
+
+func (mock *MockFactory) CreatePair(ctx context.Context, tokenA address.Address, tokenB address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.CreatePairFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func CreatePair not stubbed")
+		return
+	}
+
+	return mock.CreatePairFunc(ctx, tokenA, tokenB, ops...)
+}
+
+func (mock *MockFactory) FeeTo(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.FeeToFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FeeTo not stubbed")
+		return
+	}
+
+	return mock.FeeToFunc(ctx)
+}
+
+func (mock *MockFactory) FeeToSetter(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.FeeToSetterFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FeeToSetter not stubbed")
+		return
+	}
+
+	return mock.FeeToSetterFunc(ctx)
+}
+
+func (mock *MockFactory) GetPair(ctx context.Context, tokenA address.Address, tokenB address.Address) (pair address.Address, err error) {
+	if mock.GetPairFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetPair not stubbed")
+		return
+	}
+
+	return mock.GetPairFunc(ctx, tokenA, tokenB)
+}
+
+func (mock *MockFactory) SetFeeTo(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SetFeeToFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SetFeeTo not stubbed")
+		return
+	}
+
+	return mock.SetFeeToFunc(ctx, param0, ops...)
+}
+
+func (mock *MockFactory) SetFeeToSetter(ctx context.Context, param0 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SetFeeToSetterFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SetFeeToSetter not stubbed")
+		return
+	}
+
+	return mock.SetFeeToSetterFunc(ctx, param0, ops...)
+}
+
+func (mock *MockFactory) FilterPairCreated(ctx context.Context, opts *binding.FilterOpts, token0 []address.Address, token1 []address.Address) (events []*FactoryPairCreatedEvent, err error) {
+	if mock.FilterPairCreatedFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterPairCreated not stubbed")
+		return
+	}
+
+	return mock.FilterPairCreatedFunc(ctx, opts, token0, token1)
+}
+
+func (mock *MockFactory) WatchPairCreated(ctx context.Context, opts *binding.WatchOpts, sink chan<- *FactoryPairCreatedEvent, token0 []address.Address, token1 []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchPairCreatedFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchPairCreated not stubbed")
+		return
+	}
+
+	return mock.WatchPairCreatedFunc(ctx, opts, sink, token0, token1)
+}
+
+// PairCaller view/pure funcs of contract Pair
+type PairCaller interface {
+	DOMAINSEPARATOR(ctx context.Context) (ret0 [32]byte, err error)
+	MINIMUMLIQUIDITY(ctx context.Context) (ret0 *big.Int, err error)
+	PERMITTYPEHASH(ctx context.Context) (ret0 [32]byte, err error)
+	Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error)
+	BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	Decimals(ctx context.Context) (ret0 *big.Int, err error)
+	Factory(ctx context.Context) (ret0 address.Address, err error)
+	GetReserves(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, blockTimestampLast *big.Int, err error)
+	KLast(ctx context.Context) (ret0 *big.Int, err error)
+	Name(ctx context.Context) (ret0 string, err error)
+	Nonces(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	Price0CumulativeLast(ctx context.Context) (ret0 *big.Int, err error)
+	Price1CumulativeLast(ctx context.Context) (ret0 *big.Int, err error)
+	Symbol(ctx context.Context) (ret0 string, err error)
+	Token0(ctx context.Context) (ret0 address.Address, err error)
+	Token1(ctx context.Context) (ret0 address.Address, err error)
+	TotalSupply(ctx context.Context) (ret0 *big.Int, err error)
+}
+
+// PairTransactor state-changing funcs of contract Pair
+type PairTransactor interface {
+	Approve(ctx context.Context, spender address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Burn(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Initialize(ctx context.Context, param0 address.Address, param1 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Mint(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Permit(ctx context.Context, owner address.Address, spender address.Address, value *big.Int, deadline *big.Int, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Skim(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Swap(ctx context.Context, amount0Out *big.Int, amount1Out *big.Int, to address.Address, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Sync(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Transfer(ctx context.Context, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFrom(ctx context.Context, from address.Address, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// PairFilterer event filterers and watchers of contract Pair
+type PairFilterer interface {
+	FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*PairApprovalEvent, err error)
+	WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error)
+	FilterBurn(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairBurnEvent, err error)
+	WatchBurn(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairBurnEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterMint(ctx context.Context, opts *binding.FilterOpts, sender []address.Address) (events []*PairMintEvent, err error)
+	WatchMint(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairMintEvent, sender []address.Address) (sub client.Subscription, err error)
+	FilterSwap(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairSwapEvent, err error)
+	WatchSwap(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSwapEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterSync(ctx context.Context, opts *binding.FilterOpts) (events []*PairSyncEvent, err error)
+	WatchSync(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSyncEvent) (sub client.Subscription, err error)
+	FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*PairTransferEvent, err error)
+	WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+}
+
+// Pair contract Pair binding interface
+type Pair interface {
+	PairCaller
+	PairTransactor
+	PairFilterer
+}
+
+// PairApprovalEvent event Approval(address,address,uint256) of contract Pair
+type PairApprovalEvent struct {
+	Owner   address.Address
+	Spender address.Address
+	Value   *big.Int
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// PairBurnEvent event Burn(address,uint256,uint256,address) of contract Pair
+type PairBurnEvent struct {
+	Sender  address.Address
+	Amount0 *big.Int
+	Amount1 *big.Int
+	To      address.Address
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// PairMintEvent event Mint(address,uint256,uint256) of contract Pair
+type PairMintEvent struct {
+	Sender  address.Address
+	Amount0 *big.Int
+	Amount1 *big.Int
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// PairSwapEvent event Swap(address,uint256,uint256,uint256,uint256,address) of contract Pair
+type PairSwapEvent struct {
+	Sender     address.Address
+	Amount0In  *big.Int
+	Amount1In  *big.Int
+	Amount0Out *big.Int
+	Amount1Out *big.Int
+	To         address.Address
+	Raw        *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// PairSyncEvent event Sync(uint112,uint112) of contract Pair
+type PairSyncEvent struct {
+	Reserve0 *big.Int
+	Reserve1 *big.Int
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// PairTransferEvent event Transfer(address,address,uint256) of contract Pair
+type PairTransferEvent struct {
+	From  address.Address
+	To    address.Address
+	Value *big.Int
+	Raw   *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// PairABI json abi of contract Pair
+const PairABI = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"owner","type":"address"},{"indexed":true,"internalType":"address","name":"spender","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Approval","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"},{"indexed":true,"internalType":"address","name":"to","type":"address"}],"name":"Burn","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1","type":"uint256"}],"name":"Mint","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"sender","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount0In","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1In","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount0Out","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"amount1Out","type":"uint256"},{"indexed":true,"internalType":"address","name":"to","type":"address"}],"name":"Swap","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint112","name":"reserve0","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"reserve1","type":"uint112"}],"name":"Sync","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"from","type":"address"},{"indexed":true,"internalType":"address","name":"to","type":"address"},{"indexed":false,"internalType":"uint256","name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"inputs":[],"name":"DOMAIN_SEPARATOR","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"MINIMUM_LIQUIDITY","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[],"name":"PERMIT_TYPEHASH","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"spender","type":"address"}],"name":"allowance","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"}],"name":"approve","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"}],"name":"burn","outputs":[{"internalType":"uint256","name":"amount0","type":"uint256"},{"internalType":"uint256","name":"amount1","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"decimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"pure","type":"function"},{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"getReserves","outputs":[{"internalType":"uint112","name":"reserve0","type":"uint112"},{"internalType":"uint112","name":"reserve1","type":"uint112"},{"internalType":"uint32","name":"blockTimestampLast","type":"uint32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"},{"internalType":"address","name":"","type":"address"}],"name":"initialize","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"kLast","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"}],"name":"mint","outputs":[{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"nonces","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"permit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"price0CumulativeLast","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"price1CumulativeLast","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"}],"name":"skim","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amount0Out","type":"uint256"},{"internalType":"uint256","name":"amount1Out","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"swap","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"symbol","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"pure","type":"function"},{"inputs":[],"name":"sync","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"token0","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"token1","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"}],"name":"transfer","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"}],"name":"transferFrom","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+var (
+	parsedPairOnce sync.Once
+	parsedPair     abi.Contract
+	parsedPairErr  error
+)
+
+// PairContract returns the abi.Contract of PairABI, which is parsed once on first use
+func PairContract() (abi.Contract, error) {
+	parsedPairOnce.Do(func() {
+		parsedPair, parsedPairErr = binding.Parse("Pair", []byte(PairABI), binding.NewSymbols())
+	})
+
+	return parsedPair, parsedPairErr
+}
+
+// NewPair create Pair binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewPair(recipient address.Address, provider client.Provider, signer signer.Signer) (*PairImpl, error) {
+	contract, err := PairContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPairImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewPairCaller create PairCaller of contract deployed at recipient
+func NewPairCaller(recipient address.Address, provider client.Provider) (*PairCallerImpl, error) {
+	contract, err := PairContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &PairCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewPairFilterer create PairFilterer of contract deployed at recipient
+func NewPairFilterer(recipient address.Address, provider client.Provider) (*PairFiltererImpl, error) {
+	contract, err := PairContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &PairFiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// PairCallerImpl PairCaller implementation calling contract via provider
+type PairCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// PairTransactorImpl PairTransactor implementation sending signed transactions via provider
+type PairTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// PairFiltererImpl PairFilterer implementation querying and subscribing logs via provider
+type PairFiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// PairImpl Pair implementation
+type PairImpl struct {
+	*PairCallerImpl
+	*PairTransactorImpl
+	*PairFiltererImpl
+}
+
+// NewPairImpl create Pair implementation of contract deployed at recipient
+func NewPairImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *PairImpl {
+	return &PairImpl{
+		PairCallerImpl: &PairCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		PairTransactorImpl: &PairTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		PairFiltererImpl: &PairFiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ Pair = (*PairImpl)(nil)
+
+func (impl *PairCallerImpl) DOMAINSEPARATOR(ctx context.Context) (ret0 [32]byte, err error) {
+	f, ok := impl.Contract.Select("3644e515")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func DOMAINSEPARATOR not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) MINIMUMLIQUIDITY(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("ba9a7a56")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func MINIMUMLIQUIDITY not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) PERMITTYPEHASH(ctx context.Context) (ret0 [32]byte, err error) {
+	f, ok := impl.Contract.Select("30adf81f")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func PERMITTYPEHASH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("dd62ed3e")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Allowance not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("70a08231")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Decimals(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("313ce567")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Decimals not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Factory(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("c45a0155")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Factory not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) GetReserves(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, blockTimestampLast *big.Int, err error) {
+	f, ok := impl.Contract.Select("0902f1ac")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetReserves not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&reserve0, &reserve1, &blockTimestampLast})
+
+	return
+}
+
+func (impl *PairCallerImpl) KLast(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("7464fc3d")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func KLast not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Name(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("06fdde03")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Name not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Nonces(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("7ecebe00")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Nonces not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Price0CumulativeLast(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("5909c0d5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Price0CumulativeLast not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Price1CumulativeLast(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("5a3d5493")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Price1CumulativeLast not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Symbol(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("95d89b41")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Symbol not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Token0(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("0dfe1681")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Token0 not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) Token1(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("d21220a7")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Token1 not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairCallerImpl) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("18160ddd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TotalSupply not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *PairTransactorImpl) Approve(ctx context.Context, spender address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("095ea7b3")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Approve not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(spender, value)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Burn(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("89afcb44")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Burn not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Initialize(ctx context.Context, param0 address.Address, param1 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("485cc955")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Initialize not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(param0, param1)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Mint(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("6a627842")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Mint not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Permit(ctx context.Context, owner address.Address, spender address.Address, value *big.Int, deadline *big.Int, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("d505accf")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Permit not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner, spender, value, deadline, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Skim(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("bc25cf77")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Skim not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Swap(ctx context.Context, amount0Out *big.Int, amount1Out *big.Int, to address.Address, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("022c0d9f")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Swap not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amount0Out, amount1Out, to, data)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Sync(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("fff6cae9")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Sync not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) Transfer(ctx context.Context, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a9059cbb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Transfer not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to, value)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *PairTransactorImpl) TransferFrom(ctx context.Context, from address.Address, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("23b872dd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, value)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterApproval returns Approval events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *PairFiltererImpl) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*PairApprovalEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &PairApprovalEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchApproval stream Approval events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *PairFiltererImpl) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &PairApprovalEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterBurn returns Burn events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *PairFiltererImpl) FilterBurn(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairBurnEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("dccd412f0b1252819cb1fd330b93224ca42612892bb3f4f789976e6d81936496")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Burn not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(sender, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &PairBurnEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Sender, &event.Amount0, &event.Amount1, &event.To}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchBurn stream Burn events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *PairFiltererImpl) WatchBurn(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairBurnEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("dccd412f0b1252819cb1fd330b93224ca42612892bb3f4f789976e6d81936496")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Burn not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(sender, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &PairBurnEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Sender, &event.Amount0, &event.Amount1, &event.To}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterMint returns Mint events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *PairFiltererImpl) FilterMint(ctx context.Context, opts *binding.FilterOpts, sender []address.Address) (events []*PairMintEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("4c209b5fc8ad50758f13e2e1088ba56a560dff690a1c6fef26394f4c03821c4f")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Mint not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(sender)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &PairMintEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Sender, &event.Amount0, &event.Amount1}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchMint stream Mint events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *PairFiltererImpl) WatchMint(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairMintEvent, sender []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("4c209b5fc8ad50758f13e2e1088ba56a560dff690a1c6fef26394f4c03821c4f")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Mint not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(sender)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &PairMintEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Sender, &event.Amount0, &event.Amount1}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterSwap returns Swap events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *PairFiltererImpl) FilterSwap(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairSwapEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("d78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Swap not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(sender, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &PairSwapEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Sender, &event.Amount0In, &event.Amount1In, &event.Amount0Out, &event.Amount1Out, &event.To}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchSwap stream Swap events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *PairFiltererImpl) WatchSwap(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSwapEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("d78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Swap not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(sender, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &PairSwapEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Sender, &event.Amount0In, &event.Amount1In, &event.Amount0Out, &event.Amount1Out, &event.To}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterSync returns Sync events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *PairFiltererImpl) FilterSync(ctx context.Context, opts *binding.FilterOpts) (events []*PairSyncEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Sync not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics()
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &PairSyncEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Reserve0, &event.Reserve1}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchSync stream Sync events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *PairFiltererImpl) WatchSync(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSyncEvent) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Sync not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics()
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &PairSyncEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Reserve0, &event.Reserve1}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterTransfer returns Transfer events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *PairFiltererImpl) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*PairTransferEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &PairTransferEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransfer stream Transfer events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *PairFiltererImpl) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &PairTransferEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockPair in-memory Pair implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockPair struct {
+	DOMAINSEPARATORFunc      func(ctx context.Context) (ret0 [32]byte, err error)
+	MINIMUMLIQUIDITYFunc     func(ctx context.Context) (ret0 *big.Int, err error)
+	PERMITTYPEHASHFunc       func(ctx context.Context) (ret0 [32]byte, err error)
+	AllowanceFunc            func(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error)
+	ApproveFunc              func(ctx context.Context, spender address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	BalanceOfFunc            func(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	BurnFunc                 func(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	DecimalsFunc             func(ctx context.Context) (ret0 *big.Int, err error)
+	FactoryFunc              func(ctx context.Context) (ret0 address.Address, err error)
+	GetReservesFunc          func(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, blockTimestampLast *big.Int, err error)
+	InitializeFunc           func(ctx context.Context, param0 address.Address, param1 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	KLastFunc                func(ctx context.Context) (ret0 *big.Int, err error)
+	MintFunc                 func(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	NameFunc                 func(ctx context.Context) (ret0 string, err error)
+	NoncesFunc               func(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	PermitFunc               func(ctx context.Context, owner address.Address, spender address.Address, value *big.Int, deadline *big.Int, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Price0CumulativeLastFunc func(ctx context.Context) (ret0 *big.Int, err error)
+	Price1CumulativeLastFunc func(ctx context.Context) (ret0 *big.Int, err error)
+	SkimFunc                 func(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapFunc                 func(ctx context.Context, amount0Out *big.Int, amount1Out *big.Int, to address.Address, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SymbolFunc               func(ctx context.Context) (ret0 string, err error)
+	SyncFunc                 func(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Token0Func               func(ctx context.Context) (ret0 address.Address, err error)
+	Token1Func               func(ctx context.Context) (ret0 address.Address, err error)
+	TotalSupplyFunc          func(ctx context.Context) (ret0 *big.Int, err error)
+	TransferFunc             func(ctx context.Context, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFromFunc         func(ctx context.Context, from address.Address, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FilterApprovalFunc       func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*PairApprovalEvent, err error)
+	WatchApprovalFunc        func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error)
+	FilterBurnFunc           func(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairBurnEvent, err error)
+	WatchBurnFunc            func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairBurnEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterMintFunc           func(ctx context.Context, opts *binding.FilterOpts, sender []address.Address) (events []*PairMintEvent, err error)
+	WatchMintFunc            func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairMintEvent, sender []address.Address) (sub client.Subscription, err error)
+	FilterSwapFunc           func(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairSwapEvent, err error)
+	WatchSwapFunc            func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSwapEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterSyncFunc           func(ctx context.Context, opts *binding.FilterOpts) (events []*PairSyncEvent, err error)
+	WatchSyncFunc            func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSyncEvent) (sub client.Subscription, err error)
+	FilterTransferFunc       func(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*PairTransferEvent, err error)
+	WatchTransferFunc        func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+}
+
+var _ Pair = (*MockPair)(nil)
+
+func (mock *MockPair) DOMAINSEPARATOR(ctx context.Context) (ret0 [32]byte, err error) {
+	if mock.DOMAINSEPARATORFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func DOMAINSEPARATOR not stubbed")
+		return
+	}
+
+	return mock.DOMAINSEPARATORFunc(ctx)
+}
+
+func (mock *MockPair) MINIMUMLIQUIDITY(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.MINIMUMLIQUIDITYFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func MINIMUMLIQUIDITY not stubbed")
+		return
+	}
+
+	return mock.MINIMUMLIQUIDITYFunc(ctx)
+}
+
+func (mock *MockPair) PERMITTYPEHASH(ctx context.Context) (ret0 [32]byte, err error) {
+	if mock.PERMITTYPEHASHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func PERMITTYPEHASH not stubbed")
+		return
+	}
+
+	return mock.PERMITTYPEHASHFunc(ctx)
+}
+
+func (mock *MockPair) Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error) {
+	if mock.AllowanceFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Allowance not stubbed")
+		return
+	}
+
+	return mock.AllowanceFunc(ctx, owner, spender)
+}
+
+func (mock *MockPair) Approve(ctx context.Context, spender address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.ApproveFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Approve not stubbed")
+		return
+	}
+
+	return mock.ApproveFunc(ctx, spender, value, ops...)
+}
+
+func (mock *MockPair) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, owner)
+}
+
+func (mock *MockPair) Burn(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.BurnFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Burn not stubbed")
+		return
+	}
+
+	return mock.BurnFunc(ctx, to, ops...)
+}
+
+func (mock *MockPair) Decimals(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.DecimalsFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Decimals not stubbed")
+		return
+	}
+
+	return mock.DecimalsFunc(ctx)
+}
+
+func (mock *MockPair) Factory(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.FactoryFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Factory not stubbed")
+		return
+	}
+
+	return mock.FactoryFunc(ctx)
+}
+
+func (mock *MockPair) GetReserves(ctx context.Context) (reserve0 *big.Int, reserve1 *big.Int, blockTimestampLast *big.Int, err error) {
+	if mock.GetReservesFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetReserves not stubbed")
+		return
+	}
+
+	return mock.GetReservesFunc(ctx)
+}
+
+func (mock *MockPair) Initialize(ctx context.Context, param0 address.Address, param1 address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.InitializeFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Initialize not stubbed")
+		return
+	}
+
+	return mock.InitializeFunc(ctx, param0, param1, ops...)
+}
+
+func (mock *MockPair) KLast(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.KLastFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func KLast not stubbed")
+		return
+	}
+
+	return mock.KLastFunc(ctx)
+}
+
+func (mock *MockPair) Mint(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.MintFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Mint not stubbed")
+		return
+	}
+
+	return mock.MintFunc(ctx, to, ops...)
+}
+
+func (mock *MockPair) Name(ctx context.Context) (ret0 string, err error) {
+	if mock.NameFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Name not stubbed")
+		return
+	}
+
+	return mock.NameFunc(ctx)
+}
+
+func (mock *MockPair) Nonces(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	if mock.NoncesFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Nonces not stubbed")
+		return
+	}
+
+	return mock.NoncesFunc(ctx, owner)
+}
+
+func (mock *MockPair) Permit(ctx context.Context, owner address.Address, spender address.Address, value *big.Int, deadline *big.Int, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.PermitFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Permit not stubbed")
+		return
+	}
+
+	return mock.PermitFunc(ctx, owner, spender, value, deadline, v, r, s, ops...)
+}
+
+func (mock *MockPair) Price0CumulativeLast(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.Price0CumulativeLastFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Price0CumulativeLast not stubbed")
+		return
+	}
+
+	return mock.Price0CumulativeLastFunc(ctx)
+}
+
+func (mock *MockPair) Price1CumulativeLast(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.Price1CumulativeLastFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Price1CumulativeLast not stubbed")
+		return
+	}
+
+	return mock.Price1CumulativeLastFunc(ctx)
+}
+
+func (mock *MockPair) Skim(ctx context.Context, to address.Address, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SkimFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Skim not stubbed")
+		return
+	}
+
+	return mock.SkimFunc(ctx, to, ops...)
+}
+
+func (mock *MockPair) Swap(ctx context.Context, amount0Out *big.Int, amount1Out *big.Int, to address.Address, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Swap not stubbed")
+		return
+	}
+
+	return mock.SwapFunc(ctx, amount0Out, amount1Out, to, data, ops...)
+}
+
+func (mock *MockPair) Symbol(ctx context.Context) (ret0 string, err error) {
+	if mock.SymbolFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Symbol not stubbed")
+		return
+	}
+
+	return mock.SymbolFunc(ctx)
+}
+
+func (mock *MockPair) Sync(ctx context.Context, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SyncFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Sync not stubbed")
+		return
+	}
+
+	return mock.SyncFunc(ctx, ops...)
+}
+
+func (mock *MockPair) Token0(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.Token0Func == nil {
+		err = errors.Wrap(binding.ErrMock, "func Token0 not stubbed")
+		return
+	}
+
+	return mock.Token0Func(ctx)
+}
+
+func (mock *MockPair) Token1(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.Token1Func == nil {
+		err = errors.Wrap(binding.ErrMock, "func Token1 not stubbed")
+		return
+	}
+
+	return mock.Token1Func(ctx)
+}
+
+func (mock *MockPair) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.TotalSupplyFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TotalSupply not stubbed")
+		return
+	}
+
+	return mock.TotalSupplyFunc(ctx)
+}
+
+func (mock *MockPair) Transfer(ctx context.Context, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Transfer not stubbed")
+		return
+	}
+
+	return mock.TransferFunc(ctx, to, value, ops...)
+}
+
+func (mock *MockPair) TransferFrom(ctx context.Context, from address.Address, to address.Address, value *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferFrom not stubbed")
+		return
+	}
+
+	return mock.TransferFromFunc(ctx, from, to, value, ops...)
+}
+
+func (mock *MockPair) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*PairApprovalEvent, err error) {
+	if mock.FilterApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApproval not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalFunc(ctx, opts, owner, spender)
+}
+
+func (mock *MockPair) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApproval not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalFunc(ctx, opts, sink, owner, spender)
+}
+
+func (mock *MockPair) FilterBurn(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairBurnEvent, err error) {
+	if mock.FilterBurnFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterBurn not stubbed")
+		return
+	}
+
+	return mock.FilterBurnFunc(ctx, opts, sender, to)
+}
+
+func (mock *MockPair) WatchBurn(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairBurnEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchBurnFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchBurn not stubbed")
+		return
+	}
+
+	return mock.WatchBurnFunc(ctx, opts, sink, sender, to)
+}
+
+func (mock *MockPair) FilterMint(ctx context.Context, opts *binding.FilterOpts, sender []address.Address) (events []*PairMintEvent, err error) {
+	if mock.FilterMintFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterMint not stubbed")
+		return
+	}
+
+	return mock.FilterMintFunc(ctx, opts, sender)
+}
+
+func (mock *MockPair) WatchMint(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairMintEvent, sender []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchMintFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchMint not stubbed")
+		return
+	}
+
+	return mock.WatchMintFunc(ctx, opts, sink, sender)
+}
+
+func (mock *MockPair) FilterSwap(ctx context.Context, opts *binding.FilterOpts, sender []address.Address, to []address.Address) (events []*PairSwapEvent, err error) {
+	if mock.FilterSwapFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterSwap not stubbed")
+		return
+	}
+
+	return mock.FilterSwapFunc(ctx, opts, sender, to)
+}
+
+func (mock *MockPair) WatchSwap(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSwapEvent, sender []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchSwapFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchSwap not stubbed")
+		return
+	}
+
+	return mock.WatchSwapFunc(ctx, opts, sink, sender, to)
+}
+
+func (mock *MockPair) FilterSync(ctx context.Context, opts *binding.FilterOpts) (events []*PairSyncEvent, err error) {
+	if mock.FilterSyncFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterSync not stubbed")
+		return
+	}
+
+	return mock.FilterSyncFunc(ctx, opts)
+}
+
+func (mock *MockPair) WatchSync(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairSyncEvent) (sub client.Subscription, err error) {
+	if mock.WatchSyncFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchSync not stubbed")
+		return
+	}
+
+	return mock.WatchSyncFunc(ctx, opts, sink)
+}
+
+func (mock *MockPair) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*PairTransferEvent, err error) {
+	if mock.FilterTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransfer not stubbed")
+		return
+	}
+
+	return mock.FilterTransferFunc(ctx, opts, from, to)
+}
+
+func (mock *MockPair) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *PairTransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransfer not stubbed")
+		return
+	}
+
+	return mock.WatchTransferFunc(ctx, opts, sink, from, to)
+}
+
+// RouterCaller view/pure funcs of contract Router
+type RouterCaller interface {
+	WETH(ctx context.Context) (ret0 address.Address, err error)
+	Factory(ctx context.Context) (ret0 address.Address, err error)
+	GetAmountIn(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error)
+	GetAmountOut(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error)
+	GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	Quote(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error)
+}
+
+// RouterTransactor state-changing funcs of contract Router
+type RouterTransactor interface {
+	AddLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	AddLiquidityETH(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETH(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermit(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityWithPermit(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETHSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// Router contract Router binding interface
+type Router interface {
+	RouterCaller
+	RouterTransactor
+}
+
+// RouterABI json abi of contract Router
+const RouterABI = `[{"inputs":[],"name":"WETH","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"amountADesired","type":"uint256"},{"internalType":"uint256","name":"amountBDesired","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"addLiquidity","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"},{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"amountTokenDesired","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"addLiquidityETH","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"},{"internalType":"uint256","name":"liquidity","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"factory","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"reserveIn","type":"uint256"},{"internalType":"uint256","name":"reserveOut","type":"uint256"}],"name":"getAmountIn","outputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"reserveIn","type":"uint256"},{"internalType":"uint256","name":"reserveOut","type":"uint256"}],"name":"getAmountOut","outputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"}],"name":"getAmountsIn","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"}],"name":"getAmountsOut","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"reserveA","type":"uint256"},{"internalType":"uint256","name":"reserveB","type":"uint256"}],"name":"quote","outputs":[{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"pure","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidity","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidityETH","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"removeLiquidityETHSupportingFeeOnTransferTokens","outputs":[{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bool","name":"approveMax","type":"bool"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"removeLiquidityETHWithPermit","outputs":[{"internalType":"uint256","name":"amountToken","type":"uint256"},{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"token","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountTokenMin","type":"uint256"},{"internalType":"uint256","name":"amountETHMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bool","name":"approveMax","type":"bool"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"removeLiquidityETHWithPermitSupportingFeeOnTransferTokens","outputs":[{"internalType":"uint256","name":"amountETH","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"tokenA","type":"address"},{"internalType":"address","name":"tokenB","type":"address"},{"internalType":"uint256","name":"liquidity","type":"uint256"},{"internalType":"uint256","name":"amountAMin","type":"uint256"},{"internalType":"uint256","name":"amountBMin","type":"uint256"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bool","name":"approveMax","type":"bool"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"removeLiquidityWithPermit","outputs":[{"internalType":"uint256","name":"amountA","type":"uint256"},{"internalType":"uint256","name":"amountB","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapETHForExactTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactETHForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactETHForTokensSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForETH","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForETHSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountIn","type":"uint256"},{"internalType":"uint256","name":"amountOutMin","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokensSupportingFeeOnTransferTokens","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMax","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapTokensForExactETH","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"amountOut","type":"uint256"},{"internalType":"uint256","name":"amountInMax","type":"uint256"},{"internalType":"address[]","name":"path","type":"address[]"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"deadline","type":"uint256"}],"name":"swapTokensForExactTokens","outputs":[{"internalType":"uint256[]","name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"}]`
+
+var (
+	parsedRouterOnce sync.Once
+	parsedRouter     abi.Contract
+	parsedRouterErr  error
+)
+
+// RouterContract returns the abi.Contract of RouterABI, which is parsed once on first use
+func RouterContract() (abi.Contract, error) {
+	parsedRouterOnce.Do(func() {
+		parsedRouter, parsedRouterErr = binding.Parse("Router", []byte(RouterABI), binding.NewSymbols())
+	})
+
+	return parsedRouter, parsedRouterErr
+}
+
+// NewRouter create Router binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewRouter(recipient address.Address, provider client.Provider, signer signer.Signer) (*RouterImpl, error) {
+	contract, err := RouterContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRouterImpl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewRouterCaller create RouterCaller of contract deployed at recipient
+func NewRouterCaller(recipient address.Address, provider client.Provider) (*RouterCallerImpl, error) {
+	contract, err := RouterContract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouterCallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// RouterCallerImpl RouterCaller implementation calling contract via provider
+type RouterCallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// RouterTransactorImpl RouterTransactor implementation sending signed transactions via provider
+type RouterTransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// RouterImpl Router implementation
+type RouterImpl struct {
+	*RouterCallerImpl
+	*RouterTransactorImpl
+}
+
+// NewRouterImpl create Router implementation of contract deployed at recipient
+func NewRouterImpl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *RouterImpl {
+	return &RouterImpl{
+		RouterCallerImpl: &RouterCallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		RouterTransactorImpl: &RouterTransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ Router = (*RouterImpl)(nil)
+
+func (impl *RouterCallerImpl) WETH(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("ad5c4648")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func WETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *RouterCallerImpl) Factory(ctx context.Context) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("c45a0155")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Factory not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *RouterCallerImpl) GetAmountIn(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error) {
+	f, ok := impl.Contract.Select("85f8c259")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountIn not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, reserveIn, reserveOut)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amountIn})
+
+	return
+}
+
+func (impl *RouterCallerImpl) GetAmountOut(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error) {
+	f, ok := impl.Contract.Select("054d50d4")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountOut not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, reserveIn, reserveOut)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amountOut})
+
+	return
+}
+
+func (impl *RouterCallerImpl) GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	f, ok := impl.Contract.Select("1f00ca74")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountsIn not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, path)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amounts})
+
+	return
+}
+
+func (impl *RouterCallerImpl) GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	f, ok := impl.Contract.Select("d06ca61f")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetAmountsOut not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, path)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amounts})
+
+	return
+}
+
+func (impl *RouterCallerImpl) Quote(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error) {
+	f, ok := impl.Contract.Select("ad615dec")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Quote not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountA, reserveA, reserveB)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&amountB})
+
+	return
+}
+
+func (impl *RouterTransactorImpl) AddLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("e8e33700")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func AddLiquidity not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB, amountADesired, amountBDesired, amountAMin, amountBMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) AddLiquidityETH(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("f305d719")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func AddLiquidityETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, amountTokenDesired, amountTokenMin, amountETHMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) RemoveLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("baa2abde")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidity not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) RemoveLiquidityETH(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("02751cec")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) RemoveLiquidityETHSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("af2979eb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETHSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) RemoveLiquidityETHWithPermit(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("ded9382a")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETHWithPermit not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("5b0d5984")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) RemoveLiquidityWithPermit(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("2195995c")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func RemoveLiquidityWithPermit not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline, approveMax, v, r, s)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("fb3bdb41")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapETHForExactTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapExactETHForTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("7ff36ab5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactETHForTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapExactETHForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("b6f9de95")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactETHForTokensSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("18cbafe5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapExactTokensForETHSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("791ac947")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForETHSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("38ed1739")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapExactTokensForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("5c11d795")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapExactTokensForTokensSupportingFeeOnTransferTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountIn, amountOutMin, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("4a25d94a")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapTokensForExactETH not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, amountInMax, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *RouterTransactorImpl) SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("8803dbee")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SwapTokensForExactTokens not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(amountOut, amountInMax, path, to, deadline)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// MockRouter in-memory Router implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockRouter struct {
+	WETHFunc                                                      func(ctx context.Context) (ret0 address.Address, err error)
+	AddLiquidityFunc                                              func(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	AddLiquidityETHFunc                                           func(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FactoryFunc                                                   func(ctx context.Context) (ret0 address.Address, err error)
+	GetAmountInFunc                                               func(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error)
+	GetAmountOutFunc                                              func(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error)
+	GetAmountsInFunc                                              func(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	GetAmountsOutFunc                                             func(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error)
+	QuoteFunc                                                     func(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error)
+	RemoveLiquidityFunc                                           func(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHFunc                                        func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHSupportingFeeOnTransferTokensFunc           func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermitFunc                              func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensFunc func(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	RemoveLiquidityWithPermitFunc                                 func(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapETHForExactTokensFunc                                     func(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokensFunc                                     func(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactETHForTokensSupportingFeeOnTransferTokensFunc        func(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETHFunc                                     func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForETHSupportingFeeOnTransferTokensFunc        func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokensFunc                                  func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapExactTokensForTokensSupportingFeeOnTransferTokensFunc     func(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactETHFunc                                     func(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SwapTokensForExactTokensFunc                                  func(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+var _ Router = (*MockRouter)(nil)
+
+func (mock *MockRouter) WETH(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.WETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WETH not stubbed")
+		return
+	}
+
+	return mock.WETHFunc(ctx)
+}
+
+func (mock *MockRouter) AddLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, amountADesired *big.Int, amountBDesired *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.AddLiquidityFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func AddLiquidity not stubbed")
+		return
+	}
+
+	return mock.AddLiquidityFunc(ctx, tokenA, tokenB, amountADesired, amountBDesired, amountAMin, amountBMin, to, deadline, ops...)
+}
+
+func (mock *MockRouter) AddLiquidityETH(ctx context.Context, token address.Address, amountTokenDesired *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.AddLiquidityETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func AddLiquidityETH not stubbed")
+		return
+	}
+
+	return mock.AddLiquidityETHFunc(ctx, token, amountTokenDesired, amountTokenMin, amountETHMin, to, deadline, ops...)
+}
+
+func (mock *MockRouter) Factory(ctx context.Context) (ret0 address.Address, err error) {
+	if mock.FactoryFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Factory not stubbed")
+		return
+	}
+
+	return mock.FactoryFunc(ctx)
+}
+
+func (mock *MockRouter) GetAmountIn(ctx context.Context, amountOut *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountIn *big.Int, err error) {
+	if mock.GetAmountInFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountIn not stubbed")
+		return
+	}
+
+	return mock.GetAmountInFunc(ctx, amountOut, reserveIn, reserveOut)
+}
+
+func (mock *MockRouter) GetAmountOut(ctx context.Context, amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) (amountOut *big.Int, err error) {
+	if mock.GetAmountOutFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountOut not stubbed")
+		return
+	}
+
+	return mock.GetAmountOutFunc(ctx, amountIn, reserveIn, reserveOut)
+}
+
+func (mock *MockRouter) GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	if mock.GetAmountsInFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountsIn not stubbed")
+		return
+	}
+
+	return mock.GetAmountsInFunc(ctx, amountOut, path)
+}
+
+func (mock *MockRouter) GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) (amounts []*big.Int, err error) {
+	if mock.GetAmountsOutFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetAmountsOut not stubbed")
+		return
+	}
+
+	return mock.GetAmountsOutFunc(ctx, amountIn, path)
+}
+
+func (mock *MockRouter) Quote(ctx context.Context, amountA *big.Int, reserveA *big.Int, reserveB *big.Int) (amountB *big.Int, err error) {
+	if mock.QuoteFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Quote not stubbed")
+		return
+	}
+
+	return mock.QuoteFunc(ctx, amountA, reserveA, reserveB)
+}
+
+func (mock *MockRouter) RemoveLiquidity(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidity not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityFunc(ctx, tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline, ops...)
+}
+
+func (mock *MockRouter) RemoveLiquidityETH(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETH not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, ops...)
+}
+
+func (mock *MockRouter) RemoveLiquidityETHSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETHSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHSupportingFeeOnTransferTokensFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, ops...)
+}
+
+func (mock *MockRouter) RemoveLiquidityETHWithPermit(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHWithPermitFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETHWithPermit not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHWithPermitFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s, ops...)
+}
+
+func (mock *MockRouter) RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens(ctx context.Context, token address.Address, liquidity *big.Int, amountTokenMin *big.Int, amountETHMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityETHWithPermitSupportingFeeOnTransferTokensFunc(ctx, token, liquidity, amountTokenMin, amountETHMin, to, deadline, approveMax, v, r, s, ops...)
+}
+
+func (mock *MockRouter) RemoveLiquidityWithPermit(ctx context.Context, tokenA address.Address, tokenB address.Address, liquidity *big.Int, amountAMin *big.Int, amountBMin *big.Int, to address.Address, deadline *big.Int, approveMax bool, v *big.Int, r [32]byte, s [32]byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.RemoveLiquidityWithPermitFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func RemoveLiquidityWithPermit not stubbed")
+		return
+	}
+
+	return mock.RemoveLiquidityWithPermitFunc(ctx, tokenA, tokenB, liquidity, amountAMin, amountBMin, to, deadline, approveMax, v, r, s, ops...)
+}
+
+func (mock *MockRouter) SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapETHForExactTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapETHForExactTokens not stubbed")
+		return
+	}
+
+	return mock.SwapETHForExactTokensFunc(ctx, amountOut, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapExactETHForTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactETHForTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactETHForTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactETHForTokensFunc(ctx, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapExactETHForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactETHForTokensSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactETHForTokensSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactETHForTokensSupportingFeeOnTransferTokensFunc(ctx, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForETH not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForETHFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapExactTokensForETHSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForETHSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForETHSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForETHSupportingFeeOnTransferTokensFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForTokensFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapExactTokensForTokensSupportingFeeOnTransferTokens(ctx context.Context, amountIn *big.Int, amountOutMin *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapExactTokensForTokensSupportingFeeOnTransferTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapExactTokensForTokensSupportingFeeOnTransferTokens not stubbed")
+		return
+	}
+
+	return mock.SwapExactTokensForTokensSupportingFeeOnTransferTokensFunc(ctx, amountIn, amountOutMin, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapTokensForExactETHFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapTokensForExactETH not stubbed")
+		return
+	}
+
+	return mock.SwapTokensForExactETHFunc(ctx, amountOut, amountInMax, path, to, deadline, ops...)
+}
+
+func (mock *MockRouter) SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, amountInMax *big.Int, path []address.Address, to address.Address, deadline *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SwapTokensForExactTokensFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SwapTokensForExactTokens not stubbed")
+		return
+	}
+
+	return mock.SwapTokensForExactTokensFunc(ctx, amountOut, amountInMax, path, to, deadline, ops...)
+}
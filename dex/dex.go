@@ -0,0 +1,368 @@
+// Package dex provides the pre-generated bindings of Uniswap V2 style factory, pair and router,
+// the off-chain amount math mirroring UniswapV2Library with configurable fee, CREATE2 pair address
+// derivation, multi-hop quoting and the swap helpers of tokens and native token applying slippage
+// tolerance and deadline, whose gas limit is estimated unless supplied by abi.WithGasLimits. The
+// fee-on-transfer router variants are not wrapped, send them by Dex.Router with a quoted Trade
+package dex
+
+//go:generate go run ../cmd/abigen -pkg dex -out bindings.go Factory=abi/Factory.json Pair=abi/Pair.json Router=abi/Router.json
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+	"github.com/libs4go/fixed"
+)
+
+// Config dex deployment
+type Config struct {
+	Router       address.Address // router address
+	Factory      address.Address // factory address
+	InitCodeHash []byte          // keccak256 hash of the pair creation code
+	Fee          Fee             // pair swap fee
+}
+
+func mustDecodeHex(s string) []byte {
+	buff, err := hex.DecodeString(s)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return buff
+}
+
+// well known deployments
+var (
+	UniswapV2 = Config{
+		Router:       address.HexToAddress("0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"),
+		Factory:      address.HexToAddress("0x5C69bEe701ef814a2B6a3EDD4B1652CB9cc5aA6f"),
+		InitCodeHash: mustDecodeHex("96e8ac4277198ff8b6f785478aa9a39f403cb768dd02cbee326c3e7da348845f"),
+		Fee:          UniswapV2Fee,
+	}
+
+	PancakeV2 = Config{
+		Router:       address.HexToAddress("0x10ED43C718714eb63d5aA57B78B54704E256024E"),
+		Factory:      address.HexToAddress("0xcA143Ce32Fe78f1f7019d7d551a6402fC5350c73"),
+		InitCodeHash: mustDecodeHex("00fb7f630766e6a796048ea87d01acd3068e8ff67d078148a3fa3f4a84f69bd5"),
+		Fee:          PancakeV2Fee,
+	}
+)
+
+// defaults of Dex
+const (
+	DefaultSlippage Slippage = 50
+	DefaultTTL               = 20 * time.Minute
+)
+
+// Dex quotes by the reserves of pairs and swaps through router
+type Dex struct {
+	Router   *RouterImpl   // generated router binding
+	Config   Config        // deployment
+	Slippage Slippage      // slippage tolerance of swaps
+	TTL      time.Duration // swap deadline is now + TTL
+	provider client.Provider
+}
+
+// New create dex of config deployment, signer can be nil if no swap is sent
+func New(config Config, provider client.Provider, s signer.Signer) (*Dex, error) {
+	router, err := NewRouter(config.Router, provider, s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Dex{
+		Router:   router,
+		Config:   config,
+		Slippage: DefaultSlippage,
+		TTL:      DefaultTTL,
+		provider: provider,
+	}, nil
+}
+
+// PairFor returns the CREATE2 pair address of tokenA and tokenB
+func (dex *Dex) PairFor(tokenA, tokenB address.Address) (address.Address, error) {
+	return PairFor(dex.Config.Factory, dex.Config.InitCodeHash, tokenA, tokenB)
+}
+
+// Pair returns the caller binding of pair of tokenA and tokenB
+func (dex *Dex) Pair(tokenA, tokenB address.Address) (*PairCallerImpl, error) {
+	pair, err := dex.PairFor(tokenA, tokenB)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPairCaller(pair, dex.provider)
+}
+
+// Reserves returns the pair reserves of tokenA and tokenB in the order of arguments
+func (dex *Dex) Reserves(ctx context.Context, tokenA, tokenB address.Address) (reserveA *big.Int, reserveB *big.Int, err error) {
+	pair, err := dex.Pair(tokenA, tokenB)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reserve0, reserve1, _, err := pair.GetReserves(ctx)
+
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrPair, "get reserves of pair %s/%s: %s", tokenA.Hex(), tokenB.Hex(), err)
+	}
+
+	if token0, _, _ := SortTokens(tokenA, tokenB); token0 == tokenA {
+		return reserve0, reserve1, nil
+	}
+
+	return reserve1, reserve0, nil
+}
+
+// Hops returns the reserves of the pairs along path
+func (dex *Dex) Hops(ctx context.Context, path []address.Address) ([]Reserves, error) {
+	if len(path) < 2 {
+		return nil, errors.Wrap(ErrPath, "path length %d", len(path))
+	}
+
+	var hops []Reserves
+
+	for i := 0; i < len(path)-1; i++ {
+		reserveIn, reserveOut, err := dex.Reserves(ctx, path[i], path[i+1])
+
+		if err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, Reserves{In: reserveIn, Out: reserveOut})
+	}
+
+	return hops, nil
+}
+
+// GetAmountsOut returns the amounts along path of exact input amountIn, same as router getAmountsOut
+func (dex *Dex) GetAmountsOut(ctx context.Context, amountIn *big.Int, path []address.Address) ([]*big.Int, error) {
+	hops, err := dex.Hops(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAmountsOut(amountIn, hops, dex.Config.Fee)
+}
+
+// GetAmountsIn returns the amounts along path of exact output amountOut, same as router getAmountsIn
+func (dex *Dex) GetAmountsIn(ctx context.Context, amountOut *big.Int, path []address.Address) ([]*big.Int, error) {
+	hops, err := dex.Hops(ctx, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAmountsIn(amountOut, hops, dex.Config.Fee)
+}
+
+// Deadline returns the unix timestamp ttl later
+func Deadline(ttl time.Duration) *big.Int {
+	return big.NewInt(time.Now().Add(ttl).Unix())
+}
+
+// Trade quoted swap with slippage tolerance and deadline applied
+type Trade struct {
+	Path     []address.Address
+	Amounts  []*big.Int // quoted amounts along path
+	Limit    *big.Int   // amountOutMin of exact input trade or amountInMax of exact output trade
+	Deadline *big.Int
+}
+
+// TradeExactIn quote the swap of exact input amountIn, which can be sent by router swapExact* funcs
+func (dex *Dex) TradeExactIn(ctx context.Context, amountIn *big.Int, path []address.Address) (*Trade, error) {
+	amounts, err := dex.GetAmountsOut(ctx, amountIn, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := dex.Slippage.MinOut(amounts[len(amounts)-1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Trade{Path: path, Amounts: amounts, Limit: limit, Deadline: Deadline(dex.TTL)}, nil
+}
+
+// TradeExactOut quote the swap of exact output amountOut, which can be sent by router swap*ForExact* funcs
+func (dex *Dex) TradeExactOut(ctx context.Context, amountOut *big.Int, path []address.Address) (*Trade, error) {
+	amounts, err := dex.GetAmountsIn(ctx, amountOut, path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := dex.Slippage.MaxIn(amounts[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Trade{Path: path, Amounts: amounts, Limit: limit, Deadline: Deadline(dex.TTL)}, nil
+}
+
+// SwapExactTokensForTokens swap exact amountIn along path to recipient, the output amount is bounded
+// by the slippage tolerance of quote
+func (dex *Dex) SwapExactTokensForTokens(ctx context.Context, amountIn *big.Int, path []address.Address, to address.Address, ops ...abi.Op) (abi.Transaction, *Trade, error) {
+	trade, err := dex.TradeExactIn(ctx, amountIn, path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := dex.swap(ctx, "swapExactTokensForTokens(uint256,uint256,address[],address,uint256)", []interface{}{amountIn, trade.Limit, path, to, trade.Deadline}, nil, ops)
+
+	return tradeTx(tx, trade, err)
+}
+
+// SwapTokensForExactTokens swap along path for exact amountOut to recipient, the input amount is bounded
+// by the slippage tolerance of quote
+func (dex *Dex) SwapTokensForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, ops ...abi.Op) (abi.Transaction, *Trade, error) {
+	trade, err := dex.TradeExactOut(ctx, amountOut, path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := dex.swap(ctx, "swapTokensForExactTokens(uint256,uint256,address[],address,uint256)", []interface{}{amountOut, trade.Limit, path, to, trade.Deadline}, nil, ops)
+
+	return tradeTx(tx, trade, err)
+}
+
+// SwapExactETHForTokens swap exact native amountIn along path starting with WETH to recipient, the
+// output amount is bounded by the slippage tolerance of quote
+func (dex *Dex) SwapExactETHForTokens(ctx context.Context, amountIn *big.Int, path []address.Address, to address.Address, ops ...abi.Op) (abi.Transaction, *Trade, error) {
+	trade, err := dex.TradeExactIn(ctx, amountIn, path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := dex.swap(ctx, "swapExactETHForTokens(uint256,address[],address,uint256)", []interface{}{trade.Limit, path, to, trade.Deadline}, amountIn, ops)
+
+	return tradeTx(tx, trade, err)
+}
+
+// SwapExactTokensForETH swap exact amountIn along path ending with WETH for native token to recipient,
+// the output amount is bounded by the slippage tolerance of quote
+func (dex *Dex) SwapExactTokensForETH(ctx context.Context, amountIn *big.Int, path []address.Address, to address.Address, ops ...abi.Op) (abi.Transaction, *Trade, error) {
+	trade, err := dex.TradeExactIn(ctx, amountIn, path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := dex.swap(ctx, "swapExactTokensForETH(uint256,uint256,address[],address,uint256)", []interface{}{amountIn, trade.Limit, path, to, trade.Deadline}, nil, ops)
+
+	return tradeTx(tx, trade, err)
+}
+
+// SwapETHForExactTokens swap native token along path starting with WETH for exact amountOut to
+// recipient, the slippage bounded input is sent and the unspent is refunded by router
+func (dex *Dex) SwapETHForExactTokens(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, ops ...abi.Op) (abi.Transaction, *Trade, error) {
+	trade, err := dex.TradeExactOut(ctx, amountOut, path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := dex.swap(ctx, "swapETHForExactTokens(uint256,address[],address,uint256)", []interface{}{amountOut, path, to, trade.Deadline}, trade.Limit, ops)
+
+	return tradeTx(tx, trade, err)
+}
+
+// SwapTokensForExactETH swap along path ending with WETH for exact native amountOut to recipient, the
+// input amount is bounded by the slippage tolerance of quote
+func (dex *Dex) SwapTokensForExactETH(ctx context.Context, amountOut *big.Int, path []address.Address, to address.Address, ops ...abi.Op) (abi.Transaction, *Trade, error) {
+	trade, err := dex.TradeExactOut(ctx, amountOut, path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := dex.swap(ctx, "swapTokensForExactETH(uint256,uint256,address[],address,uint256)", []interface{}{amountOut, trade.Limit, path, to, trade.Deadline}, nil, ops)
+
+	return tradeTx(tx, trade, err)
+}
+
+func tradeTx(tx abi.Transaction, trade *Trade, err error) (abi.Transaction, *Trade, error) {
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tx, trade, nil
+}
+
+// swap send router call with native value, the gas limit is estimated unless the caller supplies one
+func (dex *Dex) swap(ctx context.Context, signature string, args []interface{}, value *big.Int, ops []abi.Op) (abi.Transaction, error) {
+	s := dex.Router.Signer
+
+	if s == nil {
+		return nil, errors.Wrap(ErrSigner, "send %s to router %s", signature, dex.Config.Router.Hex())
+	}
+
+	f, ok := abi.TryGetFunc(dex.Router.RouterTransactorImpl.Contract, signature)
+
+	if !ok {
+		return nil, errors.Wrap(binding.ErrBinding, "func %s not found", signature)
+	}
+
+	data, err := f.Call(args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if value != nil {
+		ops = append(ops[:len(ops):len(ops)], abi.WithAmount(&fixed.Number{RawValue: value, Decimals: 18}))
+	}
+
+	requested := &abi.CallOps{}
+
+	for _, op := range ops {
+		op(requested)
+	}
+
+	if requested.GasLimit == nil {
+		callsite := &client.CallSite{
+			From: s.Addresss(),
+			To:   dex.Config.Router.Hex(),
+			Data: "0x" + hex.EncodeToString(data),
+		}
+
+		if requested.Amount != nil {
+			callsite.Value = "0x" + requested.Amount.Text(16)
+		}
+
+		gas, err := dex.provider.EstimateGas(ctx, callsite)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "estimate gas of %s error", signature)
+		}
+
+		ops = append([]abi.Op{abi.WithGasLimits(gas)}, ops...)
+	}
+
+	callOps, err := abi.MakeCallOps(ctx, dex.provider, s, ops)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.MakeTransaction(ctx, dex.provider, s, callOps, dex.Config.Router.Hex(), data)
+}
@@ -0,0 +1,172 @@
+package dex
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/ethers/signer"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tokenA = address.HexToAddress("0x1111111111111111111111111111111111111111")
+	tokenB = address.HexToAddress("0x3333333333333333333333333333333333333333")
+	tokenC = address.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+// handleReserves register getReserves of pair tokenA/tokenB returning the reserves in argument order
+func handleReserves(t *testing.T, provider *clienttest.Provider, dex *Dex, tokenA, tokenB address.Address, reserveA, reserveB *big.Int) {
+	pair, err := dex.PairFor(tokenA, tokenB)
+
+	require.NoError(t, err)
+
+	if token0, _, _ := SortTokens(tokenA, tokenB); token0 != tokenA {
+		reserveA, reserveB = reserveB, reserveA
+	}
+
+	provider.HandleCall(pair.Hex(), "getReserves()", func(data []byte) ([]byte, error) {
+		var buff []byte
+
+		for _, v := range []*big.Int{reserveA, reserveB, big.NewInt(time.Now().Unix())} {
+			buff = append(buff, v.FillBytes(make([]byte, 32))...)
+		}
+
+		return buff, nil
+	})
+}
+
+func newTestDex(t *testing.T) (*Dex, *clienttest.Provider) {
+	s, err := signer.OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	provider := clienttest.New(56)
+
+	dex, err := New(PancakeV2, provider, s)
+
+	require.NoError(t, err)
+
+	handleReserves(t, provider, dex, tokenA, tokenB, amount(t, "5000000000000000000000"), amount(t, "12345678000000"))
+	handleReserves(t, provider, dex, tokenB, tokenC, amount(t, "24691356000000"), amount(t, "3000000000000000000000"))
+
+	return dex, provider
+}
+
+func TestQuotePath(t *testing.T) {
+	dex, _ := newTestDex(t)
+
+	reserveB, reserveA, err := dex.Reserves(context.Background(), tokenB, tokenA)
+
+	require.NoError(t, err)
+	require.Equal(t, "12345678000000", reserveB.String())
+	require.Equal(t, "5000000000000000000000", reserveA.String())
+
+	amounts, err := dex.GetAmountsOut(context.Background(), amount(t, "1000000000000000000"), []address.Address{tokenA, tokenB, tokenC})
+
+	require.NoError(t, err)
+	require.Equal(t, "298412649317875401", amounts[2].String())
+
+	_, err = dex.GetAmountsOut(context.Background(), amount(t, "1000000000000000000"), []address.Address{tokenA, tokenC})
+
+	require.True(t, errors.Is(err, ErrPair))
+
+	_, err = dex.GetAmountsIn(context.Background(), big.NewInt(1), []address.Address{tokenA})
+
+	require.True(t, errors.Is(err, ErrPath))
+}
+
+func TestSwap(t *testing.T) {
+	dex, provider := newTestDex(t)
+
+	var args []byte
+
+	provider.HandleTransaction(dex.Config.Router.Hex(), "swapExactTokensForTokens(uint256,uint256,address[],address,uint256)", func(data []byte) ([]*client.Log, error) {
+		args = data
+
+		return nil, nil
+	})
+
+	to := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	now := time.Now().Unix()
+
+	tx, trade, err := dex.SwapExactTokensForTokens(context.Background(), amount(t, "1000000000000000000"), []address.Address{tokenA, tokenB, tokenC}, to)
+
+	require.NoError(t, err)
+
+	defer tx.Close()
+
+	require.Equal(t, "296920586071286023", trade.Limit.String())
+	require.True(t, trade.Deadline.Int64() >= now+int64(DefaultTTL/time.Second))
+
+	require.Len(t, provider.Transactions(), 1)
+
+	word := func(i int) *big.Int {
+		return new(big.Int).SetBytes(args[i*32 : (i+1)*32])
+	}
+
+	require.Equal(t, "1000000000000000000", word(0).String())
+	require.Equal(t, trade.Limit, word(1))
+	require.Equal(t, trade.Deadline, word(4))
+
+	// the gas limit is estimated
+	require.Equal(t, int64(clienttest.DefaultGasEstimate), provider.Transactions()[0].GasLimit.Int64())
+}
+
+func TestSwapETH(t *testing.T) {
+	dex, provider := newTestDex(t)
+
+	var args []byte
+
+	handler := func(data []byte) ([]*client.Log, error) {
+		args = data
+
+		return nil, nil
+	}
+
+	provider.HandleTransaction(dex.Config.Router.Hex(), "swapExactETHForTokens(uint256,address[],address,uint256)", handler)
+	provider.HandleTransaction(dex.Config.Router.Hex(), "swapETHForExactTokens(uint256,address[],address,uint256)", handler)
+
+	to := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	// tokenA is the wrapped native token
+	tx, trade, err := dex.SwapExactETHForTokens(context.Background(), amount(t, "1000000000000000000"), []address.Address{tokenA, tokenB}, to)
+
+	require.NoError(t, err)
+
+	tx.Close()
+
+	sent := provider.Transactions()[0]
+
+	require.Equal(t, "1000000000000000000", sent.Amount.String())
+	require.Equal(t, trade.Limit, new(big.Int).SetBytes(args[:32]))
+
+	// the native value is estimated too
+	estimated := provider.Estimated()
+
+	require.Len(t, estimated, 1)
+	require.Equal(t, "0xde0b6b3a7640000", estimated[0].Value)
+
+	tx, trade, err = dex.SwapETHForExactTokens(context.Background(), big.NewInt(1000000), []address.Address{tokenA, tokenB}, to, abi.WithGasLimits(big.NewInt(300000)))
+
+	require.NoError(t, err)
+
+	tx.Close()
+
+	sent = provider.Transactions()[1]
+
+	// the slippage bounded input is sent
+	require.Equal(t, trade.Limit, sent.Amount)
+
+	// the estimation is skipped by the gas limit of caller
+	require.Len(t, provider.Estimated(), 1)
+	require.True(t, trade.Limit.Cmp(trade.Amounts[0]) > 0)
+	require.Equal(t, int64(300000), sent.GasLimit.Int64())
+}
@@ -0,0 +1,16 @@
+package dex
+
+import "github.com/libs4go/errors"
+
+// ScopeOfAPIError .
+const errVendor = "ethers-dex"
+
+// errors
+var (
+	ErrAmount    = errors.New("insufficient amount", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrLiquidity = errors.New("insufficient liquidity", errors.WithVendor(errVendor), errors.WithCode(-2))
+	ErrPath      = errors.New("invalid swap path", errors.WithVendor(errVendor), errors.WithCode(-3))
+	ErrPair      = errors.New("pair not found", errors.WithVendor(errVendor), errors.WithCode(-4))
+	ErrSlippage  = errors.New("invalid slippage tolerance", errors.WithVendor(errVendor), errors.WithCode(-5))
+	ErrSigner    = errors.New("signer expect", errors.WithVendor(errVendor), errors.WithCode(-6))
+)
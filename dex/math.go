@@ -0,0 +1,176 @@
+package dex
+
+import (
+	"math/big"
+
+	"github.com/libs4go/errors"
+)
+
+// Fee swap fee of pair, Numerator / Denominator of the input amount is charged
+type Fee struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// well known swap fees
+var (
+	UniswapV2Fee   = Fee{Numerator: 3, Denominator: 1000}
+	PancakeV2Fee   = Fee{Numerator: 25, Denominator: 10000}
+	PancakeV1Fee   = Fee{Numerator: 2, Denominator: 1000}
+	SushiSwapV2Fee = UniswapV2Fee
+)
+
+func (fee Fee) factors() (*big.Int, *big.Int) {
+	return big.NewInt(fee.Denominator - fee.Numerator), big.NewInt(fee.Denominator)
+}
+
+// Quote returns the amount of B equivalent to amountA at the reserves ratio, mirrors the
+// quote of UniswapV2Library
+func Quote(amountA, reserveA, reserveB *big.Int) (*big.Int, error) {
+	if amountA.Sign() <= 0 {
+		return nil, errors.Wrap(ErrAmount, "quote amount %s", amountA)
+	}
+
+	if reserveA.Sign() <= 0 || reserveB.Sign() <= 0 {
+		return nil, errors.Wrap(ErrLiquidity, "reserves %s/%s", reserveA, reserveB)
+	}
+
+	amountB := new(big.Int).Mul(amountA, reserveB)
+
+	return amountB.Quo(amountB, reserveA), nil
+}
+
+// GetAmountOut returns the maximum output amount of amountIn, mirrors the getAmountOut of
+// UniswapV2Library with fee
+func GetAmountOut(amountIn, reserveIn, reserveOut *big.Int, fee Fee) (*big.Int, error) {
+	if amountIn.Sign() <= 0 {
+		return nil, errors.Wrap(ErrAmount, "input amount %s", amountIn)
+	}
+
+	if reserveIn.Sign() <= 0 || reserveOut.Sign() <= 0 {
+		return nil, errors.Wrap(ErrLiquidity, "reserves %s/%s", reserveIn, reserveOut)
+	}
+
+	kept, base := fee.factors()
+
+	amountInWithFee := new(big.Int).Mul(amountIn, kept)
+
+	numerator := new(big.Int).Mul(amountInWithFee, reserveOut)
+
+	denominator := new(big.Int).Mul(reserveIn, base)
+
+	denominator.Add(denominator, amountInWithFee)
+
+	return numerator.Quo(numerator, denominator), nil
+}
+
+// GetAmountIn returns the minimum input amount required to get amountOut, mirrors the getAmountIn
+// of UniswapV2Library with fee
+func GetAmountIn(amountOut, reserveIn, reserveOut *big.Int, fee Fee) (*big.Int, error) {
+	if amountOut.Sign() <= 0 {
+		return nil, errors.Wrap(ErrAmount, "output amount %s", amountOut)
+	}
+
+	if reserveIn.Sign() <= 0 || reserveOut.Sign() <= 0 {
+		return nil, errors.Wrap(ErrLiquidity, "reserves %s/%s", reserveIn, reserveOut)
+	}
+
+	// the on-chain subtraction underflows and reverts
+	if amountOut.Cmp(reserveOut) >= 0 {
+		return nil, errors.Wrap(ErrLiquidity, "output amount %s exceeds reserve %s", amountOut, reserveOut)
+	}
+
+	kept, base := fee.factors()
+
+	numerator := new(big.Int).Mul(reserveIn, amountOut)
+
+	numerator.Mul(numerator, base)
+
+	denominator := new(big.Int).Sub(reserveOut, amountOut)
+
+	denominator.Mul(denominator, kept)
+
+	numerator.Quo(numerator, denominator)
+
+	return numerator.Add(numerator, big.NewInt(1)), nil
+}
+
+// Reserves reserves of the hop pair ordered by swap direction
+type Reserves struct {
+	In  *big.Int
+	Out *big.Int
+}
+
+// GetAmountsOut returns the amounts along the hops of exact input amountIn, the first amount is amountIn
+func GetAmountsOut(amountIn *big.Int, hops []Reserves, fee Fee) ([]*big.Int, error) {
+	if len(hops) == 0 {
+		return nil, errors.Wrap(ErrPath, "empty hops")
+	}
+
+	amounts := []*big.Int{amountIn}
+
+	for i, hop := range hops {
+		amount, err := GetAmountOut(amounts[i], hop.In, hop.Out, fee)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "hop %d", i)
+		}
+
+		amounts = append(amounts, amount)
+	}
+
+	return amounts, nil
+}
+
+// GetAmountsIn returns the amounts along the hops of exact output amountOut, the last amount is amountOut
+func GetAmountsIn(amountOut *big.Int, hops []Reserves, fee Fee) ([]*big.Int, error) {
+	if len(hops) == 0 {
+		return nil, errors.Wrap(ErrPath, "empty hops")
+	}
+
+	amounts := make([]*big.Int, len(hops)+1)
+
+	amounts[len(hops)] = amountOut
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		amount, err := GetAmountIn(amounts[i+1], hops[i].In, hops[i].Out, fee)
+
+		if err != nil {
+			return nil, errors.Wrap(err, "hop %d", i)
+		}
+
+		amounts[i] = amount
+	}
+
+	return amounts, nil
+}
+
+// Slippage slippage tolerance in basis points, e.g. 50 is 0.5%
+type Slippage uint64
+
+// MaxSlippage 100% slippage tolerance
+const MaxSlippage Slippage = 10000
+
+// MinOut returns the minimum output amount accepted for quoted amount
+func (s Slippage) MinOut(amount *big.Int) (*big.Int, error) {
+	if s > MaxSlippage {
+		return nil, errors.Wrap(ErrSlippage, "slippage %d bips", s)
+	}
+
+	min := new(big.Int).Mul(amount, big.NewInt(int64(MaxSlippage-s)))
+
+	return min.Quo(min, big.NewInt(int64(MaxSlippage))), nil
+}
+
+// MaxIn returns the maximum input amount accepted for quoted amount, rounded up
+func (s Slippage) MaxIn(amount *big.Int) (*big.Int, error) {
+	if s > MaxSlippage {
+		return nil, errors.Wrap(ErrSlippage, "slippage %d bips", s)
+	}
+
+	max := new(big.Int).Mul(amount, big.NewInt(int64(MaxSlippage+s)))
+
+	max.Add(max, big.NewInt(int64(MaxSlippage-1)))
+
+	return max.Quo(max, big.NewInt(int64(MaxSlippage))), nil
+}
@@ -0,0 +1,101 @@
+package dex
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func amount(t *testing.T, s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+
+	require.True(t, ok)
+
+	return v
+}
+
+func TestGetAmount(t *testing.T) {
+	reserve0 := amount(t, "5000000000000000000000")
+	reserve1 := amount(t, "12345678000000")
+	one := amount(t, "1000000000000000000")
+
+	out, err := GetAmountOut(one, reserve0, reserve1, UniswapV2Fee)
+
+	require.NoError(t, err)
+	require.Equal(t, "2461237422", out.String())
+
+	out, err = GetAmountOut(one, reserve0, reserve1, PancakeV2Fee)
+
+	require.NoError(t, err)
+	require.Equal(t, "2462471497", out.String())
+
+	in, err := GetAmountIn(one, reserve1, reserve0, UniswapV2Fee)
+
+	require.NoError(t, err)
+	require.Equal(t, "2477060709", in.String())
+
+	in, err = GetAmountIn(one, reserve1, reserve0, PancakeV2Fee)
+
+	require.NoError(t, err)
+	require.Equal(t, "2475819074", in.String())
+
+	// the input quoted by GetAmountIn is enough for the output
+	out, err = GetAmountOut(in, reserve1, reserve0, PancakeV2Fee)
+
+	require.NoError(t, err)
+	require.True(t, out.Cmp(one) >= 0)
+
+	_, err = GetAmountIn(reserve0, reserve1, reserve0, PancakeV2Fee)
+
+	require.True(t, errors.Is(err, ErrLiquidity))
+
+	_, err = GetAmountOut(big.NewInt(0), reserve0, reserve1, PancakeV2Fee)
+
+	require.True(t, errors.Is(err, ErrAmount))
+
+	b, err := Quote(one, reserve0, reserve1)
+
+	require.NoError(t, err)
+	require.Equal(t, "2469135600", b.String())
+}
+
+func TestGetAmountsOut(t *testing.T) {
+	hops := []Reserves{
+		{In: amount(t, "5000000000000000000000"), Out: amount(t, "12345678000000")},
+		{In: amount(t, "24691356000000"), Out: amount(t, "3000000000000000000000")},
+	}
+
+	amounts, err := GetAmountsOut(amount(t, "1000000000000000000"), hops, PancakeV2Fee)
+
+	require.NoError(t, err)
+	require.Equal(t, "[1000000000000000000 2462471497 298412649317875401]", fmt.Sprint(amounts))
+
+	amounts, err = GetAmountsIn(amounts[2], hops, PancakeV2Fee)
+
+	require.NoError(t, err)
+	require.Equal(t, amount(t, "298412649317875401"), amounts[2])
+	require.True(t, amounts[0].Cmp(amount(t, "1000000000000000000")) <= 0)
+
+	_, err = GetAmountsOut(big.NewInt(1), nil, PancakeV2Fee)
+
+	require.True(t, errors.Is(err, ErrPath))
+}
+
+func TestSlippage(t *testing.T) {
+	min, err := Slippage(50).MinOut(big.NewInt(10001))
+
+	require.NoError(t, err)
+	require.Equal(t, int64(9950), min.Int64())
+
+	max, err := Slippage(50).MaxIn(big.NewInt(10001))
+
+	require.NoError(t, err)
+	require.Equal(t, int64(10052), max.Int64())
+
+	_, err = Slippage(10001).MinOut(big.NewInt(1))
+
+	require.True(t, errors.Is(err, ErrSlippage))
+}
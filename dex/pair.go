@@ -0,0 +1,42 @@
+package dex
+
+import (
+	"bytes"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/internal/keccak"
+)
+
+// SortTokens returns the pair tokens in the order of pair token0 and token1
+func SortTokens(tokenA, tokenB address.Address) (token0 address.Address, token1 address.Address, err error) {
+	switch bytes.Compare(tokenA[:], tokenB[:]) {
+	case 0:
+		err = errors.Wrap(ErrPath, "identical tokens %s", tokenA.Hex())
+		return
+	case -1:
+		token0, token1 = tokenA, tokenB
+	default:
+		token0, token1 = tokenB, tokenA
+	}
+
+	if token0 == (address.Address{}) {
+		err = errors.Wrap(ErrPath, "zero address token")
+	}
+
+	return
+}
+
+// PairFor returns the CREATE2 pair address of tokenA and tokenB created by factory, initCodeHash
+// is the keccak256 hash of the pair creation code
+func PairFor(factory address.Address, initCodeHash []byte, tokenA, tokenB address.Address) (address.Address, error) {
+	token0, token1, err := SortTokens(tokenA, tokenB)
+
+	if err != nil {
+		return address.Address{}, err
+	}
+
+	salt := keccak.Hash(token0[:], token1[:])
+
+	return address.BytesToAddress(keccak.Hash([]byte{0xff}, factory[:], salt, initCodeHash)[12:]), nil
+}
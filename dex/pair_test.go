@@ -0,0 +1,35 @@
+package dex
+
+import (
+	"testing"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairFor(t *testing.T) {
+	usdc := address.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	weth := address.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+
+	pair, err := PairFor(UniswapV2.Factory, UniswapV2.InitCodeHash, weth, usdc)
+
+	require.NoError(t, err)
+	require.Equal(t, "0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc", pair.Hex())
+
+	wbnb := address.HexToAddress("0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	busd := address.HexToAddress("0xe9e7CEA3DedcA5984780Bafc599bD69ADd087D56")
+
+	pair, err = PairFor(PancakeV2.Factory, PancakeV2.InitCodeHash, busd, wbnb)
+
+	require.NoError(t, err)
+	require.Equal(t, "0x58F876857a02D6762E0101bb5C46A8c1ED44Dc16", pair.Hex())
+
+	_, err = PairFor(PancakeV2.Factory, PancakeV2.InitCodeHash, busd, busd)
+
+	require.True(t, errors.Is(err, ErrPath))
+
+	_, _, err = SortTokens(busd, address.Address{})
+
+	require.True(t, errors.Is(err, ErrPath))
+}
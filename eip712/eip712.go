@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"hash"
 	"math/big"
@@ -18,6 +19,7 @@ import (
 	"github.com/libs4go/crypto/elliptic"
 	"github.com/libs4go/errors"
 	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/internal/keccak"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -60,6 +62,18 @@ func (i *HexOrDecimal256) UnmarshalText(input []byte) error {
 	return nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler, both json string and number are accepted.
+func (i *HexOrDecimal256) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		var s string
+		if err := json.Unmarshal(input, &s); err != nil {
+			return err
+		}
+		return i.UnmarshalText([]byte(s))
+	}
+	return i.UnmarshalText(input)
+}
+
 // MarshalText implements encoding.TextMarshaler.
 func (i *HexOrDecimal256) MarshalText() ([]byte, error) {
 	if i == nil {
@@ -81,16 +95,45 @@ type Type struct {
 }
 
 func (t *Type) isArray() bool {
-	return strings.HasSuffix(t.Type, "[]")
+	return strings.HasSuffix(t.Type, "]")
 }
 
-// typeName returns the canonical name of the type. If the type is 'Person[]', then
-// this method returns 'Person'
+// typeName returns the canonical name of the type. If the type is 'Person[]' or
+// 'Person[2][]', then this method returns 'Person'
 func (t *Type) typeName() string {
-	if strings.HasSuffix(t.Type, "[]") {
-		return strings.TrimSuffix(t.Type, "[]")
+	return baseTypeName(t.Type)
+}
+
+// baseTypeName strips all the array dimensions of type
+func baseTypeName(encType string) string {
+	if i := strings.Index(encType, "["); i >= 0 {
+		return encType[:i]
+	}
+	return encType
+}
+
+// splitArrayType split 'Person[2][]' into element type 'Person[2]' and length -1,
+// and 'uint256[3]' into 'uint256' and length 3
+func splitArrayType(encType string) (string, int, error) {
+	i := strings.LastIndex(encType, "[")
+
+	if i < 0 || !strings.HasSuffix(encType, "]") {
+		return "", 0, fmt.Errorf("type %q is not array", encType)
 	}
-	return t.Type
+
+	lengthStr := encType[i+1 : len(encType)-1]
+
+	if lengthStr == "" {
+		return encType[:i], -1, nil
+	}
+
+	length, err := strconv.Atoi(lengthStr)
+
+	if err != nil || length <= 0 {
+		return "", 0, fmt.Errorf("invalid array length of type %q", encType)
+	}
+
+	return encType[:i], length, nil
 }
 
 func (t *Type) isReferenceType() bool {
@@ -116,9 +159,11 @@ type TypedDataDomain struct {
 	ChainId           *HexOrDecimal256 `json:"chainId"`
 	VerifyingContract string           `json:"verifyingContract"`
 	Salt              string           `json:"salt"`
+	// Extra custom domain fields, which must be declared in EIP712Domain type
+	Extra map[string]interface{} `json:"-"`
 }
 
-var typedDataReferenceTypeRegexp = regexp.MustCompile(`^[A-Z](\w*)(\[\])?$`)
+var typedDataReferenceTypeRegexp = regexp.MustCompile(`^[A-Z](\w*)((\[[1-9]\d*\])|(\[\]))*$`)
 
 // HashStruct generates a keccak256 hash of the encoding of the provided data
 func (typedData *TypedData) HashStruct(primaryType string, data TypedDataMessage) ([]byte, error) {
@@ -148,7 +193,7 @@ func (typedData *TypedData) Dependencies(primaryType string, found []string) []s
 	}
 	found = append(found, primaryType)
 	for _, field := range typedData.Types[primaryType] {
-		for _, dep := range typedData.Dependencies(field.Type, found) {
+		for _, dep := range typedData.Dependencies(field.typeName(), found) {
 			if !includes(found, dep) {
 				found = append(found, dep)
 			}
@@ -213,56 +258,59 @@ func (typedData *TypedData) EncodeData(primaryType string, data map[string]inter
 
 	// Add field contents. Structs and arrays have special handlers.
 	for _, field := range typedData.Types[primaryType] {
-		encType := field.Type
-		encValue := data[field.Name]
-		if encType[len(encType)-1:] == "]" {
-			arrayValue, ok := encValue.([]interface{})
-			if !ok {
-				return nil, dataMismatchError(encType, encValue)
-			}
+		encodedData, err := typedData.encodeField(field.Type, data[field.Name], depth)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(encodedData)
+	}
+	return buffer.Bytes(), nil
+}
 
-			arrayBuffer := bytes.Buffer{}
-			parsedType := strings.Split(encType, "[")[0]
-			for _, item := range arrayValue {
-				if typedData.Types[parsedType] != nil {
-					mapValue, ok := item.(map[string]interface{})
-					if !ok {
-						return nil, dataMismatchError(parsedType, item)
-					}
-					encodedData, err := typedData.EncodeData(parsedType, mapValue, depth+1)
-					if err != nil {
-						return nil, err
-					}
-					arrayBuffer.Write(encodedData)
-				} else {
-					bytesValue, err := typedData.EncodePrimitiveValue(parsedType, item, depth)
-					if err != nil {
-						return nil, err
-					}
-					arrayBuffer.Write(bytesValue)
-				}
-			}
+// encodeField encode struct member value: arrays are encoded as the keccak256 hash of the
+// concatenated encoding of their elements, structs as the keccak256 hash of encodeData,
+// and atomic values as 32-byte word
+func (typedData *TypedData) encodeField(encType string, encValue interface{}, depth int) ([]byte, error) {
+	if strings.HasSuffix(encType, "]") {
+		elemType, length, err := splitArrayType(encType)
+		if err != nil {
+			return nil, err
+		}
 
-			buffer.Write(Keccak256(arrayBuffer.Bytes()))
-		} else if typedData.Types[field.Type] != nil {
-			mapValue, ok := encValue.(map[string]interface{})
-			if !ok {
-				return nil, dataMismatchError(encType, encValue)
-			}
-			encodedData, err := typedData.EncodeData(field.Type, mapValue, depth+1)
-			if err != nil {
-				return nil, err
-			}
-			buffer.Write(Keccak256(encodedData))
-		} else {
-			byteValue, err := typedData.EncodePrimitiveValue(encType, encValue, depth)
+		arrayValue, ok := encValue.([]interface{})
+		if !ok {
+			return nil, dataMismatchError(encType, encValue)
+		}
+
+		if length >= 0 && len(arrayValue) != length {
+			return nil, fmt.Errorf("provided array length %d doesn't match type '%s'", len(arrayValue), encType)
+		}
+
+		arrayBuffer := bytes.Buffer{}
+		for _, item := range arrayValue {
+			encodedData, err := typedData.encodeField(elemType, item, depth+1)
 			if err != nil {
 				return nil, err
 			}
-			buffer.Write(byteValue)
+			arrayBuffer.Write(encodedData)
 		}
+
+		return Keccak256(arrayBuffer.Bytes()), nil
 	}
-	return buffer.Bytes(), nil
+
+	if typedData.Types[encType] != nil {
+		mapValue, ok := encValue.(map[string]interface{})
+		if !ok {
+			return nil, dataMismatchError(encType, encValue)
+		}
+		encodedData, err := typedData.EncodeData(encType, mapValue, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return Keccak256(encodedData), nil
+	}
+
+	return typedData.EncodePrimitiveValue(encType, encValue, depth)
 }
 
 const uintBits = 32 << (uint64(^uint(0)) >> 63)
@@ -354,7 +402,7 @@ func parseInteger(encType string, encValue interface{}) (*big.Int, error) {
 			lengthStr = strings.TrimPrefix(encType, "int")
 		}
 		atoiSize, err := strconv.Atoi(lengthStr)
-		if err != nil {
+		if err != nil || atoiSize <= 0 || atoiSize > 256 || atoiSize%8 != 0 {
 			return nil, fmt.Errorf("invalid size on integer: %v", lengthStr)
 		}
 		length = atoiSize
@@ -362,6 +410,14 @@ func parseInteger(encType string, encValue interface{}) (*big.Int, error) {
 	switch v := encValue.(type) {
 	case *HexOrDecimal256:
 		b = (*big.Int)(v)
+	case *big.Int:
+		b = v
+	case int:
+		b = big.NewInt(int64(v))
+	case int64:
+		b = big.NewInt(v)
+	case uint64:
+		b = new(big.Int).SetUint64(v)
 	case string:
 		var hexIntValue HexOrDecimal256
 		if err := hexIntValue.UnmarshalText([]byte(v)); err != nil {
@@ -380,12 +436,18 @@ func parseInteger(encType string, encValue interface{}) (*big.Int, error) {
 	if b == nil {
 		return nil, fmt.Errorf("invalid integer value %v/%v for type %v", encValue, reflect.TypeOf(encValue), encType)
 	}
-	if b.BitLen() > length {
-		return nil, fmt.Errorf("integer larger than '%v'", encType)
-	}
 	if !signed && b.Sign() == -1 {
 		return nil, fmt.Errorf("invalid negative value for unsigned type %v", encType)
 	}
+	if signed {
+		// -2^(length-1) <= b < 2^(length-1)
+		min := new(big.Int).Neg(new(big.Int).Lsh(Big1, uint(length-1)))
+		if b.Cmp(min) < 0 || b.BitLen() > length-1 {
+			return nil, fmt.Errorf("integer out of range of '%v'", encType)
+		}
+	} else if b.BitLen() > length {
+		return nil, fmt.Errorf("integer larger than '%v'", encType)
+	}
 	return b, nil
 }
 
@@ -521,11 +583,15 @@ var (
 func (typedData *TypedData) EncodePrimitiveValue(encType string, encValue interface{}, depth int) ([]byte, error) {
 	switch encType {
 	case "address":
+		retval := make([]byte, 32)
+		if addr, ok := encValue.(address.Address); ok {
+			copy(retval[12:], addr.Bytes())
+			return retval, nil
+		}
 		stringValue, ok := encValue.(string)
 		if !ok || !address.IsHexAddress(stringValue) {
 			return nil, dataMismatchError(encType, encValue)
 		}
-		retval := make([]byte, 32)
 		copy(retval[12:], address.HexToAddress(stringValue).Bytes())
 		return retval, nil
 	case "bool":
@@ -664,51 +730,53 @@ func (typedData *TypedData) formatData(primaryType string, data map[string]inter
 
 	// Add field contents. Structs and arrays have special handlers.
 	for _, field := range typedData.Types[primaryType] {
-		encName := field.Name
-		encValue := data[encName]
-		item := &NameValueType{
-			Name: encName,
-			Typ:  field.Type,
+		item, err := typedData.formatField(field.Name, field.Type, data[field.Name])
+		if err != nil {
+			return nil, err
 		}
-		if field.isArray() {
-			arrayValue, _ := encValue.([]interface{})
-			parsedType := field.typeName()
-			for _, v := range arrayValue {
-				if typedData.Types[parsedType] != nil {
-					mapValue, _ := v.(map[string]interface{})
-					mapOutput, err := typedData.formatData(parsedType, mapValue)
-					if err != nil {
-						return nil, err
-					}
-					item.Value = mapOutput
-				} else {
-					primitiveOutput, err := formatPrimitiveValue(field.Type, encValue)
-					if err != nil {
-						return nil, err
-					}
-					item.Value = primitiveOutput
-				}
-			}
-		} else if typedData.Types[field.Type] != nil {
-			if mapValue, ok := encValue.(map[string]interface{}); ok {
-				mapOutput, err := typedData.formatData(field.Type, mapValue)
-				if err != nil {
-					return nil, err
-				}
-				item.Value = mapOutput
-			} else {
-				item.Value = "<nil>"
+		output = append(output, item)
+	}
+	return output, nil
+}
+
+func (typedData *TypedData) formatField(encName string, encType string, encValue interface{}) (*NameValueType, error) {
+	item := &NameValueType{
+		Name: encName,
+		Typ:  encType,
+	}
+	if strings.HasSuffix(encType, "]") {
+		elemType, _, err := splitArrayType(encType)
+		if err != nil {
+			return nil, err
+		}
+		arrayValue, _ := encValue.([]interface{})
+		var elems []*NameValueType
+		for i, v := range arrayValue {
+			elem, err := typedData.formatField(fmt.Sprintf("[%d]", i), elemType, v)
+			if err != nil {
+				return nil, err
 			}
-		} else {
-			primitiveOutput, err := formatPrimitiveValue(field.Type, encValue)
+			elems = append(elems, elem)
+		}
+		item.Value = elems
+	} else if typedData.Types[encType] != nil {
+		if mapValue, ok := encValue.(map[string]interface{}); ok {
+			mapOutput, err := typedData.formatData(encType, mapValue)
 			if err != nil {
 				return nil, err
 			}
-			item.Value = primitiveOutput
+			item.Value = mapOutput
+		} else {
+			item.Value = "<nil>"
 		}
-		output = append(output, item)
+	} else {
+		primitiveOutput, err := formatPrimitiveValue(encType, encValue)
+		if err != nil {
+			return nil, err
+		}
+		item.Value = primitiveOutput
 	}
-	return output, nil
+	return item, nil
 }
 
 // // Hex returns an EIP55-compliant hex string representation of the address.
@@ -840,126 +908,46 @@ func (t Types) validate() error {
 	return nil
 }
 
-// Checks if the primitive value is valid
+var typedDataPrimitiveTypeRegexp = regexp.MustCompile(`^(address|bool|string|bytes|bytes\d+|u?int|u?int\d+)((\[[1-9]\d*\])|(\[\]))*$`)
+
+// Checks if the primitive value is valid, array types of any dimension are accepted
 func isPrimitiveTypeValid(primitiveType string) bool {
-	if primitiveType == "address" ||
-		primitiveType == "address[]" ||
-		primitiveType == "bool" ||
-		primitiveType == "bool[]" ||
-		primitiveType == "string" ||
-		primitiveType == "string[]" {
-		return true
-	}
-	if primitiveType == "bytes" ||
-		primitiveType == "bytes[]" ||
-		primitiveType == "bytes1" ||
-		primitiveType == "bytes1[]" ||
-		primitiveType == "bytes2" ||
-		primitiveType == "bytes2[]" ||
-		primitiveType == "bytes3" ||
-		primitiveType == "bytes3[]" ||
-		primitiveType == "bytes4" ||
-		primitiveType == "bytes4[]" ||
-		primitiveType == "bytes5" ||
-		primitiveType == "bytes5[]" ||
-		primitiveType == "bytes6" ||
-		primitiveType == "bytes6[]" ||
-		primitiveType == "bytes7" ||
-		primitiveType == "bytes7[]" ||
-		primitiveType == "bytes8" ||
-		primitiveType == "bytes8[]" ||
-		primitiveType == "bytes9" ||
-		primitiveType == "bytes9[]" ||
-		primitiveType == "bytes10" ||
-		primitiveType == "bytes10[]" ||
-		primitiveType == "bytes11" ||
-		primitiveType == "bytes11[]" ||
-		primitiveType == "bytes12" ||
-		primitiveType == "bytes12[]" ||
-		primitiveType == "bytes13" ||
-		primitiveType == "bytes13[]" ||
-		primitiveType == "bytes14" ||
-		primitiveType == "bytes14[]" ||
-		primitiveType == "bytes15" ||
-		primitiveType == "bytes15[]" ||
-		primitiveType == "bytes16" ||
-		primitiveType == "bytes16[]" ||
-		primitiveType == "bytes17" ||
-		primitiveType == "bytes17[]" ||
-		primitiveType == "bytes18" ||
-		primitiveType == "bytes18[]" ||
-		primitiveType == "bytes19" ||
-		primitiveType == "bytes19[]" ||
-		primitiveType == "bytes20" ||
-		primitiveType == "bytes20[]" ||
-		primitiveType == "bytes21" ||
-		primitiveType == "bytes21[]" ||
-		primitiveType == "bytes22" ||
-		primitiveType == "bytes22[]" ||
-		primitiveType == "bytes23" ||
-		primitiveType == "bytes23[]" ||
-		primitiveType == "bytes24" ||
-		primitiveType == "bytes24[]" ||
-		primitiveType == "bytes25" ||
-		primitiveType == "bytes25[]" ||
-		primitiveType == "bytes26" ||
-		primitiveType == "bytes26[]" ||
-		primitiveType == "bytes27" ||
-		primitiveType == "bytes27[]" ||
-		primitiveType == "bytes28" ||
-		primitiveType == "bytes28[]" ||
-		primitiveType == "bytes29" ||
-		primitiveType == "bytes29[]" ||
-		primitiveType == "bytes30" ||
-		primitiveType == "bytes30[]" ||
-		primitiveType == "bytes31" ||
-		primitiveType == "bytes31[]" ||
-		primitiveType == "bytes32" ||
-		primitiveType == "bytes32[]" {
-		return true
-	}
-	if primitiveType == "int" ||
-		primitiveType == "int[]" ||
-		primitiveType == "int8" ||
-		primitiveType == "int8[]" ||
-		primitiveType == "int16" ||
-		primitiveType == "int16[]" ||
-		primitiveType == "int32" ||
-		primitiveType == "int32[]" ||
-		primitiveType == "int64" ||
-		primitiveType == "int64[]" ||
-		primitiveType == "int128" ||
-		primitiveType == "int128[]" ||
-		primitiveType == "int256" ||
-		primitiveType == "int256[]" {
-		return true
-	}
-	if primitiveType == "uint" ||
-		primitiveType == "uint[]" ||
-		primitiveType == "uint8" ||
-		primitiveType == "uint8[]" ||
-		primitiveType == "uint16" ||
-		primitiveType == "uint16[]" ||
-		primitiveType == "uint32" ||
-		primitiveType == "uint32[]" ||
-		primitiveType == "uint64" ||
-		primitiveType == "uint64[]" ||
-		primitiveType == "uint128" ||
-		primitiveType == "uint128[]" ||
-		primitiveType == "uint256" ||
-		primitiveType == "uint256[]" {
-		return true
-	}
-	return false
+	if !typedDataPrimitiveTypeRegexp.MatchString(primitiveType) {
+		return false
+	}
+	baseType := baseTypeName(primitiveType)
+	switch {
+	case strings.HasPrefix(baseType, "bytes") && baseType != "bytes":
+		size, err := strconv.Atoi(strings.TrimPrefix(baseType, "bytes"))
+		return err == nil && size >= 1 && size <= 32
+	case strings.HasPrefix(baseType, "uint") && baseType != "uint":
+		bits, err := strconv.Atoi(strings.TrimPrefix(baseType, "uint"))
+		return err == nil && bits >= 8 && bits <= 256 && bits%8 == 0
+	case strings.HasPrefix(baseType, "int") && baseType != "int":
+		bits, err := strconv.Atoi(strings.TrimPrefix(baseType, "int"))
+		return err == nil && bits >= 8 && bits <= 256 && bits%8 == 0
+	}
+	return true
 }
 
 // validate checks if the given domain is valid, i.e. contains at least
 // the minimum viable keys and values
 func (domain *TypedDataDomain) validate() error {
-	if domain.ChainId == nil && len(domain.Name) == 0 && len(domain.Version) == 0 && len(domain.VerifyingContract) == 0 && len(domain.Salt) == 0 {
+	if domain.ChainId == nil && len(domain.Name) == 0 && len(domain.Version) == 0 && len(domain.VerifyingContract) == 0 && len(domain.Salt) == 0 && len(domain.Extra) == 0 {
 		return errors.New("domain is undefined")
 	}
 
+	if len(domain.VerifyingContract) > 0 && !address.IsHexAddress(domain.VerifyingContract) {
+		return fmt.Errorf("invalid domain verifyingContract %q", domain.VerifyingContract)
+	}
+
+	// salt is bytes32, it must be 0x prefixed hex string of 32 bytes
+	if len(domain.Salt) > 0 {
+		if salt, err := Decode(domain.Salt); err != nil || len(salt) != 32 {
+			return fmt.Errorf("invalid domain salt %q, expect bytes32 hex string", domain.Salt)
+		}
+	}
+
 	return nil
 }
 
@@ -986,9 +974,47 @@ func (domain *TypedDataDomain) Map() map[string]interface{} {
 	if len(domain.Salt) > 0 {
 		dataMap["salt"] = domain.Salt
 	}
+
+	for name, value := range domain.Extra {
+		dataMap[name] = value
+	}
 	return dataMap
 }
 
+// MarshalJSON implements json.Marshaler, the empty domain fields are omitted
+func (domain TypedDataDomain) MarshalJSON() ([]byte, error) {
+	return json.Marshal(domain.Map())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, the unknown domain fields are kept in Extra
+func (domain *TypedDataDomain) UnmarshalJSON(data []byte) error {
+	type plainDomain TypedDataDomain
+
+	var plain plainDomain
+
+	if err := json.Unmarshal(data, &plain); err != nil {
+		return err
+	}
+
+	var fields map[string]interface{}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"name", "version", "chainId", "verifyingContract", "salt"} {
+		delete(fields, name)
+	}
+
+	if len(fields) > 0 {
+		plain.Extra = fields
+	}
+
+	*domain = TypedDataDomain(plain)
+
+	return nil
+}
+
 // KeccakState wraps sha3.state. In addition to the usual hash methods, it also supports
 // Read to get a variable amount of data from the hash state. Read is faster than Sum
 // because it doesn't copy the internal state, but also modifies the internal state.
@@ -1004,64 +1030,84 @@ func NewKeccakState() KeccakState {
 
 // Keccak256 calculates and returns the Keccak256 hash of the input data.
 func Keccak256(data ...[]byte) []byte {
-	b := make([]byte, 32)
-	d := NewKeccakState()
-	for _, b := range data {
-		d.Write(b)
-	}
-	d.Read(b)
-	return b
+	return keccak.Hash(data...)
 }
 
+// Sign sign typed data, returns 65 bytes r ‖ s ‖ v signature with v of 27/28
 func Sign(pk *ecdsa.PrivateKey, typedData *TypedData) ([]byte, error) {
+	sig, _, err := SignWithDigest(pk, typedData)
+
+	return sig, err
+}
 
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+// SignWithDigest sign typed data, returns the signature and the signed digest
+func SignWithDigest(pk *ecdsa.PrivateKey, typedData *TypedData) ([]byte, []byte, error) {
+
+	sighash, err := typedData.Digest()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+
+	sig, err := SignDigest(pk, sighash)
+
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
 
-	sighash := Keccak256(rawData)
+	return sig, sighash, nil
+}
 
-	r, s, v, err := ecdsax.RecoverSign(pk, sighash, true)
+// SignDigest sign the raw typed data digest, see TypedData.Digest
+func SignDigest(pk *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	r, s, v, err := ecdsax.RecoverSign(pk, digest, false)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "recover sign err")
 	}
 
 	return ecdsax.Sig2Bytes(pk.Curve, r, s, v), nil
-
 }
 
+// Recover recover the signer address of typed data signature
 func Recover(typedData *TypedData, sig []byte) (string, error) {
-	curve := elliptic.SECP256K1()
+	addr, _, err := RecoverWithDigest(typedData, sig)
 
-	r, s, v, err := ecdsax.Bytes2Sig(curve, sig)
+	return addr, err
+}
+
+// RecoverWithDigest recover the signer address of typed data signature, returns the address and the signed digest
+func RecoverWithDigest(typedData *TypedData, sig []byte) (string, []byte, error) {
+	sighash, err := typedData.Digest()
 
 	if err != nil {
-		return "", errors.Wrap(err, "")
+		return "", nil, err
 	}
 
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	addr, err := RecoverDigest(sighash, sig)
 
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	return addr, sighash, nil
+}
 
-	if err != nil {
-		return "", err
+// RecoverDigest recover the signer address from the raw typed data digest and signature,
+// v of the signature can be 0/1 or 27/28
+func RecoverDigest(digest []byte, sig []byte) (string, error) {
+	curve := elliptic.SECP256K1()
+
+	if len(sig) == 65 && sig[64] < 27 {
+		sig = append(append([]byte{}, sig[:64]...), sig[64]+27)
 	}
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
 
-	sighash := Keccak256(rawData)
+	r, s, v, err := ecdsax.Bytes2Sig(curve, sig)
+
+	if err != nil {
+		return "", errors.Wrap(err, "")
+	}
 
-	publicKey, _, err := ecdsax.Recover(curve, r, s, v, sighash)
+	publicKey, _, err := ecdsax.Recover(curve, r, s, v, digest)
 
 	if err != nil {
 		return "", err
@@ -0,0 +1,300 @@
+package eip712
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	ecdsax "github.com/libs4go/crypto/ecdsa"
+	"github.com/libs4go/crypto/elliptic"
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+// eth-sig-util signTypedData_v4 test vector, with struct arrays
+var v4TypedDataJSON = `{
+	"types": {
+		"EIP712Domain": [
+			{ "name": "name", "type": "string" },
+			{ "name": "version", "type": "string" },
+			{ "name": "chainId", "type": "uint256" },
+			{ "name": "verifyingContract", "type": "address" }
+		],
+		"Person": [
+			{ "name": "name", "type": "string" },
+			{ "name": "wallets", "type": "address[]" }
+		],
+		"Mail": [
+			{ "name": "from", "type": "Person" },
+			{ "name": "to", "type": "Person[]" },
+			{ "name": "contents", "type": "string" }
+		],
+		"Group": [
+			{ "name": "name", "type": "string" },
+			{ "name": "members", "type": "Person[]" }
+		]
+	},
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"primaryType": "Mail",
+	"message": {
+		"from": {
+			"name": "Cow",
+			"wallets": [
+				"0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+				"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"
+			]
+		},
+		"to": [
+			{
+				"name": "Bob",
+				"wallets": [
+					"0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+					"0xB0BdaBea57B0BDABeA57b0bdABEA57b0BDabEa57",
+					"0xB0B0b0b0b0b0B000000000000000000000000000"
+				]
+			}
+		],
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestV4StructArrays(t *testing.T) {
+	var typedData TypedData
+
+	require.NoError(t, json.Unmarshal([]byte(v4TypedDataJSON), &typedData))
+
+	require.Equal(t, "Mail(Person from,Person[] to,string contents)Person(string name,address[] wallets)", string(typedData.EncodeType("Mail")))
+
+	require.Equal(t, "Group(string name,Person[] members)Person(string name,address[] wallets)", string(typedData.EncodeType("Group")))
+
+	require.Equal(t, "4bd8a9a2b93427bb184aca81e24beb30ffa3c747e2a33d4225ec08bf12e2e753", hex.EncodeToString(typedData.TypeHash("Mail")))
+
+	structHash, err := typedData.StructHash()
+
+	require.NoError(t, err)
+
+	require.Equal(t, "eb4221181ff3f1a83ea7313993ca9218496e424604ba9492bb4052c03d5c3df8", hex.EncodeToString(structHash))
+
+	digest, err := typedData.Digest()
+
+	require.NoError(t, err)
+
+	require.Equal(t, "a85c2e2b118698e88db68a8105b794a8cc7cec074e89ef991cb4f5f533819cc2", hex.EncodeToString(digest))
+
+	sig, signed, err := SignWithDigest(ecdsaKey(t, "cow"), &typedData)
+
+	require.NoError(t, err)
+
+	require.Equal(t, digest, signed)
+
+	addr, recovered, err := RecoverWithDigest(&typedData, sig)
+
+	require.NoError(t, err)
+
+	require.Equal(t, digest, recovered)
+
+	require.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", addr)
+}
+
+func TestRecoverSpecSignature(t *testing.T) {
+	mail := &Mail{
+		From: Person{
+			Name:   "Cow",
+			Wallet: address.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"),
+		},
+		To: &Person{
+			Name:   "Bob",
+			Wallet: address.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"),
+		},
+		Contents: "Hello, Bob!",
+	}
+
+	typedData, err := TypedDataOf(mailDomain, mail)
+
+	require.NoError(t, err)
+
+	// v = 28, signature of EIP-712 reference implementation with private key keccak256("cow")
+	sig, err := hex.DecodeString("4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c")
+
+	require.NoError(t, err)
+
+	addr, err := Recover(typedData, sig)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", addr)
+
+	// v of 0/1 is accepted too
+	sig[64] = 1
+
+	addr, err = Recover(typedData, sig)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", addr)
+}
+
+func TestSignDigest(t *testing.T) {
+	pk := ecdsaKey(t, "cow")
+
+	typedData, err := TypedDataOf(mailDomain, &Person{Name: "Cow"})
+
+	require.NoError(t, err)
+
+	sig, digest, err := SignWithDigest(pk, typedData)
+
+	require.NoError(t, err)
+
+	require.True(t, sig[64] == 27 || sig[64] == 28)
+
+	addr, err := RecoverDigest(digest, sig)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", addr)
+}
+
+func TestFixedAndNestedArrays(t *testing.T) {
+	typedData := &TypedData{
+		Types: Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+			},
+			"Matrix": {
+				{Name: "values", Type: "uint256[3]"},
+				{Name: "grid", Type: "int8[2][]"},
+				{Name: "pairs", Type: "Person[2][]"},
+			},
+		},
+		PrimaryType: "Matrix",
+		Domain: TypedDataDomain{
+			Name: "Matrix",
+		},
+		Message: TypedDataMessage{
+			"values": []interface{}{"1", "2", "3"},
+			"grid":   []interface{}{[]interface{}{"-1", "2"}},
+			"pairs": []interface{}{
+				[]interface{}{
+					map[string]interface{}{"name": "a"},
+					map[string]interface{}{"name": "b"},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, "Matrix(uint256[3] values,int8[2][] grid,Person[2][] pairs)Person(string name)", string(typedData.EncodeType("Matrix")))
+
+	encoded, err := typedData.EncodeData("Matrix", typedData.Message, 1)
+
+	require.NoError(t, err)
+
+	// values: keccak256(enc(1) ‖ enc(2) ‖ enc(3))
+	require.Equal(t, Keccak256(U256Bytes(Big1), U256Bytes(Big2), U256Bytes(Big3)), encoded[32:64])
+
+	// grid: keccak256(keccak256(enc(-1) ‖ enc(2)))
+	require.Equal(t, Keccak256(Keccak256(U256Bytes(BigPow(2, 256).Sub(BigPow(2, 256), Big1)), U256Bytes(Big2))), encoded[64:96])
+
+	// pairs: keccak256(keccak256(hashStruct(a) ‖ hashStruct(b)))
+	a, err := typedData.HashStruct("Person", map[string]interface{}{"name": "a"})
+	require.NoError(t, err)
+	b, err := typedData.HashStruct("Person", map[string]interface{}{"name": "b"})
+	require.NoError(t, err)
+
+	require.Equal(t, Keccak256(Keccak256(a, b)), encoded[96:128])
+
+	// fixed array length mismatch
+	typedData.Message["values"] = []interface{}{"1", "2"}
+
+	_, err = typedData.Digest()
+
+	require.Error(t, err)
+
+	// int8 out of range
+	typedData.Message["values"] = []interface{}{"1", "2", "3"}
+	typedData.Message["grid"] = []interface{}{[]interface{}{"128", "2"}}
+
+	_, err = typedData.Digest()
+
+	require.Error(t, err)
+}
+
+func TestDomainFields(t *testing.T) {
+	salt := "0xf2d857f4a3edcb9b78b4d503bfe733db1e3f6cdc2b7971ee739626c97e86a558"
+
+	typedData := &TypedData{
+		Types: Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "salt", Type: "bytes32"},
+				{Name: "custom", Type: "uint256"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+			},
+		},
+		PrimaryType: "Person",
+		Domain: TypedDataDomain{
+			Name: "Subset",
+			// declared fields only are hashed
+			Version: "1",
+			Salt:    salt,
+			Extra:   map[string]interface{}{"custom": "7"},
+		},
+		Message: TypedDataMessage{"name": "Cow"},
+	}
+
+	domainSeparator, err := typedData.DomainSeparator()
+
+	require.NoError(t, err)
+
+	saltBytes, err := Decode(salt)
+
+	require.NoError(t, err)
+
+	expect := Keccak256(
+		Keccak256([]byte("EIP712Domain(string name,bytes32 salt,uint256 custom)")),
+		Keccak256([]byte("Subset")),
+		saltBytes,
+		U256Bytes(big.NewInt(7)),
+	)
+
+	require.Equal(t, expect, domainSeparator)
+
+	// json round trip keeps custom fields
+	data, err := json.Marshal(typedData)
+
+	require.NoError(t, err)
+
+	var decoded TypedData
+
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	require.Equal(t, "7", decoded.Domain.Extra["custom"])
+
+	decodedSeparator, err := decoded.DomainSeparator()
+
+	require.NoError(t, err)
+
+	require.Equal(t, domainSeparator, decodedSeparator)
+
+	// salt must be bytes32
+	typedData.Domain.Salt = "0x01"
+
+	_, err = typedData.DomainSeparator()
+
+	require.Error(t, err)
+}
+
+func ecdsaKey(t *testing.T, seed string) *ecdsa.PrivateKey {
+	return ecdsax.BytesToPrivateKey(Keccak256([]byte(seed)), elliptic.SECP256K1())
+}
@@ -0,0 +1,402 @@
+package eip712
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/libs4go/ethers/address"
+)
+
+var bigIntType = reflect.TypeOf((*big.Int)(nil)).Elem()
+var addressType = reflect.TypeOf((*address.Address)(nil)).Elem()
+
+// TypedDataOf create TypedData from domain and the tagged go struct primary message.
+//
+// Struct fields are mapped to eip712 members in declaration order, the member name
+// and type can be overridden by tag `eip712:"name,type"`, field with tag "-" is skipped.
+// The go struct type name is used as the eip712 struct type name.
+func TypedDataOf(domain TypedDataDomain, message interface{}) (*TypedData, error) {
+	types, primaryType, data, err := StructOf(message)
+
+	if err != nil {
+		return nil, err
+	}
+
+	types["EIP712Domain"] = domain.Types()
+
+	return &TypedData{
+		Types:       types,
+		PrimaryType: primaryType,
+		Domain:      domain,
+		Message:     data,
+	}, nil
+}
+
+// StructOf derive eip712 types and message from tagged go struct, returns the types,
+// the primary type name and the message
+func StructOf(v interface{}) (Types, string, TypedDataMessage, error) {
+	value := reflect.ValueOf(v)
+
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, "", nil, fmt.Errorf("expect struct, got nil %v", value.Type())
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil, "", nil, fmt.Errorf("expect struct, got %v", value.Type())
+	}
+
+	types := make(Types)
+
+	primaryType, err := reflectType(types, value.Type())
+
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	data, err := reflectValue(types, primaryType, value)
+
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	return types, primaryType, data.(TypedDataMessage), nil
+}
+
+type structField struct {
+	index int
+	name  string
+	typ   string
+}
+
+// fieldsOf returns the eip712 members of go struct type
+func fieldsOf(types Types, t reflect.Type) ([]*structField, error) {
+	var fields []*structField
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tag := field.Tag.Get("eip712")
+
+		if tag == "-" {
+			continue
+		}
+
+		var name, typ string
+
+		options := strings.SplitN(tag, ",", 2)
+
+		name = options[0]
+
+		if len(options) == 2 {
+			typ = options[1]
+		}
+
+		if name == "" {
+			runes := []rune(field.Name)
+			runes[0] = unicode.ToLower(runes[0])
+			name = string(runes)
+		}
+
+		if typ == "" {
+			var err error
+			typ, err = reflectType(types, field.Type)
+
+			if err != nil {
+				return nil, fmt.Errorf("field %s.%s: %s", t.Name(), field.Name, err)
+			}
+		}
+
+		fields = append(fields, &structField{
+			index: i,
+			name:  name,
+			typ:   typ,
+		})
+	}
+
+	return fields, nil
+}
+
+// reflectType returns eip712 type name of go type, struct types are registered into types
+func reflectType(types Types, t reflect.Type) (string, error) {
+	switch t {
+	case bigIntType, reflect.PtrTo(bigIntType):
+		return "uint256", nil
+	case addressType:
+		return "address", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return reflectType(types, t.Elem())
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("uint%d", t.Bits()), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("int%d", t.Bits()), nil
+	case reflect.Uint:
+		return "uint256", nil
+	case reflect.Int:
+		return "int256", nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes", nil
+		}
+
+		elem, err := reflectType(types, t.Elem())
+
+		if err != nil {
+			return "", err
+		}
+
+		return elem + "[]", nil
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if t.Len() == 0 || t.Len() > 32 {
+				return "", fmt.Errorf("invalid fixed bytes length %d", t.Len())
+			}
+
+			return fmt.Sprintf("bytes%d", t.Len()), nil
+		}
+
+		elem, err := reflectType(types, t.Elem())
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s[%d]", elem, t.Len()), nil
+	case reflect.Struct:
+		return reflectStruct(types, t)
+	}
+
+	return "", fmt.Errorf("unsupport go type %v", t)
+}
+
+func reflectStruct(types Types, t reflect.Type) (string, error) {
+	name := t.Name()
+
+	if name == "" {
+		return "", fmt.Errorf("anonymous struct %v can't be eip712 struct type", t)
+	}
+
+	if _, ok := types[name]; ok {
+		return name, nil
+	}
+
+	// register placeholder first, to break self reference
+	types[name] = []Type{}
+
+	fields, err := fieldsOf(types, t)
+
+	if err != nil {
+		return "", err
+	}
+
+	var members []Type
+
+	for _, field := range fields {
+		members = append(members, Type{
+			Name: field.name,
+			Type: field.typ,
+		})
+	}
+
+	types[name] = members
+
+	return name, nil
+}
+
+// reflectValue convert go value to the message value format expected by EncodeData
+func reflectValue(types Types, typ string, value reflect.Value) (interface{}, error) {
+	if value.Kind() == reflect.Ptr && value.Type() != reflect.PtrTo(bigIntType) {
+		if value.IsNil() {
+			return nil, fmt.Errorf("nil value for type %s", typ)
+		}
+
+		return reflectValue(types, typ, value.Elem())
+	}
+
+	if members, ok := types[typ]; ok && value.Kind() == reflect.Struct {
+		fields, err := fieldsOf(types, value.Type())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) != len(members) {
+			return nil, fmt.Errorf("struct %v mismatch with eip712 type %s", value.Type(), typ)
+		}
+
+		message := make(TypedDataMessage)
+
+		for i, field := range fields {
+			v, err := reflectValue(types, members[i].Type, value.Field(field.index))
+
+			if err != nil {
+				return nil, err
+			}
+
+			message[field.name] = v
+		}
+
+		return message, nil
+	}
+
+	if strings.HasSuffix(typ, "]") && (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) {
+		elemType := typ[:strings.LastIndex(typ, "[")]
+
+		array := make([]interface{}, 0, value.Len())
+
+		for i := 0; i < value.Len(); i++ {
+			v, err := reflectValue(types, elemType, value.Index(i))
+
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, v)
+		}
+
+		return array, nil
+	}
+
+	switch v := value.Interface().(type) {
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("nil value for type %s", typ)
+		}
+
+		return (*HexOrDecimal256)(v), nil
+	case big.Int:
+		return (*HexOrDecimal256)(&v), nil
+	case address.Address:
+		return v.Hex(), nil
+	}
+
+	switch value.Kind() {
+	case reflect.Bool, reflect.String:
+		return value.Interface(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return (*HexOrDecimal256)(new(big.Int).SetUint64(value.Uint())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return (*HexOrDecimal256)(big.NewInt(value.Int())), nil
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return value.Bytes(), nil
+		}
+	case reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			buff := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(buff), value)
+			return buff, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupport value %v for type %s", value.Type(), typ)
+}
+
+// Types returns EIP712Domain type members of the non-empty domain fields
+func (domain *TypedDataDomain) Types() []Type {
+	var members []Type
+
+	if len(domain.Name) > 0 {
+		members = append(members, Type{Name: "name", Type: "string"})
+	}
+
+	if len(domain.Version) > 0 {
+		members = append(members, Type{Name: "version", Type: "string"})
+	}
+
+	if domain.ChainId != nil {
+		members = append(members, Type{Name: "chainId", Type: "uint256"})
+	}
+
+	if len(domain.VerifyingContract) > 0 {
+		members = append(members, Type{Name: "verifyingContract", Type: "address"})
+	}
+
+	if len(domain.Salt) > 0 {
+		members = append(members, Type{Name: "salt", Type: "bytes32"})
+	}
+
+	return members
+}
+
+// DomainSeparator returns hashStruct(EIP712Domain), only the fields declared by EIP712Domain
+// type are hashed, if the type is absent it is derived from the non-empty domain fields
+func (typedData *TypedData) DomainSeparator() ([]byte, error) {
+	domainData := typedData
+
+	if _, ok := typedData.Types["EIP712Domain"]; !ok {
+		if len(typedData.Domain.Extra) > 0 {
+			return nil, fmt.Errorf("custom domain fields must be declared by EIP712Domain type")
+		}
+
+		types := make(Types, len(typedData.Types)+1)
+
+		for name, members := range typedData.Types {
+			types[name] = members
+		}
+
+		types["EIP712Domain"] = typedData.Domain.Types()
+
+		domainData = &TypedData{
+			Types:       types,
+			PrimaryType: typedData.PrimaryType,
+			Domain:      typedData.Domain,
+			Message:     typedData.Message,
+		}
+	}
+
+	fields := typedData.Domain.Map()
+
+	message := make(TypedDataMessage)
+
+	for _, member := range domainData.Types["EIP712Domain"] {
+		value, ok := fields[member.Name]
+
+		if !ok {
+			return nil, fmt.Errorf("domain field %q declared by EIP712Domain is missing", member.Name)
+		}
+
+		message[member.Name] = value
+	}
+
+	return domainData.HashStruct("EIP712Domain", message)
+}
+
+// StructHash returns hashStruct(message) of primary type
+func (typedData *TypedData) StructHash() ([]byte, error) {
+	return typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+}
+
+// Digest returns the final signing hash keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message))
+func (typedData *TypedData) Digest() ([]byte, error) {
+	domainSeparator, err := typedData.DomainSeparator()
+
+	if err != nil {
+		return nil, err
+	}
+
+	typedDataHash, err := typedData.StructHash()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256([]byte{0x19, 0x01}, domainSeparator, typedDataHash), nil
+}
@@ -0,0 +1,118 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/address"
+	"github.com/stretchr/testify/require"
+)
+
+type Person struct {
+	Name   string
+	Wallet address.Address
+}
+
+type Mail struct {
+	From     Person
+	To       *Person
+	Contents string
+}
+
+// test vector from https://eips.ethereum.org/EIPS/eip-712
+var mailDomain = TypedDataDomain{
+	Name:              "Ether Mail",
+	Version:           "1",
+	ChainId:           NewHexOrDecimal256(1),
+	VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+}
+
+func TestTypedDataOf(t *testing.T) {
+	mail := &Mail{
+		From: Person{
+			Name:   "Cow",
+			Wallet: address.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"),
+		},
+		To: &Person{
+			Name:   "Bob",
+			Wallet: address.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"),
+		},
+		Contents: "Hello, Bob!",
+	}
+
+	typedData, err := TypedDataOf(mailDomain, mail)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "Mail", typedData.PrimaryType)
+
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", string(typedData.EncodeType("Mail")))
+
+	domainSeparator, err := typedData.DomainSeparator()
+
+	require.NoError(t, err)
+
+	require.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	structHash, err := typedData.StructHash()
+
+	require.NoError(t, err)
+
+	require.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(structHash))
+
+	digest, err := typedData.Digest()
+
+	require.NoError(t, err)
+
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(digest))
+}
+
+type Order struct {
+	Maker   address.Address `eip712:"maker"`
+	Amounts []*big.Int      `eip712:"amounts"`
+	Expiry  uint64          `eip712:"expiry"`
+	Salt    [32]byte        `eip712:"salt"`
+	Data    []byte          `eip712:"data"`
+	Fee     *big.Int        `eip712:"fee,uint128"`
+	Legs    []Person        `eip712:"legs"`
+	Ignored string          `eip712:"-"`
+}
+
+func TestStructOf(t *testing.T) {
+	order := &Order{
+		Maker:   address.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"),
+		Amounts: []*big.Int{big.NewInt(1), big.NewInt(2)},
+		Expiry:  100,
+		Salt:    [32]byte{1},
+		Data:    []byte{0xff},
+		Fee:     big.NewInt(3),
+		Legs:    []Person{{Name: "Cow"}},
+	}
+
+	types, primaryType, message, err := StructOf(order)
+
+	require.NoError(t, err)
+
+	require.Equal(t, "Order", primaryType)
+
+	require.Equal(t, []Type{
+		{Name: "maker", Type: "address"},
+		{Name: "amounts", Type: "uint256[]"},
+		{Name: "expiry", Type: "uint64"},
+		{Name: "salt", Type: "bytes32"},
+		{Name: "data", Type: "bytes"},
+		{Name: "fee", Type: "uint128"},
+		{Name: "legs", Type: "Person[]"},
+	}, types["Order"])
+
+	require.Len(t, message, 7)
+
+	typedData, err := TypedDataOf(mailDomain, order)
+
+	require.NoError(t, err)
+
+	_, err = typedData.Digest()
+
+	require.NoError(t, err)
+}
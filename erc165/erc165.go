@@ -0,0 +1,280 @@
+// Package erc165 detects the standards implemented by contract, the interfaces are detected by
+// ERC-165 supportsInterface and the standards without ERC-165 by probing their view functions
+package erc165
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/jsonrpc"
+)
+
+// Standard contract standard
+type Standard string
+
+// Known standards
+const (
+	ERC20              Standard = "ERC-20"
+	ERC721             Standard = "ERC-721"
+	ERC721Metadata     Standard = "ERC-721 Metadata"
+	ERC721Enumerable   Standard = "ERC-721 Enumerable"
+	ERC1155            Standard = "ERC-1155"
+	ERC1155MetadataURI Standard = "ERC-1155 Metadata URI"
+	ERC2981            Standard = "ERC-2981"
+	ERC4626            Standard = "ERC-4626"
+)
+
+// Interface ids
+var (
+	ERC165ID = abi.InterfaceIDOf("supportsInterface(bytes4)")
+
+	ERC721ID = abi.InterfaceIDOf(
+		"balanceOf(address)",
+		"ownerOf(uint256)",
+		"safeTransferFrom(address,address,uint256,bytes)",
+		"safeTransferFrom(address,address,uint256)",
+		"transferFrom(address,address,uint256)",
+		"approve(address,uint256)",
+		"setApprovalForAll(address,bool)",
+		"getApproved(uint256)",
+		"isApprovedForAll(address,address)",
+	)
+
+	ERC721MetadataID = abi.InterfaceIDOf("name()", "symbol()", "tokenURI(uint256)")
+
+	ERC721EnumerableID = abi.InterfaceIDOf("totalSupply()", "tokenOfOwnerByIndex(address,uint256)", "tokenByIndex(uint256)")
+
+	ERC1155ID = abi.InterfaceIDOf(
+		"safeTransferFrom(address,address,uint256,uint256,bytes)",
+		"safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+		"balanceOf(address,uint256)",
+		"balanceOfBatch(address[],uint256[])",
+		"setApprovalForAll(address,bool)",
+		"isApprovedForAll(address,address)",
+	)
+
+	ERC1155MetadataURIID = abi.InterfaceIDOf("uri(uint256)")
+
+	ERC2981ID = abi.InterfaceIDOf("royaltyInfo(uint256,uint256)")
+
+	// InvalidID must not be supported by ERC-165 contract
+	InvalidID = [4]byte{0xff, 0xff, 0xff, 0xff}
+)
+
+// interfaces the standards detected by ERC-165
+var interfaces = []struct {
+	standard Standard
+	id       [4]byte
+}{
+	{ERC721, ERC721ID},
+	{ERC721Metadata, ERC721MetadataID},
+	{ERC721Enumerable, ERC721EnumerableID},
+	{ERC1155, ERC1155ID},
+	{ERC1155MetadataURI, ERC1155MetadataURIID},
+	{ERC2981, ERC2981ID},
+}
+
+// queryGas the gas limit of supportsInterface query required by ERC-165
+const queryGas = "0x7530"
+
+// staticCall eth_call contract, ok is false if the call is reverted or runs out of the query gas,
+// the other errors e.g. transport failure are returned
+func staticCall(ctx context.Context, provider client.Provider, contract string, gas string, data []byte) (ret []byte, ok bool, err error) {
+	result, err := provider.Call(ctx, &client.CallSite{
+		To:   contract,
+		Gas:  gas,
+		Data: "0x" + hex.EncodeToString(data),
+	})
+
+	if err != nil {
+		if client.IsReverted(err) || outOfGas(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, errors.Wrap(err, "call %s error", contract)
+	}
+
+	ret, err = hex.DecodeString(strings.TrimPrefix(result, "0x"))
+
+	if err != nil {
+		return nil, false, errors.Wrap(err, "decode eth_call result %s error", result)
+	}
+
+	return ret, true, nil
+}
+
+// outOfGas check if err is the -32000 out of gas error of eth_call
+func outOfGas(err error) bool {
+	var rpcError *jsonrpc.RPCError
+
+	if !errors.As(err, &rpcError) {
+		return false
+	}
+
+	return rpcError.Code == jsonrpc.RPCServerError && strings.Contains(strings.ToLower(rpcError.Message), "out of gas")
+}
+
+// SupportsInterface call supportsInterface(id) with 30000 gas, the rejected call and the return
+// value other than abi encoded bool are treated as false
+func SupportsInterface(ctx context.Context, provider client.Provider, contract string, id [4]byte) (bool, error) {
+	data := append(abi.Selector("supportsInterface(bytes4)"), make([]byte, 32)...)
+
+	copy(data[4:], id[:])
+
+	ret, ok, err := staticCall(ctx, provider, contract, queryGas, data)
+
+	if err != nil || !ok || len(ret) < 32 {
+		return false, err
+	}
+
+	supported, _, err := abi.DecodeBool(ret)
+
+	return err == nil && supported, nil
+}
+
+// SupportsERC165 ERC-165 detection, supportsInterface(0x01ffc9a7) returns true and
+// supportsInterface(0xffffffff) returns false
+func SupportsERC165(ctx context.Context, provider client.Provider, contract string) (bool, error) {
+	supported, err := SupportsInterface(ctx, provider, contract, ERC165ID)
+
+	if err != nil || !supported {
+		return false, err
+	}
+
+	invalid, err := SupportsInterface(ctx, provider, contract, InvalidID)
+
+	if err != nil {
+		return false, err
+	}
+
+	return !invalid, nil
+}
+
+// Supports check contract implements ERC-165 and the interface id
+func Supports(ctx context.Context, provider client.Provider, contract string, id [4]byte) (bool, error) {
+	ok, err := SupportsERC165(ctx, provider, contract)
+
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return SupportsInterface(ctx, provider, contract, id)
+}
+
+// Report the standards implemented by contract
+type Report struct {
+	ERC165    bool       // contract implements ERC-165
+	Standards []Standard // detected standards
+}
+
+// Implements check standard is detected
+func (report *Report) Implements(standard Standard) bool {
+	for _, s := range report.Standards {
+		if s == standard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Probe detect the standards implemented by contract. ERC-721, ERC-1155, ERC-2981 and their
+// extensions are detected by ERC-165. ERC-20 is detected heuristically by totalSupply(),
+// balanceOf(address) and allowance(address,address) returning uint256 if the contract is not
+// ERC-721 or ERC-1155, and ERC-4626 by asset() returning address and totalAssets() and
+// convertToShares(uint256) returning uint256 besides ERC-20
+func Probe(ctx context.Context, provider client.Provider, contract string) (*Report, error) {
+	report := &Report{}
+
+	ok, err := SupportsERC165(ctx, provider, contract)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		report.ERC165 = true
+
+		for _, i := range interfaces {
+			supported, err := SupportsInterface(ctx, provider, contract, i.id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if supported {
+				report.Standards = append(report.Standards, i.standard)
+			}
+		}
+	}
+
+	if report.Implements(ERC721) || report.Implements(ERC1155) {
+		return report, nil
+	}
+
+	zero := make([]byte, 32)
+
+	ok, err = returnsWord(ctx, provider, contract, "totalSupply()", nil, nil)
+
+	if err != nil || !ok {
+		return report, err
+	}
+
+	ok, err = returnsWord(ctx, provider, contract, "balanceOf(address)", zero, nil)
+
+	if err != nil || !ok {
+		return report, err
+	}
+
+	ok, err = returnsWord(ctx, provider, contract, "allowance(address,address)", append(zero, zero...), nil)
+
+	if err != nil || !ok {
+		return report, err
+	}
+
+	report.Standards = append(report.Standards, ERC20)
+
+	ok, err = returnsWord(ctx, provider, contract, "asset()", nil, isAddress)
+
+	if err != nil || !ok {
+		return report, err
+	}
+
+	ok, err = returnsWord(ctx, provider, contract, "totalAssets()", nil, nil)
+
+	if err != nil || !ok {
+		return report, err
+	}
+
+	ok, err = returnsWord(ctx, provider, contract, "convertToShares(uint256)", zero, nil)
+
+	if err != nil || !ok {
+		return report, err
+	}
+
+	report.Standards = append(report.Standards, ERC4626)
+
+	return report, nil
+}
+
+// returnsWord check the function call returns one abi word accepted by check, nil accepts any word
+func returnsWord(ctx context.Context, provider client.Provider, contract string, signature string, args []byte, check func(word []byte) bool) (bool, error) {
+	ret, ok, err := staticCall(ctx, provider, contract, "", append(abi.Selector(signature), args...))
+
+	if err != nil || !ok || len(ret) != 32 {
+		return false, err
+	}
+
+	return check == nil || check(ret), nil
+}
+
+func isAddress(word []byte) bool {
+	addr, _, err := abi.DecodeAddress(word)
+
+	return err == nil && addr != address.Address{}
+}
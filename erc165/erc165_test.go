@@ -0,0 +1,184 @@
+package erc165
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+const contract = "0x1111111111111111111111111111111111111111"
+
+func TestInterfaceIDs(t *testing.T) {
+	for expect, id := range map[string][4]byte{
+		"01ffc9a7": ERC165ID,
+		"80ac58cd": ERC721ID,
+		"5b5e139f": ERC721MetadataID,
+		"780e9d63": ERC721EnumerableID,
+		"d9b67a26": ERC1155ID,
+		"0e89341c": ERC1155MetadataURIID,
+		"2a55205a": ERC2981ID,
+	} {
+		require.Equal(t, expect, hex.EncodeToString(id[:]))
+	}
+
+	metadata, err := binding.ParseHumanReadable("Metadata", []string{
+		"function name() view returns (string)",
+		"function symbol() view returns (string)",
+		"function tokenURI(uint256 id) view returns (string)",
+	}, binding.NewSymbols())
+
+	require.NoError(t, err)
+
+	require.Equal(t, ERC721MetadataID, abi.ContractInterfaceID(metadata))
+
+	name, ok := abi.TryGetFunc(metadata, "name()")
+
+	require.True(t, ok)
+
+	require.Equal(t, abi.InterfaceIDOf("name()"), abi.InterfaceID(name))
+}
+
+func boolWord(v bool) []byte {
+	word := make([]byte, 32)
+
+	if v {
+		word[31] = 1
+	}
+
+	return word
+}
+
+// handleSupports register supportsInterface returning true for ids
+func handleSupports(provider *clienttest.Provider, ids ...[4]byte) {
+	provider.HandleCall(contract, "supportsInterface(bytes4)", func(data []byte) ([]byte, error) {
+		for _, id := range ids {
+			if string(data[:4]) == string(id[:]) {
+				return boolWord(true), nil
+			}
+		}
+
+		return boolWord(false), nil
+	})
+}
+
+func TestProbeERC721(t *testing.T) {
+	provider := clienttest.New(1)
+
+	handleSupports(provider, ERC165ID, ERC721ID, ERC721MetadataID, ERC2981ID)
+
+	// ERC-721 has balanceOf too, which must not be reported as ERC-20
+	provider.HandleCall(contract, "balanceOf(address)", func(data []byte) ([]byte, error) {
+		return make([]byte, 32), nil
+	})
+
+	report, err := Probe(context.Background(), provider, contract)
+
+	require.NoError(t, err)
+
+	require.True(t, report.ERC165)
+	require.Equal(t, []Standard{ERC721, ERC721Metadata, ERC2981}, report.Standards)
+
+	ok, err := Supports(context.Background(), provider, contract, ERC1155ID)
+
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestProbeInvalidERC165(t *testing.T) {
+	provider := clienttest.New(1)
+
+	// returns true for 0xffffffff
+	handleSupports(provider, ERC165ID, ERC721ID, InvalidID)
+
+	report, err := Probe(context.Background(), provider, contract)
+
+	require.NoError(t, err)
+
+	require.False(t, report.ERC165)
+	require.Empty(t, report.Standards)
+
+	// reverted call is not supported
+	provider.HandleCall(contract, "supportsInterface(bytes4)", func(data []byte) ([]byte, error) {
+		return nil, &jsonrpc.RPCError{Code: 3, Message: "execution reverted"}
+	})
+
+	ok, err := SupportsERC165(context.Background(), provider, contract)
+
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	for _, rejected := range []error{
+		&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "execution reverted"},
+		&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "out of gas"},
+	} {
+		rejected := rejected
+
+		provider.HandleCall(contract, "supportsInterface(bytes4)", func(data []byte) ([]byte, error) {
+			return nil, rejected
+		})
+
+		ok, err = SupportsERC165(context.Background(), provider, contract)
+
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+
+	// transport and node errors are returned
+	for _, failure := range []error{
+		fmt.Errorf("connection refused"),
+		&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "header not found"},
+		&jsonrpc.RPCError{Code: jsonrpc.RPCMethodNotFound, Message: "the method eth_call does not exist"},
+	} {
+		failure := failure
+
+		provider.HandleCall(contract, "supportsInterface(bytes4)", func(data []byte) ([]byte, error) {
+			return nil, failure
+		})
+
+		_, err = SupportsERC165(context.Background(), provider, contract)
+
+		require.Error(t, err)
+	}
+}
+
+func TestProbeERC4626(t *testing.T) {
+	provider := clienttest.New(1)
+
+	uint256 := func(data []byte) ([]byte, error) {
+		return big.NewInt(100).FillBytes(make([]byte, 32)), nil
+	}
+
+	for _, signature := range []string{"totalSupply()", "balanceOf(address)", "allowance(address,address)"} {
+		provider.HandleCall(contract, signature, uint256)
+	}
+
+	report, err := Probe(context.Background(), provider, contract)
+
+	require.NoError(t, err)
+
+	require.False(t, report.ERC165)
+	require.Equal(t, []Standard{ERC20}, report.Standards)
+
+	provider.HandleCall(contract, "asset()", func(data []byte) ([]byte, error) {
+		return abi.AppendAddress(nil, address.HexToAddress("0x2222222222222222222222222222222222222222"))
+	})
+
+	provider.HandleCall(contract, "totalAssets()", uint256)
+	provider.HandleCall(contract, "convertToShares(uint256)", uint256)
+
+	report, err = Probe(context.Background(), provider, contract)
+
+	require.NoError(t, err)
+
+	require.True(t, report.Implements(ERC20))
+	require.True(t, report.Implements(ERC4626))
+}
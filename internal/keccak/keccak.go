@@ -0,0 +1,15 @@
+// Package keccak the legacy keccak256 hash shared by the ethers packages
+package keccak
+
+import "golang.org/x/crypto/sha3"
+
+// Hash returns keccak256 hash of data
+func Hash(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+
+	for _, buff := range data {
+		hasher.Write(buff)
+	}
+
+	return hasher.Sum(nil)
+}
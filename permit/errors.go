@@ -0,0 +1,12 @@
+package permit
+
+import "github.com/libs4go/errors"
+
+// ScopeOfAPIError .
+const errVendor = "ethers-permit"
+
+// errors
+var (
+	ErrDomain    = errors.New("token eip712 domain mismatch", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrSignature = errors.New("invalid signature", errors.WithVendor(errVendor), errors.WithCode(-2))
+)
@@ -0,0 +1,220 @@
+package permit
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/libs4go/ethers/signer"
+)
+
+// Permit EIP-2612 permit message
+type Permit struct {
+	Owner    address.Address
+	Spender  address.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+// Signature the split signature, which can be passed to generated bindings directly,
+// e.g. permit(owner, spender, value, deadline, sig.V, sig.R, sig.S)
+type Signature struct {
+	V   *big.Int
+	R   [32]byte
+	S   [32]byte
+	Raw []byte // 65 bytes r ‖ s ‖ v
+}
+
+// Split split 65 bytes r ‖ s ‖ v or 64 bytes EIP-2098 compact signature, v is normalized to 27/28
+func Split(sig []byte) (*Signature, error) {
+	parsed, err := signer.ParseSignature(sig)
+
+	if err != nil {
+		return nil, errors.Wrap(ErrSignature, "invalid signature: %s", err)
+	}
+
+	v, r, s := parsed.VRS(nil)
+
+	signature := &Signature{
+		V:   v,
+		Raw: parsed.Bytes(),
+	}
+
+	r.FillBytes(signature.R[:])
+	s.FillBytes(signature.S[:])
+
+	return signature, nil
+}
+
+// Sign sign typed data with signer and split the signature
+func Sign(s signer.Signer, typedData *signer.TypedData) (*Signature, error) {
+	sig, err := s.SignTypedData(typedData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Split(sig)
+}
+
+// TokenDomain read the token eip712 domain by eth_call name(), version() and DOMAIN_SEPARATOR(),
+// version "1" is used if the token doesn't implement version(). The domain is verified against
+// the on-chain DOMAIN_SEPARATOR
+func TokenDomain(ctx context.Context, provider client.Provider, token string) (*eip712.TypedDataDomain, error) {
+	chainID, err := provider.ChainID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+
+	if err := call(ctx, provider, token, "name()", nil, nil, []abi.Encoder{ensure(abi.Builtin("string"))}, &name); err != nil {
+		return nil, errors.Wrap(err, "call %s name() error", token)
+	}
+
+	var separator [32]byte
+
+	if err := call(ctx, provider, token, "DOMAIN_SEPARATOR()", nil, nil, []abi.Encoder{ensure(abi.Builtin("bytes32"))}, &separator); err != nil {
+		return nil, errors.Wrap(err, "call %s DOMAIN_SEPARATOR() error", token)
+	}
+
+	var candidates []string
+
+	var version string
+
+	if err := call(ctx, provider, token, "version()", nil, nil, []abi.Encoder{ensure(abi.Builtin("string"))}, &version); err == nil {
+		candidates = append(candidates, version)
+	}
+
+	candidates = append(candidates, "1", "2")
+
+	for _, version := range candidates {
+		domain := &eip712.TypedDataDomain{
+			Name:              name,
+			Version:           version,
+			ChainId:           (*eip712.HexOrDecimal256)(new(big.Int).SetUint64(chainID)),
+			VerifyingContract: address.HexToAddress(token).Hex(),
+		}
+
+		typedData := &eip712.TypedData{
+			Types:  eip712.Types{"EIP712Domain": domain.Types()},
+			Domain: *domain,
+		}
+
+		hash, err := typedData.DomainSeparator()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(hash, separator[:]) {
+			return domain, nil
+		}
+	}
+
+	return nil, errors.Wrap(ErrDomain, "token %s DOMAIN_SEPARATOR 0x%x mismatch with domain(name: %s, chainId: %d)", token, separator, name, chainID)
+}
+
+// Nonce read the token nonces(owner)
+func Nonce(ctx context.Context, provider client.Provider, token string, owner string) (*big.Int, error) {
+	var nonce *big.Int
+
+	err := call(ctx, provider, token, "nonces(address)", []abi.Encoder{ensure(abi.Builtin("address"))}, []interface{}{address.HexToAddress(owner)}, []abi.Encoder{ensure(abi.Builtin("uint256"))}, &nonce)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "call %s nonces(%s) error", token, owner)
+	}
+
+	return nonce, nil
+}
+
+// NewPermit create EIP-2612 permit typed data, the token domain and owner nonce are read on-chain
+func NewPermit(ctx context.Context, provider client.Provider, token string, owner string, spender string, value *big.Int, deadline *big.Int) (*signer.TypedData, error) {
+	domain, err := TokenDomain(ctx, provider, token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := Nonce(ctx, provider, token, owner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.NewTypedData(*domain, &Permit{
+		Owner:    address.HexToAddress(owner),
+		Spender:  address.HexToAddress(spender),
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: deadline,
+	})
+}
+
+// SignPermit create and sign EIP-2612 permit of the signer account
+func SignPermit(ctx context.Context, provider client.Provider, s signer.Signer, token string, spender string, value *big.Int, deadline *big.Int) (*Signature, error) {
+	typedData, err := NewPermit(ctx, provider, token, s.Addresss(), spender, value, deadline)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Sign(s, typedData)
+}
+
+func ensure(encoder abi.Encoder, ok bool) abi.Encoder {
+	if !ok {
+		panic("builtin encoder not found")
+	}
+
+	return encoder
+}
+
+// call eth_call contract method and unmarshal the returns into values
+func call(ctx context.Context, provider client.Provider, to string, signature string, inputs []abi.Encoder, args []interface{}, outputs []abi.Encoder, values ...interface{}) error {
+	encoder, err := abi.Tuple("inputs", inputs...)
+
+	if err != nil {
+		return err
+	}
+
+	data, err := encoder.Marshal(args)
+
+	if err != nil {
+		return err
+	}
+
+	ret, err := provider.Call(ctx, &client.CallSite{
+		To:   to,
+		Data: "0x" + hex.EncodeToString(append(abi.Selector(signature), data...)),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	buff, err := hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return errors.Wrap(err, "decode eth_call result %s error", ret)
+	}
+
+	decoder, err := abi.Tuple("outputs", outputs...)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = decoder.Unmarshal(buff, values)
+
+	return err
+}
@@ -0,0 +1,116 @@
+package permit
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/libs4go/ethers/signer"
+)
+
+// Permit2Address the canonical uniswap Permit2 contract address, same on all chains
+const Permit2Address = "0x000000000022D473030F116dDEE9F6B43aC78BA3"
+
+// PermitDetails Permit2 AllowanceTransfer permit details
+type PermitDetails struct {
+	Token      address.Address
+	Amount     *big.Int `eip712:"amount,uint160"`
+	Expiration *big.Int `eip712:"expiration,uint48"`
+	Nonce      *big.Int `eip712:"nonce,uint48"`
+}
+
+// PermitSingle Permit2 AllowanceTransfer single token permit
+type PermitSingle struct {
+	Details     PermitDetails
+	Spender     address.Address
+	SigDeadline *big.Int
+}
+
+// PermitBatch Permit2 AllowanceTransfer multiple tokens permit
+type PermitBatch struct {
+	Details     []PermitDetails
+	Spender     address.Address
+	SigDeadline *big.Int
+}
+
+// TokenPermissions Permit2 SignatureTransfer token and amount
+type TokenPermissions struct {
+	Token  address.Address
+	Amount *big.Int
+}
+
+// PermitTransferFrom Permit2 SignatureTransfer single token permit, spender is the caller of permitTransferFrom
+type PermitTransferFrom struct {
+	Permitted TokenPermissions
+	Spender   address.Address
+	Nonce     *big.Int
+	Deadline  *big.Int
+}
+
+// PermitBatchTransferFrom Permit2 SignatureTransfer multiple tokens permit
+type PermitBatchTransferFrom struct {
+	Permitted []TokenPermissions
+	Spender   address.Address
+	Nonce     *big.Int
+	Deadline  *big.Int
+}
+
+// Permit2Domain the Permit2 eip712 domain, which has no version field
+func Permit2Domain(chainID uint64, permit2 string) eip712.TypedDataDomain {
+	return eip712.TypedDataDomain{
+		Name:              "Permit2",
+		ChainId:           (*eip712.HexOrDecimal256)(new(big.Int).SetUint64(chainID)),
+		VerifyingContract: address.HexToAddress(permit2).Hex(),
+	}
+}
+
+// Permit2Allowance read Permit2 allowance(owner, token, spender), the returned nonce is used for the next PermitDetails
+func Permit2Allowance(ctx context.Context, provider client.Provider, permit2 string, owner string, token string, spender string) (amount *big.Int, expiration *big.Int, nonce *big.Int, err error) {
+	addressEncoder := ensure(abi.Builtin("address"))
+
+	err = call(ctx, provider, permit2, "allowance(address,address,address)",
+		[]abi.Encoder{addressEncoder, addressEncoder, addressEncoder},
+		[]interface{}{address.HexToAddress(owner), address.HexToAddress(token), address.HexToAddress(spender)},
+		[]abi.Encoder{ensure(abi.Builtin("uint160")), ensure(abi.Builtin("uint48")), ensure(abi.Builtin("uint48"))},
+		&amount, &expiration, &nonce)
+
+	if err != nil {
+		err = errors.Wrap(err, "call %s allowance(%s,%s,%s) error", permit2, owner, token, spender)
+	}
+
+	return
+}
+
+// NewPermit2 create Permit2 typed data, message must be one of PermitSingle, PermitBatch,
+// PermitTransferFrom or PermitBatchTransferFrom
+func NewPermit2(chainID uint64, permit2 string, message interface{}) (*signer.TypedData, error) {
+	switch message.(type) {
+	case *PermitSingle, *PermitBatch, *PermitTransferFrom, *PermitBatchTransferFrom:
+	case PermitSingle, PermitBatch, PermitTransferFrom, PermitBatchTransferFrom:
+	default:
+		return nil, errors.Wrap(ErrSignature, "unsupport Permit2 message %T", message)
+	}
+
+	return signer.NewTypedData(Permit2Domain(chainID, permit2), message)
+}
+
+// SignPermit2 create and sign Permit2 typed data, the chain id is read from provider
+func SignPermit2(ctx context.Context, provider client.Provider, s signer.Signer, permit2 string, message interface{}) (*Signature, error) {
+	chainID, err := provider.ChainID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	typedData, err := NewPermit2(chainID, permit2, message)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Sign(s, typedData)
+}
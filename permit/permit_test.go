@@ -0,0 +1,199 @@
+package permit
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/libs4go/ethers/signer"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
+
+func openWallet(t *testing.T) signer.Signer {
+	s, err := signer.OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	return s
+}
+
+func mockToken(t *testing.T, provider *clienttest.Provider, name string, version string, implementVersion bool) {
+	stringEncoder, _ := abi.Builtin("string")
+	uintEncoder, _ := abi.Builtin("uint256")
+
+	domain := &eip712.TypedDataDomain{
+		Name:              name,
+		Version:           version,
+		ChainId:           eip712.NewHexOrDecimal256(56),
+		VerifyingContract: tokenAddress,
+	}
+
+	separator, err := (&eip712.TypedData{Domain: *domain}).DomainSeparator()
+
+	require.NoError(t, err)
+
+	stringReturn := func(s string) clienttest.CallHandler {
+		return func(data []byte) ([]byte, error) {
+			encoder, _ := abi.Tuple("outputs", stringEncoder)
+			return encoder.Marshal([]interface{}{s})
+		}
+	}
+
+	provider.HandleCall(tokenAddress, "name()", stringReturn(name))
+
+	if implementVersion {
+		provider.HandleCall(tokenAddress, "version()", stringReturn(version))
+	}
+
+	provider.HandleCall(tokenAddress, "DOMAIN_SEPARATOR()", func(data []byte) ([]byte, error) {
+		return separator, nil
+	})
+
+	provider.HandleCall(tokenAddress, "nonces(address)", func(data []byte) ([]byte, error) {
+		return uintEncoder.Marshal(big.NewInt(3))
+	})
+}
+
+func TestPermitTypeHash(t *testing.T) {
+	typedData, err := eip712.TypedDataOf(eip712.TypedDataDomain{Name: "Token"}, &Permit{
+		Value:    big.NewInt(0),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(0),
+	})
+
+	require.NoError(t, err)
+
+	require.Equal(t, "6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c9", hex.EncodeToString(typedData.TypeHash("Permit")))
+}
+
+func TestSignPermit(t *testing.T) {
+	s := openWallet(t)
+
+	for _, implementVersion := range []bool{true, false} {
+		provider := clienttest.New(56)
+
+		mockToken(t, provider, "Tether USD", "1", implementVersion)
+
+		spender := "0x44A347Cf7278685320a05Cb39e903C42e472e262"
+
+		typedData, err := NewPermit(context.Background(), provider, tokenAddress, s.Addresss(), spender, big.NewInt(100), big.NewInt(1700000000))
+
+		require.NoError(t, err)
+
+		require.Equal(t, "1", typedData.Domain.Version)
+
+		require.Equal(t, int64(3), (*big.Int)(typedData.Message["nonce"].(*eip712.HexOrDecimal256)).Int64())
+
+		sig, err := SignPermit(context.Background(), provider, s, tokenAddress, spender, big.NewInt(100), big.NewInt(1700000000))
+
+		require.NoError(t, err)
+
+		require.True(t, sig.V.Int64() == 27 || sig.V.Int64() == 28)
+
+		addr, err := signer.VerifyTypedData(typedData, sig.Raw)
+
+		require.NoError(t, err)
+
+		require.Equal(t, s.Addresss(), addr)
+	}
+}
+
+func TestTokenDomainMismatch(t *testing.T) {
+	provider := clienttest.New(56)
+
+	mockToken(t, provider, "Tether USD", "5", false)
+
+	_, err := TokenDomain(context.Background(), provider, tokenAddress)
+
+	require.Error(t, err)
+}
+
+func TestPermit2TypeHash(t *testing.T) {
+	single, err := NewPermit2(1, Permit2Address, &PermitSingle{
+		Details: PermitDetails{
+			Token:      address.HexToAddress(tokenAddress),
+			Amount:     big.NewInt(100),
+			Expiration: big.NewInt(1700000000),
+			Nonce:      big.NewInt(0),
+		},
+		Spender:     address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		SigDeadline: big.NewInt(1700000000),
+	})
+
+	require.NoError(t, err)
+
+	require.Equal(t, "PermitSingle(PermitDetails details,address spender,uint256 sigDeadline)PermitDetails(address token,uint160 amount,uint48 expiration,uint48 nonce)", string((*eip712.TypedData)(single).EncodeType("PermitSingle")))
+
+	require.Equal(t, "f3841cd1ff0085026a6327b620b67997ce40f282c88a8e905a7a5626e310f3d0", hex.EncodeToString((*eip712.TypedData)(single).TypeHash("PermitSingle")))
+
+	batch, err := NewPermit2(1, Permit2Address, &PermitBatch{
+		Details:     []PermitDetails{},
+		Spender:     address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		SigDeadline: big.NewInt(1700000000),
+	})
+
+	require.NoError(t, err)
+
+	require.Equal(t, "PermitBatch(PermitDetails[] details,address spender,uint256 sigDeadline)PermitDetails(address token,uint160 amount,uint48 expiration,uint48 nonce)", string((*eip712.TypedData)(batch).EncodeType("PermitBatch")))
+
+	transfer, err := NewPermit2(1, Permit2Address, &PermitTransferFrom{
+		Permitted: TokenPermissions{
+			Token:  address.HexToAddress(tokenAddress),
+			Amount: big.NewInt(100),
+		},
+		Spender:  address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(1700000000),
+	})
+
+	require.NoError(t, err)
+
+	require.Equal(t, "PermitTransferFrom(TokenPermissions permitted,address spender,uint256 nonce,uint256 deadline)TokenPermissions(address token,uint256 amount)", string((*eip712.TypedData)(transfer).EncodeType("PermitTransferFrom")))
+
+	require.Equal(t, "939c21a48a8dbe3a9a2404a1d46691e4d39f6583d6ec6b35714604c986d80106", hex.EncodeToString((*eip712.TypedData)(transfer).TypeHash("PermitTransferFrom")))
+
+	_, err = NewPermit2(1, Permit2Address, &Permit{})
+
+	require.Error(t, err)
+}
+
+func TestSignPermit2(t *testing.T) {
+	s := openWallet(t)
+
+	provider := clienttest.New(1)
+
+	message := &PermitBatchTransferFrom{
+		Permitted: []TokenPermissions{
+			{Token: address.HexToAddress(tokenAddress), Amount: big.NewInt(100)},
+			{Token: address.HexToAddress(Permit2Address), Amount: big.NewInt(200)},
+		},
+		Spender:  address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		Nonce:    big.NewInt(7),
+		Deadline: big.NewInt(1700000000),
+	}
+
+	sig, err := SignPermit2(context.Background(), provider, s, Permit2Address, message)
+
+	require.NoError(t, err)
+
+	typedData, err := NewPermit2(1, Permit2Address, message)
+
+	require.NoError(t, err)
+
+	_, hasVersion := typedData.Domain.Map()["version"]
+
+	require.False(t, hasVersion)
+
+	addr, err := signer.VerifyTypedData(typedData, sig.Raw)
+
+	require.NoError(t, err)
+
+	require.Equal(t, s.Addresss(), addr)
+}
@@ -0,0 +1,13 @@
+package proxy
+
+import "github.com/libs4go/errors"
+
+// ScopeOfAPIError .
+const errVendor = "ethers-proxy"
+
+// errors
+var (
+	ErrStorage = errors.New("invalid proxy storage slot", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrBeacon  = errors.New("beacon implementation error", errors.WithVendor(errVendor), errors.WithCode(-2))
+	ErrDiamond = errors.New("diamond loupe error", errors.WithVendor(errVendor), errors.WithCode(-3))
+)
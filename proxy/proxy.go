@@ -0,0 +1,418 @@
+// Package proxy resolves the implementations of upgradeable proxy contracts, which are EIP-1967
+// transparent/UUPS proxies, EIP-1967 beacon proxies, EIP-1822 proxiable contracts and EIP-2535
+// diamonds
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/internal/keccak"
+)
+
+// eip1967Slot returns bytes32(uint256(keccak256(name)) - 1)
+func eip1967Slot(name string) string {
+	slot := new(big.Int).SetBytes(keccak.Hash([]byte(name)))
+
+	return "0x" + hex.EncodeToString(slot.Sub(slot, big.NewInt(1)).FillBytes(make([]byte, 32)))
+}
+
+// Proxy storage slots
+var (
+	ImplementationSlot = eip1967Slot("eip1967.proxy.implementation")                 // EIP-1967 implementation slot
+	AdminSlot          = eip1967Slot("eip1967.proxy.admin")                          // EIP-1967 admin slot
+	BeaconSlot         = eip1967Slot("eip1967.proxy.beacon")                         // EIP-1967 beacon slot
+	ProxiableSlot      = "0x" + hex.EncodeToString(keccak.Hash([]byte("PROXIABLE"))) // EIP-1822 logic contract slot
+)
+
+// Kind proxy standard
+type Kind int
+
+// Proxy kinds
+const (
+	None    Kind = iota // not a proxy
+	EIP1967             // EIP-1967 transparent or UUPS proxy
+	Beacon              // EIP-1967 beacon proxy
+	EIP1822             // EIP-1822 proxiable
+	Diamond             // EIP-2535 diamond
+)
+
+func (kind Kind) String() string {
+	switch kind {
+	case EIP1967:
+		return "EIP-1967"
+	case Beacon:
+		return "EIP-1967 beacon"
+	case EIP1822:
+		return "EIP-1822"
+	case Diamond:
+		return "EIP-2535 diamond"
+	default:
+		return "none"
+	}
+}
+
+// Facet diamond facet and the function selectors routed to it
+type Facet struct {
+	Address   address.Address
+	Selectors [][4]byte
+}
+
+// Info resolved proxy state at block
+type Info struct {
+	Address        address.Address // proxy address
+	Kind           Kind            // proxy standard, None if the contract is not a proxy
+	Implementation address.Address // current implementation, zero for diamond
+	Admin          address.Address // EIP-1967 admin, zero if unset
+	Beacon         address.Address // EIP-1967 beacon, zero if not beacon proxy
+	Facets         []*Facet        // diamond facets sorted by address, selectors are sorted too
+}
+
+// Implementations returns the logic contracts of proxy, which are the facets of diamond
+func (info *Info) Implementations() []address.Address {
+	if info.Kind == Diamond {
+		var result []address.Address
+
+		for _, facet := range info.Facets {
+			result = append(result, facet.Address)
+		}
+
+		return result
+	}
+
+	if info.Kind == None {
+		return nil
+	}
+
+	return []address.Address{info.Implementation}
+}
+
+// FacetOf returns the diamond facet routing selector
+func (info *Info) FacetOf(selector [4]byte) (address.Address, bool) {
+	for _, facet := range info.Facets {
+		for _, s := range facet.Selectors {
+			if s == selector {
+				return facet.Address, true
+			}
+		}
+	}
+
+	return address.Address{}, false
+}
+
+// Uncovered returns the diamond selectors not declared by contract abi, e.g. the abi merged from
+// facet abis by binding.MergeABI is checked to cover the whole diamond
+func (info *Info) Uncovered(contract abi.Contract) [][4]byte {
+	var result [][4]byte
+
+	for _, facet := range info.Facets {
+		for _, selector := range facet.Selectors {
+			if _, ok := contract.Select(hex.EncodeToString(selector[:])); !ok {
+				result = append(result, selector)
+			}
+		}
+	}
+
+	return result
+}
+
+// Equal check proxies have same kind, implementation, admin, beacon and facets
+func (info *Info) Equal(other *Info) bool {
+	if info.Kind != other.Kind || info.Implementation != other.Implementation || info.Admin != other.Admin || info.Beacon != other.Beacon {
+		return false
+	}
+
+	if len(info.Facets) != len(other.Facets) {
+		return false
+	}
+
+	for i, facet := range info.Facets {
+		if facet.Address != other.Facets[i].Address || len(facet.Selectors) != len(other.Facets[i].Selectors) {
+			return false
+		}
+
+		for j, selector := range facet.Selectors {
+			if selector != other.Facets[i].Selectors[j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Resolve read the proxy state of contract at block number, nil is the latest block. The EIP-1967
+// implementation and beacon slots are read first, the beacon is followed by calling implementation(),
+// then the EIP-1822 slot and at last the diamond loupe facetAddresses/facetFunctionSelectors
+func Resolve(ctx context.Context, provider client.Provider, contract string, number *uint64) (*Info, error) {
+	info := &Info{
+		Address: address.HexToAddress(contract),
+	}
+
+	admin, err := storageAddress(ctx, provider, contract, AdminSlot, number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	implementation, err := storageAddress(ctx, provider, contract, ImplementationSlot, number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if implementation != (address.Address{}) {
+		info.Kind = EIP1967
+		info.Implementation = implementation
+		info.Admin = admin
+
+		return info, nil
+	}
+
+	beacon, err := storageAddress(ctx, provider, contract, BeaconSlot, number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if beacon != (address.Address{}) {
+		implementation, err := beaconImplementation(ctx, provider, beacon, number)
+
+		if err != nil {
+			return nil, err
+		}
+
+		info.Kind = Beacon
+		info.Implementation = implementation
+		info.Admin = admin
+		info.Beacon = beacon
+
+		return info, nil
+	}
+
+	implementation, err = storageAddress(ctx, provider, contract, ProxiableSlot, number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if implementation != (address.Address{}) {
+		info.Kind = EIP1822
+		info.Implementation = implementation
+
+		return info, nil
+	}
+
+	facets, err := diamondFacets(ctx, provider, contract, number)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(facets) > 0 {
+		info.Kind = Diamond
+		info.Facets = facets
+	}
+
+	return info, nil
+}
+
+// Upgrade proxy state change between blocks
+type Upgrade struct {
+	From   uint64
+	To     uint64
+	Before *Info
+	After  *Info
+}
+
+// DetectUpgrade resolve proxy at block from and to, returns nil if the proxy is not changed
+func DetectUpgrade(ctx context.Context, provider client.Provider, contract string, from uint64, to uint64) (*Upgrade, error) {
+	before, err := Resolve(ctx, provider, contract, &from)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve proxy %s at block %d error", contract, from)
+	}
+
+	after, err := Resolve(ctx, provider, contract, &to)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve proxy %s at block %d error", contract, to)
+	}
+
+	if before.Equal(after) {
+		return nil, nil
+	}
+
+	return &Upgrade{
+		From:   from,
+		To:     to,
+		Before: before,
+		After:  after,
+	}, nil
+}
+
+// storageAddress read the address stored in the low 20 bytes of slot
+func storageAddress(ctx context.Context, provider client.Provider, contract string, slot string, number *uint64) (address.Address, error) {
+	ret, err := provider.GetStorageAt(ctx, contract, slot, number)
+
+	if err != nil {
+		return address.Address{}, errors.Wrap(err, "get storage %s of %s error", slot, contract)
+	}
+
+	buff, err := hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil || len(buff) > 32 {
+		return address.Address{}, errors.Wrap(ErrStorage, "invalid storage %s of %s: %s", slot, contract, ret)
+	}
+
+	buff = append(make([]byte, 32-len(buff)), buff...)
+
+	if !bytes.Equal(buff[:12], make([]byte, 12)) {
+		return address.Address{}, errors.Wrap(ErrStorage, "storage %s of %s is not address: %s", slot, contract, ret)
+	}
+
+	return address.BytesToAddress(buff[12:]), nil
+}
+
+// call eth_call contract at block, returns nil if contract returns empty data
+func call(ctx context.Context, provider client.Provider, contract string, signature string, args []byte, number *uint64) ([]byte, error) {
+	ret, err := provider.CallAt(ctx, &client.CallSite{
+		To:   contract,
+		Data: "0x" + hex.EncodeToString(append(abi.Selector(signature), args...)),
+	}, number)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "call %s %s error", contract, signature)
+	}
+
+	buff, err := hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "decode eth_call result %s error", ret)
+	}
+
+	return buff, nil
+}
+
+func beaconImplementation(ctx context.Context, provider client.Provider, beacon address.Address, number *uint64) (address.Address, error) {
+	ret, err := call(ctx, provider, beacon.Hex(), "implementation()", nil, number)
+
+	if err != nil {
+		return address.Address{}, err
+	}
+
+	implementation, _, err := abi.DecodeAddress(ret)
+
+	if err != nil {
+		return address.Address{}, errors.Wrap(ErrBeacon, "beacon %s implementation() returns 0x%x", beacon.Hex(), ret)
+	}
+
+	return implementation, nil
+}
+
+// diamondFacets returns nil if contract doesn't implement diamond loupe
+func diamondFacets(ctx context.Context, provider client.Provider, contract string, number *uint64) ([]*Facet, error) {
+	ret, err := call(ctx, provider, contract, "facetAddresses()", nil, number)
+
+	// the non diamond contract may revert or return empty data, the other errors e.g. transport
+	// failure are returned
+	if err != nil {
+		if client.IsReverted(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if len(ret) == 0 {
+		return nil, nil
+	}
+
+	addressEncoder, err := abi.Address()
+
+	if err != nil {
+		return nil, err
+	}
+
+	addressesEncoder, err := abi.Array(addressEncoder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []address.Address
+
+	if err := unmarshalReturn(addressesEncoder, ret, &addresses); err != nil {
+		return nil, errors.Wrap(ErrDiamond, "decode %s facetAddresses() error: %s", contract, err)
+	}
+
+	selectorEncoder, err := abi.FixedBytes(4)
+
+	if err != nil {
+		return nil, err
+	}
+
+	selectorsEncoder, err := abi.Array(selectorEncoder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var facets []*Facet
+
+	for _, facet := range addresses {
+		args, err := abi.AppendAddress(nil, facet)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ret, err := call(ctx, provider, contract, "facetFunctionSelectors(address)", args, number)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var selectors [][4]byte
+
+		if err := unmarshalReturn(selectorsEncoder, ret, &selectors); err != nil {
+			return nil, errors.Wrap(ErrDiamond, "decode %s facetFunctionSelectors(%s) error: %s", contract, facet.Hex(), err)
+		}
+
+		sort.Slice(selectors, func(i, j int) bool {
+			return bytes.Compare(selectors[i][:], selectors[j][:]) < 0
+		})
+
+		facets = append(facets, &Facet{
+			Address:   facet,
+			Selectors: selectors,
+		})
+	}
+
+	sort.Slice(facets, func(i, j int) bool {
+		return bytes.Compare(facets[i].Address[:], facets[j].Address[:]) < 0
+	})
+
+	return facets, nil
+}
+
+// unmarshalReturn unmarshal the single dynamic return value
+func unmarshalReturn(encoder abi.Encoder, data []byte, value interface{}) error {
+	decoder, err := abi.Tuple("outputs", encoder)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = decoder.Unmarshal(data, []interface{}{value})
+
+	return err
+}
@@ -0,0 +1,248 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/jsonrpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	proxyAddress = "0x1111111111111111111111111111111111111111"
+	implV1       = "0x2222222222222222222222222222222222222222"
+	implV2       = "0x3333333333333333333333333333333333333333"
+	adminAddress = "0x4444444444444444444444444444444444444444"
+	beacon       = "0x5555555555555555555555555555555555555555"
+)
+
+func TestSlots(t *testing.T) {
+	require.Equal(t, "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc", ImplementationSlot)
+	require.Equal(t, "0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103", AdminSlot)
+	require.Equal(t, "0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50", BeaconSlot)
+	require.Equal(t, "0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7", ProxiableSlot)
+}
+
+func TestEIP1967Upgrade(t *testing.T) {
+	provider := clienttest.New(1)
+
+	provider.SetBlockNumber(10)
+
+	require.NoError(t, provider.SetStorageAt(proxyAddress, ImplementationSlot, address.HexToAddress(implV1).Bytes()))
+	require.NoError(t, provider.SetStorageAt(proxyAddress, AdminSlot, address.HexToAddress(adminAddress).Bytes()))
+
+	provider.SetBlockNumber(20)
+
+	require.NoError(t, provider.SetStorageAt(proxyAddress, ImplementationSlot, address.HexToAddress(implV2).Bytes()))
+
+	info, err := Resolve(context.Background(), provider, proxyAddress, nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, EIP1967, info.Kind)
+	require.Equal(t, address.HexToAddress(implV2), info.Implementation)
+	require.Equal(t, address.HexToAddress(adminAddress), info.Admin)
+	require.Equal(t, []address.Address{address.HexToAddress(implV2)}, info.Implementations())
+
+	upgrade, err := DetectUpgrade(context.Background(), provider, proxyAddress, 10, 15)
+
+	require.NoError(t, err)
+	require.Nil(t, upgrade)
+
+	upgrade, err = DetectUpgrade(context.Background(), provider, proxyAddress, 15, 20)
+
+	require.NoError(t, err)
+	require.NotNil(t, upgrade)
+	require.Equal(t, address.HexToAddress(implV1), upgrade.Before.Implementation)
+	require.Equal(t, address.HexToAddress(implV2), upgrade.After.Implementation)
+
+	// slot with dirty high bytes is not an address
+	require.NoError(t, provider.SetStorageAt(proxyAddress, ImplementationSlot, make([]byte, 32)))
+	require.NoError(t, provider.SetStorageAt(proxyAddress, ProxiableSlot, append([]byte{1}, make([]byte, 31)...)))
+
+	_, err = Resolve(context.Background(), provider, proxyAddress, nil)
+
+	require.Error(t, err)
+}
+
+func addressReturn(t *testing.T, addr string) []byte {
+	buff, err := abi.AppendAddress(nil, address.HexToAddress(addr))
+
+	require.NoError(t, err)
+
+	return buff
+}
+
+func TestBeaconAndEIP1822(t *testing.T) {
+	provider := clienttest.New(1)
+
+	require.NoError(t, provider.SetStorageAt(proxyAddress, BeaconSlot, address.HexToAddress(beacon).Bytes()))
+
+	provider.HandleCall(beacon, "implementation()", func(data []byte) ([]byte, error) {
+		return addressReturn(t, implV1), nil
+	})
+
+	info, err := Resolve(context.Background(), provider, proxyAddress, nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, Beacon, info.Kind)
+	require.Equal(t, address.HexToAddress(beacon), info.Beacon)
+	require.Equal(t, address.HexToAddress(implV1), info.Implementation)
+
+	require.NoError(t, provider.SetStorageAt(implV2, ProxiableSlot, address.HexToAddress(implV1).Bytes()))
+
+	info, err = Resolve(context.Background(), provider, implV2, nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, EIP1822, info.Kind)
+	require.Equal(t, address.HexToAddress(implV1), info.Implementation)
+
+	info, err = Resolve(context.Background(), provider, adminAddress, nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, None, info.Kind)
+	require.Empty(t, info.Implementations())
+}
+
+func selector(signature string) [4]byte {
+	var result [4]byte
+
+	copy(result[:], abi.Selector(signature))
+
+	return result
+}
+
+func TestDiamond(t *testing.T) {
+	provider := clienttest.New(1)
+
+	facets := map[address.Address][][4]byte{
+		address.HexToAddress(implV2): {selector("withdraw(uint256)"), selector("deposit(uint256)")},
+		address.HexToAddress(implV1): {selector("owner()")},
+	}
+
+	addressEncoder, err := abi.Address()
+	require.NoError(t, err)
+
+	addressesEncoder, err := abi.Array(addressEncoder)
+	require.NoError(t, err)
+
+	bytes4Encoder, err := abi.FixedBytes(4)
+	require.NoError(t, err)
+
+	selectorsEncoder, err := abi.Array(bytes4Encoder)
+	require.NoError(t, err)
+
+	provider.HandleCall(proxyAddress, "facetAddresses()", func(data []byte) ([]byte, error) {
+		encoder, err := abi.Tuple("outputs", addressesEncoder)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return encoder.Marshal([]interface{}{[]address.Address{address.HexToAddress(implV2), address.HexToAddress(implV1)}})
+	})
+
+	provider.HandleCall(proxyAddress, "facetFunctionSelectors(address)", func(data []byte) ([]byte, error) {
+		facet, _, err := abi.DecodeAddress(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		encoder, err := abi.Tuple("outputs", selectorsEncoder)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return encoder.Marshal([]interface{}{facets[facet]})
+	})
+
+	info, err := Resolve(context.Background(), provider, proxyAddress, nil)
+
+	require.NoError(t, err)
+
+	require.Equal(t, Diamond, info.Kind)
+	require.Equal(t, []address.Address{address.HexToAddress(implV1), address.HexToAddress(implV2)}, info.Implementations())
+
+	facet, ok := info.FacetOf(selector("deposit(uint256)"))
+
+	require.True(t, ok)
+	require.Equal(t, address.HexToAddress(implV2), facet)
+
+	vault, err := json.Marshal(mustFragments(t, "function deposit(uint256 amount)", "function withdraw(uint256 amount)"))
+	require.NoError(t, err)
+
+	ownable, err := json.Marshal(mustFragments(t, "function owner() view returns (address)"))
+	require.NoError(t, err)
+
+	merged, err := binding.MergeABI(vault, ownable)
+	require.NoError(t, err)
+
+	contract, err := binding.Parse("Diamond", merged, binding.NewSymbols())
+	require.NoError(t, err)
+
+	require.Empty(t, info.Uncovered(contract))
+
+	contract, err = binding.Parse("Diamond", vault, binding.NewSymbols())
+	require.NoError(t, err)
+
+	require.Equal(t, [][4]byte{selector("owner()")}, info.Uncovered(contract))
+}
+
+func mustFragments(t *testing.T, fragments ...string) []*abi.JSONField {
+	fields, err := abi.ParseHumanReadable(fragments...)
+
+	require.NoError(t, err)
+
+	return fields
+}
+
+func TestDiamondCallError(t *testing.T) {
+	provider := clienttest.New(1)
+
+	// reverted facetAddresses() is not diamond
+	provider.HandleCall(proxyAddress, "facetAddresses()", func(data []byte) ([]byte, error) {
+		return nil, &jsonrpc.RPCError{Code: 3, Message: "execution reverted"}
+	})
+
+	info, err := Resolve(context.Background(), provider, proxyAddress, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, None, info.Kind)
+
+	provider.HandleCall(proxyAddress, "facetAddresses()", func(data []byte) ([]byte, error) {
+		return nil, &jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "execution reverted: not found"}
+	})
+
+	info, err = Resolve(context.Background(), provider, proxyAddress, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, None, info.Kind)
+
+	// transport and node errors are returned
+	for _, failure := range []error{
+		fmt.Errorf("connection refused"),
+		&jsonrpc.RPCError{Code: jsonrpc.RPCServerError, Message: "header not found"},
+		&jsonrpc.RPCError{Code: jsonrpc.RPCInternalError, Message: "internal error"},
+	} {
+		failure := failure
+
+		provider.HandleCall(proxyAddress, "facetAddresses()", func(data []byte) ([]byte, error) {
+			return nil, failure
+		})
+
+		_, err = Resolve(context.Background(), provider, proxyAddress, nil)
+
+		require.Error(t, err)
+	}
+}
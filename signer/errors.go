@@ -0,0 +1,13 @@
+package signer
+
+import "github.com/libs4go/errors"
+
+// ScopeOfAPIError .
+const errVendor = "ethers-signer"
+
+// errors
+var (
+	ErrRemote    = errors.New("remote signer error", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrAccount   = errors.New("signer account not found", errors.WithVendor(errVendor), errors.WithCode(-2))
+	ErrSignature = errors.New("invalid signature", errors.WithVendor(errVendor), errors.WithCode(-3))
+)
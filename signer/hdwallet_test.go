@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/eip712"
 	"github.com/libs4go/fixed"
 	"github.com/stretchr/testify/require"
 )
@@ -59,3 +60,42 @@ func TestSigner(t *testing.T) {
 
 	println(hex.EncodeToString(rawTx))
 }
+
+type Permit struct {
+	Owner    address.Address
+	Spender  address.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+func TestSignTypedDataStruct(t *testing.T) {
+	s, err := OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	typedData, err := NewTypedData(eip712.TypedDataDomain{
+		Name:              "Token",
+		Version:           "1",
+		ChainId:           eip712.NewHexOrDecimal256(56),
+		VerifyingContract: "0x44A347Cf7278685320a05Cb39e903C42e472e262",
+	}, &Permit{
+		Owner:    address.HexToAddress(s.Addresss()),
+		Spender:  address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"),
+		Value:    big.NewInt(100),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(1700000000),
+	})
+
+	require.NoError(t, err)
+
+	sig, err := s.SignTypedData(typedData)
+
+	require.NoError(t, err)
+
+	addr, err := VerifyTypedData(typedData, sig)
+
+	require.NoError(t, err)
+
+	require.Equal(t, s.Addresss(), addr)
+}
@@ -0,0 +1,379 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/libs4go/encoding/rlp"
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/libs4go/jsonrpc"
+	"github.com/libs4go/jsonrpc/client"
+	"github.com/libs4go/slf4go"
+	"golang.org/x/crypto/sha3"
+)
+
+// RemoteProtocol the json-rpc dialect spoken by remote signer
+type RemoteProtocol int
+
+const (
+	// ProtocolClef clef external signer api: account_signTransaction/account_signTypedData
+	ProtocolClef RemoteProtocol = iota
+	// ProtocolNode node managed accounts: eth_signTransaction/eth_signTypedData_v4
+	ProtocolNode
+)
+
+type remoteMethods struct {
+	accounts        string
+	signTransaction string
+	signTypedData   string
+}
+
+var protocolMethods = map[RemoteProtocol]*remoteMethods{
+	ProtocolClef: {
+		accounts:        "account_list",
+		signTransaction: "account_signTransaction",
+		signTypedData:   "account_signTypedData",
+	},
+	ProtocolNode: {
+		accounts:        "eth_accounts",
+		signTransaction: "eth_signTransaction",
+		signTypedData:   "eth_signTypedData_v4",
+	},
+}
+
+// remoteTxArgs the transaction arguments accepted by both clef and node signer
+type remoteTxArgs struct {
+	From     string  `json:"from"`
+	To       *string `json:"to,omitempty"`
+	Gas      string  `json:"gas"`
+	GasPrice string  `json:"gasPrice"`
+	Value    string  `json:"value"`
+	Nonce    string  `json:"nonce"`
+	Data     string  `json:"data"`
+	ChainID  string  `json:"chainId,omitempty"`
+}
+
+// remoteTxResult the sign transaction result returned by remote signer
+type remoteTxResult struct {
+	Raw string `json:"raw"`
+}
+
+type remoteSigner struct {
+	slf4go.Logger
+	addr       string
+	client     jsonrpc.Client
+	protocol   RemoteProtocol
+	chainID    *big.Int
+	timeout    time.Duration
+	clientOpts []client.ClientOpt
+}
+
+// RemoteOpt remote signer options
+type RemoteOpt func(signer *remoteSigner)
+
+// WithProtocol set remote signer json-rpc dialect, default is ProtocolClef
+func WithProtocol(protocol RemoteProtocol) RemoteOpt {
+	return func(signer *remoteSigner) {
+		signer.protocol = protocol
+	}
+}
+
+// WithChainID set chainId field of sign transaction request
+func WithChainID(chainID *big.Int) RemoteOpt {
+	return func(signer *remoteSigner) {
+		signer.chainID = chainID
+	}
+}
+
+// WithAccount set the signer account, default is the first account listed by remote signer
+func WithAccount(account string) RemoteOpt {
+	return func(signer *remoteSigner) {
+		signer.addr = account
+	}
+}
+
+// WithTimeout set remote sign request timeout, clef may wait for manual approval
+func WithTimeout(duration time.Duration) RemoteOpt {
+	return func(signer *remoteSigner) {
+		signer.timeout = duration
+	}
+}
+
+// WithClientOpts set jsonrpc client options of HttpRemoteSigner, e.g. client.ClientTimeout
+func WithClientOpts(ops ...client.ClientOpt) RemoteOpt {
+	return func(signer *remoteSigner) {
+		signer.clientOpts = append(signer.clientOpts, ops...)
+	}
+}
+
+// NewRemoteSigner create signer which delegates signing to external signer over json-rpc
+func NewRemoteSigner(client jsonrpc.Client, ops ...RemoteOpt) (Signer, error) {
+	signer := &remoteSigner{
+		Logger:   slf4go.Get("ethers-remote-signer"),
+		client:   client,
+		protocol: ProtocolClef,
+		timeout:  time.Minute * 5,
+	}
+
+	for _, op := range ops {
+		op(signer)
+	}
+
+	if _, ok := protocolMethods[signer.protocol]; !ok {
+		return nil, errors.Wrap(ErrRemote, "unknown remote protocol %d", signer.protocol)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signer.timeout)
+	defer cancel()
+
+	accounts, err := signer.accounts(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if signer.addr == "" {
+		if len(accounts) == 0 {
+			return nil, errors.Wrap(ErrAccount, "remote signer has no accounts")
+		}
+
+		signer.addr = accounts[0]
+	}
+
+	if !address.IsHexAddress(signer.addr) {
+		return nil, errors.Wrap(ErrAccount, "invalid account %s", signer.addr)
+	}
+
+	for _, account := range accounts {
+		if strings.EqualFold(account, signer.addr) {
+			signer.addr = address.HexToAddress(account).Hex()
+			return signer, nil
+		}
+	}
+
+	return nil, errors.Wrap(ErrAccount, "account %s not managed by remote signer", signer.addr)
+}
+
+// HttpRemoteSigner create http jsonrpc remote signer
+func HttpRemoteSigner(remote string, ops ...RemoteOpt) (Signer, error) {
+	settings := &remoteSigner{}
+
+	for _, op := range ops {
+		op(settings)
+	}
+
+	c, err := client.HTTPConnect(remote, settings.clientOpts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemoteSigner(c, ops...)
+}
+
+func (signer *remoteSigner) methods() *remoteMethods {
+	return protocolMethods[signer.protocol]
+}
+
+func (signer *remoteSigner) accounts(ctx context.Context) ([]string, error) {
+	var accounts []string
+
+	err := signer.client.Call(ctx, signer.methods().accounts).Join(&accounts)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "list remote signer accounts error")
+	}
+
+	return accounts, nil
+}
+
+func (signer *remoteSigner) Addresss() string {
+	return signer.addr
+}
+
+func (signer *remoteSigner) SignTypedData(typedData *TypedData) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), signer.timeout)
+	defer cancel()
+
+	var result string
+
+	err := signer.client.Call(ctx, signer.methods().signTypedData, signer.addr, (*eip712.TypedData)(typedData).Map()).Join(&result)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "remote sign typed data error")
+	}
+
+	sig, err := decodeHex(result)
+
+	if err != nil {
+		return nil, errors.Wrap(ErrRemote, "decode signature %s error", result)
+	}
+
+	if len(sig) != 65 {
+		return nil, errors.Wrap(ErrRemote, "invalid signature length %d", len(sig))
+	}
+
+	// normalize v to 27/28 which eip712.Recover expected
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+
+	return sig, nil
+}
+
+func (signer *remoteSigner) SignTransaction(tx *Transaction) error {
+	ctx, cancel := context.WithTimeout(context.Background(), signer.timeout)
+	defer cancel()
+
+	args := &remoteTxArgs{
+		From:     signer.addr,
+		Gas:      encodeBig(tx.GasLimit),
+		GasPrice: encodeBig(tx.Price),
+		Value:    encodeBig(tx.Amount),
+		Nonce:    fmt.Sprintf("0x%x", tx.AccountNonce),
+		Data:     "0x" + hex.EncodeToString(tx.Payload),
+	}
+
+	if tx.Recipient != nil {
+		to := address.Address(*tx.Recipient).Hex()
+		args.To = &to
+	}
+
+	if signer.chainID != nil {
+		args.ChainID = encodeBig(signer.chainID)
+	}
+
+	var result remoteTxResult
+
+	err := signer.client.Call(ctx, signer.methods().signTransaction, args).Join(&result)
+
+	if err != nil {
+		return errors.Wrap(err, "remote sign transaction error")
+	}
+
+	raw, err := decodeHex(result.Raw)
+
+	if err != nil || len(raw) == 0 {
+		return errors.Wrap(ErrRemote, "decode raw transaction %s error", result.Raw)
+	}
+
+	// typed transaction envelope (EIP-2718) can't be represented by Transaction
+	if raw[0] < 0xc0 {
+		return errors.Wrap(ErrRemote, "unsupport typed transaction 0x%x", raw[0])
+	}
+
+	var signed Transaction
+
+	if err := rlp.DecodeBytes(raw, &signed); err != nil {
+		return errors.Wrap(err, "decode remote signed transaction error")
+	}
+
+	if signed.AccountNonce != tx.AccountNonce || !bytes.Equal(signed.Payload, tx.Payload) ||
+		!sameRecipient(signed.Recipient, tx.Recipient) || !sameBig(signed.Amount, tx.Amount) {
+		return errors.Wrap(ErrRemote, "remote signed transaction mismatch with request")
+	}
+
+	if signer.chainID != nil && !sameBig(txChainID(&signed), signer.chainID) {
+		return errors.Wrap(ErrRemote, "remote signed transaction chain id %v mismatch with %s", txChainID(&signed), signer.chainID)
+	}
+
+	sender, err := txSender(&signed)
+
+	if err != nil {
+		return errors.Wrap(ErrRemote, "recover remote signed transaction sender error: %s", err)
+	}
+
+	if !strings.EqualFold(sender, signer.addr) {
+		return errors.Wrap(ErrRemote, "remote signed transaction sender %s mismatch with %s", sender, signer.addr)
+	}
+
+	// the remote signer (e.g. clef rules or ui) may adjust gas settings, keep the signed one
+	*tx = signed
+
+	return nil
+}
+
+func sameRecipient(a, b *[20]byte) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// sameBig compare big ints, nil equals zero
+func sameBig(a, b *big.Int) bool {
+	if a == nil {
+		a = new(big.Int)
+	}
+
+	if b == nil {
+		b = new(big.Int)
+	}
+
+	return a.Cmp(b) == 0
+}
+
+// txChainID returns the EIP-155 chain id of signed transaction, nil if v is 27/28
+func txChainID(tx *Transaction) *big.Int {
+	if tx.V == nil || tx.V.Cmp(big.NewInt(35)) < 0 {
+		return nil
+	}
+
+	chainID := new(big.Int).Sub(tx.V, big.NewInt(35))
+
+	return chainID.Rsh(chainID, 1)
+}
+
+// signHash returns the signing hash of tx, the EIP-155 one if chainID is not nil
+func signHash(tx *Transaction, chainID *big.Int) []byte {
+	if chainID == nil {
+		return tx.SignHash()
+	}
+
+	hw := sha3.NewLegacyKeccak256()
+
+	rlp.Encode(hw, []interface{}{
+		tx.AccountNonce,
+		tx.Price,
+		tx.GasLimit,
+		tx.Recipient,
+		tx.Amount,
+		tx.Payload,
+		chainID,
+		uint(0),
+		uint(0),
+	})
+
+	return hw.Sum(nil)
+}
+
+// txSender recover the sender address of signed transaction
+func txSender(tx *Transaction) (string, error) {
+	sig, err := TransactionSignature(tx)
+
+	if err != nil {
+		return "", err
+	}
+
+	return sig.RecoverAddress(signHash(tx, txChainID(tx)))
+}
+
+func encodeBig(v *big.Int) string {
+	if v == nil {
+		return "0x0"
+	}
+
+	return fmt.Sprintf("0x%x", v)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+}
@@ -0,0 +1,323 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ecdsax "github.com/libs4go/crypto/ecdsa"
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/libs4go/jsonrpc"
+	"github.com/libs4go/jsonrpc/client"
+	"github.com/libs4go/jsonrpc/transport"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRemote clef compatible json-rpc server backed by hdWalletSigner
+type mockRemote struct {
+	wallet  Signer
+	tamper  func(tx *Transaction) // modify the request before signing
+	chainID *big.Int              // rewrite v as EIP-155 v of chainID
+}
+
+func (mock *mockRemote) Dispatch(ctx context.Context, buff []byte) ([]byte, error) {
+	var request struct {
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+		ID     uint              `json:"id"`
+	}
+
+	if err := json.Unmarshal(buff, &request); err != nil {
+		return nil, err
+	}
+
+	resp := &jsonrpc.RPCResponse{
+		JSONRPC: "2.0",
+		ID:      request.ID,
+	}
+
+	result, err := mock.handle(request.Method, request.Params)
+
+	if err != nil {
+		resp.Error = &jsonrpc.RPCError{Code: jsonrpc.RPCInternalError, Message: err.Error()}
+	} else {
+		resp.Result = result
+	}
+
+	return json.Marshal(resp)
+}
+
+func (mock *mockRemote) handle(method string, params []json.RawMessage) (interface{}, error) {
+	switch method {
+	case "account_list", "eth_accounts":
+		return []string{mock.wallet.Addresss()}, nil
+	case "account_signTransaction", "eth_signTransaction":
+		var args remoteTxArgs
+
+		if err := json.Unmarshal(params[0], &args); err != nil {
+			return nil, err
+		}
+
+		data, err := decodeHex(args.Data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tx := &Transaction{
+			AccountNonce: mustBig(args.Nonce).Uint64(),
+			Price:        mustBig(args.GasPrice),
+			GasLimit:     mustBig(args.Gas),
+			Amount:       mustBig(args.Value),
+			Payload:      data,
+		}
+
+		if args.To != nil {
+			recipient := [20]byte(address.HexToAddress(*args.To))
+			tx.Recipient = &recipient
+		}
+
+		if mock.tamper != nil {
+			mock.tamper(tx)
+		}
+
+		r, s, v, err := ecdsax.RecoverSign(mock.wallet.(*hdWalletSigner).privateKey, signHash(tx, mock.chainID), false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if mock.chainID != nil {
+			chainV := new(big.Int).Mul(mock.chainID, big.NewInt(2))
+			v = chainV.Add(chainV, big.NewInt(35)).Add(chainV, new(big.Int).Sub(v, big.NewInt(27)))
+		}
+
+		tx.R, tx.S, tx.V = r, s, v
+
+		raw, err := tx.Encode()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &remoteTxResult{Raw: "0x" + hex.EncodeToString(raw)}, nil
+	case "account_signTypedData", "eth_signTypedData_v4":
+		var typedData TypedData
+
+		if err := json.Unmarshal(params[1], &typedData); err != nil {
+			return nil, err
+		}
+
+		sig, err := mock.wallet.SignTypedData(&typedData)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// remote signer may return v as 0/1
+		sig[64] = (sig[64] - 27) & 1
+
+		return "0x" + hex.EncodeToString(sig), nil
+	}
+
+	return nil, ErrRemote
+}
+
+func mustBig(s string) *big.Int {
+	v, _ := new(big.Int).SetString(s[2:], 16)
+	return v
+}
+
+func openMockRemote(t *testing.T, ops ...RemoteOpt) (Signer, Signer) {
+	remote, wallet, _ := openTamperedRemote(t, ops...)
+
+	return remote, wallet
+}
+
+func openTamperedRemote(t *testing.T, ops ...RemoteOpt) (Signer, Signer, *mockRemote) {
+	wallet, err := OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	mock := &mockRemote{wallet: wallet}
+
+	server := httptest.NewServer(transport.ServeHTTP(mock))
+
+	t.Cleanup(server.Close)
+
+	remote, err := HttpRemoteSigner(server.URL, ops...)
+
+	require.NoError(t, err)
+
+	return remote, wallet, mock
+}
+
+func TestRemoteSignTransaction(t *testing.T) {
+	for _, protocol := range []RemoteProtocol{ProtocolClef, ProtocolNode} {
+		remote, wallet := openMockRemote(t, WithProtocol(protocol))
+
+		require.Equal(t, wallet.Addresss(), remote.Addresss())
+
+		recipient := [20]byte(address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"))
+
+		newTx := func() *Transaction {
+			return &Transaction{
+				AccountNonce: 1,
+				Price:        big.NewInt(18000000000),
+				GasLimit:     big.NewInt(21000),
+				Recipient:    &recipient,
+				Amount:       big.NewInt(10000000000000000),
+				Payload:      []byte{0x01, 0x02},
+			}
+		}
+
+		tx := newTx()
+
+		require.NoError(t, remote.SignTransaction(tx))
+
+		expect := newTx()
+
+		require.NoError(t, wallet.SignTransaction(expect))
+
+		require.Equal(t, expect.Hash(), tx.Hash())
+	}
+}
+
+func TestRemoteSignTransactionMismatch(t *testing.T) {
+	remote, _, mock := openTamperedRemote(t, WithChainID(big.NewInt(56)))
+
+	recipient := [20]byte(address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262"))
+
+	newTx := func() *Transaction {
+		return &Transaction{
+			AccountNonce: 1,
+			Price:        big.NewInt(18000000000),
+			GasLimit:     big.NewInt(21000),
+			Recipient:    &recipient,
+			Amount:       big.NewInt(10000000000000000),
+		}
+	}
+
+	mock.chainID = big.NewInt(56)
+
+	// gas settings may be adjusted
+	mock.tamper = func(tx *Transaction) {
+		tx.GasLimit = big.NewInt(50000)
+	}
+
+	tx := newTx()
+
+	require.NoError(t, remote.SignTransaction(tx))
+	require.Equal(t, int64(50000), tx.GasLimit.Int64())
+
+	for _, tamper := range []func(tx *Transaction){
+		func(tx *Transaction) { tx.Amount = big.NewInt(1) },
+		func(tx *Transaction) { tx.Recipient = nil },
+		func(tx *Transaction) { tx.Recipient = &[20]byte{1} },
+	} {
+		mock.tamper = tamper
+
+		require.True(t, errors.Is(remote.SignTransaction(newTx()), ErrRemote))
+	}
+
+	mock.tamper = nil
+
+	// signed for other chain
+	mock.chainID = big.NewInt(1)
+
+	require.True(t, errors.Is(remote.SignTransaction(newTx()), ErrRemote))
+
+	// replayable signature without chain id
+	mock.chainID = nil
+
+	require.True(t, errors.Is(remote.SignTransaction(newTx()), ErrRemote))
+
+	// signed by other account
+	other, err := OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/1")
+
+	require.NoError(t, err)
+
+	mock.chainID = big.NewInt(56)
+	mock.wallet = other
+
+	require.True(t, errors.Is(remote.SignTransaction(newTx()), ErrRemote))
+}
+
+func TestRemoteSignTypedData(t *testing.T) {
+	remote, wallet := openMockRemote(t)
+
+	typedData := &TypedData{
+		Types: eip712.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Mail": {
+				{Name: "to", Type: "address"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: eip712.TypedDataDomain{
+			Name:    "Ether Mail",
+			ChainId: eip712.NewHexOrDecimal256(1),
+		},
+		Message: map[string]interface{}{
+			"to":       "0x44A347Cf7278685320a05Cb39e903C42e472e262",
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	sig, err := remote.SignTypedData(typedData)
+
+	require.NoError(t, err)
+
+	addr, err := VerifyTypedData(typedData, sig)
+
+	require.NoError(t, err)
+
+	require.Equal(t, wallet.Addresss(), addr)
+}
+
+func TestRemoteAccountNotFound(t *testing.T) {
+	wallet, err := OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	server := httptest.NewServer(transport.ServeHTTP(&mockRemote{wallet: wallet}))
+
+	defer server.Close()
+
+	_, err = HttpRemoteSigner(server.URL, WithAccount("0x0000000000000000000000000000000000000001"))
+
+	require.Error(t, err)
+}
+
+func TestRemoteClientOpts(t *testing.T) {
+	wallet, err := OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	server := httptest.NewServer(transport.ServeHTTP(&mockRemote{wallet: wallet}))
+
+	defer server.Close()
+
+	remote, err := HttpRemoteSigner(server.URL, WithClientOpts(client.ClientTimeout(time.Second)))
+
+	require.NoError(t, err)
+	require.Equal(t, wallet.Addresss(), remote.Addresss())
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	_, err = HttpRemoteSigner(server.URL, WithClientOpts(client.ClientContext(ctx)), WithTimeout(time.Second))
+
+	require.Error(t, err)
+}
@@ -0,0 +1,192 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	ecdsax "github.com/libs4go/crypto/ecdsa"
+	ellipticx "github.com/libs4go/crypto/elliptic"
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/address"
+)
+
+var (
+	secp256k1N     = ellipticx.SECP256K1().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// Signature secp256k1 recoverable signature, V is the recovery id 0/1
+type Signature struct {
+	R *big.Int
+	S *big.Int
+	V byte
+}
+
+// NewSignature create signature from r, s, v, v can be the recovery id 0/1, legacy 27/28
+// or EIP-155 chainId*2+35/36. High S values are rejected, see NormalizeSignature
+func NewSignature(r, s, v *big.Int) (*Signature, error) {
+	if r == nil || s == nil {
+		return nil, errors.Wrap(ErrSignature, "signature r, s can't be nil")
+	}
+
+	recovery, err := recoveryID(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &Signature{
+		R: new(big.Int).Set(r),
+		S: new(big.Int).Set(s),
+		V: recovery,
+	}
+
+	if err := sig.validate(); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// NormalizeSignature same as NewSignature, but high S value is converted to the
+// canonical low S form (s' = n - s, flipped recovery id), which recovers the same public key
+func NormalizeSignature(r, s, v *big.Int) (*Signature, error) {
+	recovery, err := recoveryID(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s != nil && s.Cmp(secp256k1HalfN) > 0 && s.Cmp(secp256k1N) < 0 {
+		s = new(big.Int).Sub(secp256k1N, s)
+		recovery ^= 1
+	}
+
+	return NewSignature(r, s, big.NewInt(int64(recovery)))
+}
+
+func recoveryID(v *big.Int) (byte, error) {
+	if v == nil {
+		return 0, errors.Wrap(ErrSignature, "signature v can't be nil")
+	}
+
+	switch {
+	case v.Cmp(big.NewInt(35)) >= 0:
+		return byte(new(big.Int).Sub(v, big.NewInt(35)).Bit(0)), nil
+	case v.Cmp(big.NewInt(27)) == 0 || v.Cmp(big.NewInt(28)) == 0:
+		return byte(v.Int64() - 27), nil
+	case v.Sign() == 0 || v.Cmp(big.NewInt(1)) == 0:
+		return byte(v.Int64()), nil
+	}
+
+	return 0, errors.Wrap(ErrSignature, "invalid signature v %s", v)
+}
+
+// ParseSignature parse 65 bytes r ‖ s ‖ v signature (v of 0/1 or 27/28) or
+// 64 bytes EIP-2098 compact signature r ‖ yParityAndS. High S values are rejected
+func ParseSignature(buff []byte) (*Signature, error) {
+	switch len(buff) {
+	case 65:
+		return NewSignature(new(big.Int).SetBytes(buff[:32]), new(big.Int).SetBytes(buff[32:64]), big.NewInt(int64(buff[64])))
+	case 64:
+		vs := append([]byte{}, buff[32:]...)
+
+		v := vs[0] >> 7
+
+		vs[0] &= 0x7f
+
+		return NewSignature(new(big.Int).SetBytes(buff[:32]), new(big.Int).SetBytes(vs), big.NewInt(int64(v)))
+	}
+
+	return nil, errors.Wrap(ErrSignature, "invalid signature length %d", len(buff))
+}
+
+// TransactionSignature returns the signature of signed transaction
+func TransactionSignature(tx *Transaction) (*Signature, error) {
+	return NewSignature(tx.R, tx.S, tx.V)
+}
+
+func (sig *Signature) validate() error {
+	if sig.R.Sign() <= 0 || sig.R.Cmp(secp256k1N) >= 0 {
+		return errors.Wrap(ErrSignature, "signature r out of range")
+	}
+
+	if sig.S.Sign() <= 0 || sig.S.Cmp(secp256k1N) >= 0 {
+		return errors.Wrap(ErrSignature, "signature s out of range")
+	}
+
+	if !sig.IsCanonical() {
+		return errors.Wrap(ErrSignature, "signature s is not canonical (s > n/2)")
+	}
+
+	return nil
+}
+
+// IsCanonical check the EIP-2 low S rule s <= n/2
+func (sig *Signature) IsCanonical() bool {
+	return sig.S.Cmp(secp256k1HalfN) <= 0
+}
+
+// Bytes returns 65 bytes r ‖ s ‖ v signature, v is 27/28 as returned by Signer.SignTypedData
+func (sig *Signature) Bytes() []byte {
+	buff := make([]byte, 65)
+
+	sig.R.FillBytes(buff[:32])
+	sig.S.FillBytes(buff[32:64])
+	buff[64] = sig.V + 27
+
+	return buff
+}
+
+// Compact returns 64 bytes EIP-2098 compact signature r ‖ (v << 255 | s)
+func (sig *Signature) Compact() []byte {
+	buff := make([]byte, 64)
+
+	sig.R.FillBytes(buff[:32])
+	sig.S.FillBytes(buff[32:])
+	buff[32] |= sig.V << 7
+
+	return buff
+}
+
+// VRS returns v, r, s values, v is 27/28 if chainID is nil, otherwise EIP-155 chainId*2+35/36
+func (sig *Signature) VRS(chainID *big.Int) (v, r, s *big.Int) {
+	if chainID == nil {
+		v = big.NewInt(int64(sig.V) + 27)
+	} else {
+		v = new(big.Int).Mul(chainID, big.NewInt(2))
+		v.Add(v, big.NewInt(int64(sig.V)+35))
+	}
+
+	return v, new(big.Int).Set(sig.R), new(big.Int).Set(sig.S)
+}
+
+// RecoverPublicKey recover signer public key from signed digest
+func (sig *Signature) RecoverPublicKey(digest []byte) (*ecdsa.PublicKey, error) {
+	if len(digest) != 32 {
+		return nil, errors.Wrap(ErrSignature, "invalid digest length %d", len(digest))
+	}
+
+	if err := sig.validate(); err != nil {
+		return nil, err
+	}
+
+	publicKey, _, err := ecdsax.Recover(ellipticx.SECP256K1(), sig.R, sig.S, big.NewInt(int64(sig.V)+27), digest)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "recover public key error")
+	}
+
+	return publicKey, nil
+}
+
+// RecoverAddress recover signer address from signed digest
+func (sig *Signature) RecoverAddress(digest []byte) (string, error) {
+	publicKey, err := sig.RecoverPublicKey(digest)
+
+	if err != nil {
+		return "", err
+	}
+
+	return address.FromPublicKey(publicKey).Hex(), nil
+}
@@ -0,0 +1,140 @@
+package signer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	ecdsax "github.com/libs4go/crypto/ecdsa"
+	"github.com/libs4go/ethers/eip712"
+	"github.com/stretchr/testify/require"
+)
+
+func mustHex(s string) []byte {
+	buff, err := decodeHex(s)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return buff
+}
+
+func TestSignatureRoundTrip(t *testing.T) {
+	s, err := OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	wallet := s.(*hdWalletSigner)
+
+	for i := 0; i < 8; i++ {
+		digest := eip712.Keccak256([]byte{byte(i)})
+
+		r, ss, v, err := ecdsax.RecoverSign(wallet.privateKey, digest, false)
+
+		require.NoError(t, err)
+
+		sig, err := NewSignature(r, ss, v)
+
+		require.NoError(t, err)
+
+		require.True(t, sig.IsCanonical())
+
+		addr, err := sig.RecoverAddress(digest)
+
+		require.NoError(t, err)
+		require.Equal(t, wallet.Addresss(), addr)
+
+		// rsv with v of 27/28
+		parsed, err := ParseSignature(sig.Bytes())
+
+		require.NoError(t, err)
+		require.Equal(t, sig, parsed)
+
+		// rsv with v of 0/1
+		raw := sig.Bytes()
+		raw[64] -= 27
+
+		parsed, err = ParseSignature(raw)
+
+		require.NoError(t, err)
+		require.Equal(t, sig, parsed)
+
+		// EIP-2098 compact
+		parsed, err = ParseSignature(sig.Compact())
+
+		require.NoError(t, err)
+		require.Equal(t, sig, parsed)
+
+		// EIP-155
+		v, _, _ = sig.VRS(big.NewInt(56))
+
+		parsed, err = NewSignature(r, ss, v)
+
+		require.NoError(t, err)
+		require.Equal(t, sig, parsed)
+
+		// high S malleable signature
+		highS := new(big.Int).Sub(secp256k1N, ss)
+
+		_, err = NewSignature(r, highS, big.NewInt(int64(sig.V^1)))
+
+		require.Error(t, err)
+
+		normalized, err := NormalizeSignature(r, highS, big.NewInt(int64(sig.V^1)+27))
+
+		require.NoError(t, err)
+		require.Equal(t, sig, normalized)
+	}
+}
+
+func TestEIP2098(t *testing.T) {
+	vectors := []struct {
+		r, s        string
+		v           int64
+		yParityAndS string
+	}{
+		{
+			r:           "68a020a209d3d56c46f38cc50a33f704f4a9a10a59377f8dd762ac66910e9b90",
+			s:           "7e865ad05c4035ab5792787d4a0297a43617ae897930a6fe4d822b8faea52064",
+			v:           27,
+			yParityAndS: "7e865ad05c4035ab5792787d4a0297a43617ae897930a6fe4d822b8faea52064",
+		},
+		{
+			r:           "9328da16089fcba9bececa81663203989f2df5fe1faa6291a45381c81bd17f76",
+			s:           "139c6d6b623b42da56557e5e734a43dc83345ddfadec52cbe24d0cc64f550793",
+			v:           28,
+			yParityAndS: "939c6d6b623b42da56557e5e734a43dc83345ddfadec52cbe24d0cc64f550793",
+		},
+	}
+
+	for _, vector := range vectors {
+		sig, err := NewSignature(new(big.Int).SetBytes(mustHex(vector.r)), new(big.Int).SetBytes(mustHex(vector.s)), big.NewInt(vector.v))
+
+		require.NoError(t, err)
+
+		require.Equal(t, vector.r+vector.yParityAndS, hex.EncodeToString(sig.Compact()))
+
+		parsed, err := ParseSignature(sig.Compact())
+
+		require.NoError(t, err)
+
+		require.Equal(t, sig, parsed)
+
+		require.Equal(t, vector.r+vector.s+hex.EncodeToString([]byte{byte(vector.v)}), hex.EncodeToString(parsed.Bytes()))
+	}
+}
+
+func TestInvalidSignature(t *testing.T) {
+	_, err := ParseSignature(make([]byte, 63))
+	require.Error(t, err)
+
+	_, err = ParseSignature(make([]byte, 65))
+	require.Error(t, err, "zero r")
+
+	_, err = NewSignature(big.NewInt(1), big.NewInt(1), big.NewInt(29))
+	require.Error(t, err)
+
+	_, err = NewSignature(secp256k1N, big.NewInt(1), big.NewInt(27))
+	require.Error(t, err)
+}
@@ -53,6 +53,18 @@ func (tx *Transaction) Encode() ([]byte, error) {
 // TypedData ...
 type TypedData eip712.TypedData
 
+// NewTypedData create eip712 typed data from domain and tagged go struct message,
+// see eip712.TypedDataOf
+func NewTypedData(domain eip712.TypedDataDomain, message interface{}) (*TypedData, error) {
+	typedData, err := eip712.TypedDataOf(domain, message)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return (*TypedData)(typedData), nil
+}
+
 // VerifyTypedData verify eip712 sig, and return signer address ...
 func VerifyTypedData(typedData *TypedData, sig []byte) (string, error) {
 	return eip712.Recover((*eip712.TypedData)(typedData), sig)
@@ -0,0 +1,2761 @@
+package token
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+)
+
+// ERC20Caller view/pure funcs of contract ERC20
+type ERC20Caller interface {
+	Name(ctx context.Context) (ret0 string, err error)
+	Symbol(ctx context.Context) (ret0 string, err error)
+	Decimals(ctx context.Context) (ret0 *big.Int, err error)
+	TotalSupply(ctx context.Context) (ret0 *big.Int, err error)
+	BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error)
+	Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error)
+}
+
+// ERC20Transactor state-changing funcs of contract ERC20
+type ERC20Transactor interface {
+	Approve(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Transfer(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFrom(ctx context.Context, from address.Address, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// ERC20Filterer event filterers and watchers of contract ERC20
+type ERC20Filterer interface {
+	FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*ERC20TransferEvent, err error)
+	WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*ERC20ApprovalEvent, err error)
+	WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error)
+}
+
+// ERC20 contract ERC20 binding interface
+type ERC20 interface {
+	ERC20Caller
+	ERC20Transactor
+	ERC20Filterer
+}
+
+// ERC20TransferEvent event Transfer(address,address,uint256) of contract ERC20
+type ERC20TransferEvent struct {
+	From  address.Address
+	To    address.Address
+	Value *big.Int
+	Raw   *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC20ApprovalEvent event Approval(address,address,uint256) of contract ERC20
+type ERC20ApprovalEvent struct {
+	Owner   address.Address
+	Spender address.Address
+	Value   *big.Int
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC20ABI json abi of contract ERC20
+const ERC20ABI = `[{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","internalType":"address","indexed":true},{"name":"to","type":"address","internalType":"address","indexed":true},{"name":"value","type":"uint256","internalType":"uint256","indexed":false}],"anonymous":false},{"type":"event","name":"Approval","inputs":[{"name":"owner","type":"address","internalType":"address","indexed":true},{"name":"spender","type":"address","internalType":"address","indexed":true},{"name":"value","type":"uint256","internalType":"uint256","indexed":false}],"anonymous":false},{"type":"function","name":"name","inputs":[],"outputs":[{"name":"","type":"string","internalType":"string"}],"stateMutability":"view"},{"type":"function","name":"symbol","inputs":[],"outputs":[{"name":"","type":"string","internalType":"string"}],"stateMutability":"view"},{"type":"function","name":"decimals","inputs":[],"outputs":[{"name":"","type":"uint8","internalType":"uint8"}],"stateMutability":"view"},{"type":"function","name":"totalSupply","inputs":[],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"},{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"},{"type":"function","name":"allowance","inputs":[{"name":"owner","type":"address","internalType":"address"},{"name":"spender","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"},{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address","internalType":"address"},{"name":"amount","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"nonpayable"},{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address","internalType":"address"},{"name":"amount","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"nonpayable"},{"type":"function","name":"transferFrom","inputs":[{"name":"from","type":"address","internalType":"address"},{"name":"to","type":"address","internalType":"address"},{"name":"amount","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"nonpayable"}]`
+
+var (
+	parsedERC20Once sync.Once
+	parsedERC20     abi.Contract
+	parsedERC20Err  error
+)
+
+// ERC20Contract returns the abi.Contract of ERC20ABI, which is parsed once on first use
+func ERC20Contract() (abi.Contract, error) {
+	parsedERC20Once.Do(func() {
+		parsedERC20, parsedERC20Err = binding.Parse("ERC20", []byte(ERC20ABI), binding.NewSymbols())
+	})
+
+	return parsedERC20, parsedERC20Err
+}
+
+// NewERC20 create ERC20 binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewERC20(recipient address.Address, provider client.Provider, signer signer.Signer) (*ERC20Impl, error) {
+	contract, err := ERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewERC20Impl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewERC20Caller create ERC20Caller of contract deployed at recipient
+func NewERC20Caller(recipient address.Address, provider client.Provider) (*ERC20CallerImpl, error) {
+	contract, err := ERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ERC20CallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewERC20Filterer create ERC20Filterer of contract deployed at recipient
+func NewERC20Filterer(recipient address.Address, provider client.Provider) (*ERC20FiltererImpl, error) {
+	contract, err := ERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ERC20FiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// ERC20CallerImpl ERC20Caller implementation calling contract via provider
+type ERC20CallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ERC20TransactorImpl ERC20Transactor implementation sending signed transactions via provider
+type ERC20TransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// ERC20FiltererImpl ERC20Filterer implementation querying and subscribing logs via provider
+type ERC20FiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ERC20Impl ERC20 implementation
+type ERC20Impl struct {
+	*ERC20CallerImpl
+	*ERC20TransactorImpl
+	*ERC20FiltererImpl
+}
+
+// NewERC20Impl create ERC20 implementation of contract deployed at recipient
+func NewERC20Impl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *ERC20Impl {
+	return &ERC20Impl{
+		ERC20CallerImpl: &ERC20CallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		ERC20TransactorImpl: &ERC20TransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		ERC20FiltererImpl: &ERC20FiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ ERC20 = (*ERC20Impl)(nil)
+
+func (impl *ERC20CallerImpl) Name(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("06fdde03")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Name not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC20CallerImpl) Symbol(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("95d89b41")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Symbol not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC20CallerImpl) Decimals(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("313ce567")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Decimals not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC20CallerImpl) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("18160ddd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TotalSupply not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC20CallerImpl) BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("70a08231")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(account)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC20CallerImpl) Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("dd62ed3e")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Allowance not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC20TransactorImpl) Approve(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("095ea7b3")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Approve not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(spender, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC20TransactorImpl) Transfer(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a9059cbb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Transfer not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC20TransactorImpl) TransferFrom(ctx context.Context, from address.Address, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("23b872dd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, amount)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterTransfer returns Transfer events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC20FiltererImpl) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*ERC20TransferEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC20TransferEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransfer stream Transfer events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC20FiltererImpl) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC20TransferEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterApproval returns Approval events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC20FiltererImpl) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*ERC20ApprovalEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC20ApprovalEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchApproval stream Approval events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC20FiltererImpl) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, spender)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC20ApprovalEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockERC20 in-memory ERC20 implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockERC20 struct {
+	NameFunc           func(ctx context.Context) (ret0 string, err error)
+	SymbolFunc         func(ctx context.Context) (ret0 string, err error)
+	DecimalsFunc       func(ctx context.Context) (ret0 *big.Int, err error)
+	TotalSupplyFunc    func(ctx context.Context) (ret0 *big.Int, err error)
+	BalanceOfFunc      func(ctx context.Context, account address.Address) (ret0 *big.Int, err error)
+	AllowanceFunc      func(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error)
+	ApproveFunc        func(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFunc       func(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFromFunc   func(ctx context.Context, from address.Address, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	FilterTransferFunc func(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*ERC20TransferEvent, err error)
+	WatchTransferFunc  func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterApprovalFunc func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*ERC20ApprovalEvent, err error)
+	WatchApprovalFunc  func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error)
+}
+
+var _ ERC20 = (*MockERC20)(nil)
+
+func (mock *MockERC20) Name(ctx context.Context) (ret0 string, err error) {
+	if mock.NameFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Name not stubbed")
+		return
+	}
+
+	return mock.NameFunc(ctx)
+}
+
+func (mock *MockERC20) Symbol(ctx context.Context) (ret0 string, err error) {
+	if mock.SymbolFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Symbol not stubbed")
+		return
+	}
+
+	return mock.SymbolFunc(ctx)
+}
+
+func (mock *MockERC20) Decimals(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.DecimalsFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Decimals not stubbed")
+		return
+	}
+
+	return mock.DecimalsFunc(ctx)
+}
+
+func (mock *MockERC20) TotalSupply(ctx context.Context) (ret0 *big.Int, err error) {
+	if mock.TotalSupplyFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TotalSupply not stubbed")
+		return
+	}
+
+	return mock.TotalSupplyFunc(ctx)
+}
+
+func (mock *MockERC20) BalanceOf(ctx context.Context, account address.Address) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, account)
+}
+
+func (mock *MockERC20) Allowance(ctx context.Context, owner address.Address, spender address.Address) (ret0 *big.Int, err error) {
+	if mock.AllowanceFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Allowance not stubbed")
+		return
+	}
+
+	return mock.AllowanceFunc(ctx, owner, spender)
+}
+
+func (mock *MockERC20) Approve(ctx context.Context, spender address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.ApproveFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Approve not stubbed")
+		return
+	}
+
+	return mock.ApproveFunc(ctx, spender, amount, ops...)
+}
+
+func (mock *MockERC20) Transfer(ctx context.Context, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Transfer not stubbed")
+		return
+	}
+
+	return mock.TransferFunc(ctx, to, amount, ops...)
+}
+
+func (mock *MockERC20) TransferFrom(ctx context.Context, from address.Address, to address.Address, amount *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferFrom not stubbed")
+		return
+	}
+
+	return mock.TransferFromFunc(ctx, from, to, amount, ops...)
+}
+
+func (mock *MockERC20) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address) (events []*ERC20TransferEvent, err error) {
+	if mock.FilterTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransfer not stubbed")
+		return
+	}
+
+	return mock.FilterTransferFunc(ctx, opts, from, to)
+}
+
+func (mock *MockERC20) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20TransferEvent, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransfer not stubbed")
+		return
+	}
+
+	return mock.WatchTransferFunc(ctx, opts, sink, from, to)
+}
+
+func (mock *MockERC20) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, spender []address.Address) (events []*ERC20ApprovalEvent, err error) {
+	if mock.FilterApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApproval not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalFunc(ctx, opts, owner, spender)
+}
+
+func (mock *MockERC20) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC20ApprovalEvent, owner []address.Address, spender []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApproval not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalFunc(ctx, opts, sink, owner, spender)
+}
+
+// ERC721Caller view/pure funcs of contract ERC721
+type ERC721Caller interface {
+	SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error)
+	BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error)
+	Name(ctx context.Context) (ret0 string, err error)
+	Symbol(ctx context.Context) (ret0 string, err error)
+	TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error)
+}
+
+// ERC721Transactor state-changing funcs of contract ERC721
+type ERC721Transactor interface {
+	SafeTransferFromWithData(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// ERC721Filterer event filterers and watchers of contract ERC721
+type ERC721Filterer interface {
+	FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*ERC721TransferEvent, err error)
+	WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721TransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+	FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*ERC721ApprovalEvent, err error)
+	WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+	FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*ERC721ApprovalForAllEvent, err error)
+	WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error)
+}
+
+// ERC721 contract ERC721 binding interface
+type ERC721 interface {
+	ERC721Caller
+	ERC721Transactor
+	ERC721Filterer
+}
+
+// ERC721TransferEvent event Transfer(address,address,uint256) of contract ERC721
+type ERC721TransferEvent struct {
+	From    address.Address
+	To      address.Address
+	TokenId *big.Int
+	Raw     *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC721ApprovalEvent event Approval(address,address,uint256) of contract ERC721
+type ERC721ApprovalEvent struct {
+	Owner    address.Address
+	Approved address.Address
+	TokenId  *big.Int
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC721ApprovalForAllEvent event ApprovalForAll(address,address,bool) of contract ERC721
+type ERC721ApprovalForAllEvent struct {
+	Owner    address.Address
+	Operator address.Address
+	Approved bool
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC721ABI json abi of contract ERC721
+const ERC721ABI = `[{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","internalType":"address","indexed":true},{"name":"to","type":"address","internalType":"address","indexed":true},{"name":"tokenId","type":"uint256","internalType":"uint256","indexed":true}],"anonymous":false},{"type":"event","name":"Approval","inputs":[{"name":"owner","type":"address","internalType":"address","indexed":true},{"name":"approved","type":"address","internalType":"address","indexed":true},{"name":"tokenId","type":"uint256","internalType":"uint256","indexed":true}],"anonymous":false},{"type":"event","name":"ApprovalForAll","inputs":[{"name":"owner","type":"address","internalType":"address","indexed":true},{"name":"operator","type":"address","internalType":"address","indexed":true},{"name":"approved","type":"bool","internalType":"bool","indexed":false}],"anonymous":false},{"type":"function","name":"supportsInterface","inputs":[{"name":"interfaceId","type":"bytes4","internalType":"bytes4"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"view"},{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"},{"type":"function","name":"ownerOf","inputs":[{"name":"tokenId","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"},{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address","internalType":"address"},{"name":"to","type":"address","internalType":"address"},{"name":"tokenId","type":"uint256","internalType":"uint256"},{"name":"data","type":"bytes","internalType":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address","internalType":"address"},{"name":"to","type":"address","internalType":"address"},{"name":"tokenId","type":"uint256","internalType":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"transferFrom","inputs":[{"name":"from","type":"address","internalType":"address"},{"name":"to","type":"address","internalType":"address"},{"name":"tokenId","type":"uint256","internalType":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"approve","inputs":[{"name":"to","type":"address","internalType":"address"},{"name":"tokenId","type":"uint256","internalType":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"setApprovalForAll","inputs":[{"name":"operator","type":"address","internalType":"address"},{"name":"approved","type":"bool","internalType":"bool"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"getApproved","inputs":[{"name":"tokenId","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"},{"type":"function","name":"isApprovedForAll","inputs":[{"name":"owner","type":"address","internalType":"address"},{"name":"operator","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"view"},{"type":"function","name":"name","inputs":[],"outputs":[{"name":"","type":"string","internalType":"string"}],"stateMutability":"view"},{"type":"function","name":"symbol","inputs":[],"outputs":[{"name":"","type":"string","internalType":"string"}],"stateMutability":"view"},{"type":"function","name":"tokenURI","inputs":[{"name":"tokenId","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"string","internalType":"string"}],"stateMutability":"view"}]`
+
+var (
+	parsedERC721Once sync.Once
+	parsedERC721     abi.Contract
+	parsedERC721Err  error
+)
+
+// ERC721Contract returns the abi.Contract of ERC721ABI, which is parsed once on first use
+func ERC721Contract() (abi.Contract, error) {
+	parsedERC721Once.Do(func() {
+		parsedERC721, parsedERC721Err = binding.Parse("ERC721", []byte(ERC721ABI), binding.NewSymbols())
+	})
+
+	return parsedERC721, parsedERC721Err
+}
+
+// NewERC721 create ERC721 binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewERC721(recipient address.Address, provider client.Provider, signer signer.Signer) (*ERC721Impl, error) {
+	contract, err := ERC721Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewERC721Impl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewERC721Caller create ERC721Caller of contract deployed at recipient
+func NewERC721Caller(recipient address.Address, provider client.Provider) (*ERC721CallerImpl, error) {
+	contract, err := ERC721Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ERC721CallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewERC721Filterer create ERC721Filterer of contract deployed at recipient
+func NewERC721Filterer(recipient address.Address, provider client.Provider) (*ERC721FiltererImpl, error) {
+	contract, err := ERC721Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ERC721FiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// ERC721CallerImpl ERC721Caller implementation calling contract via provider
+type ERC721CallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ERC721TransactorImpl ERC721Transactor implementation sending signed transactions via provider
+type ERC721TransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// ERC721FiltererImpl ERC721Filterer implementation querying and subscribing logs via provider
+type ERC721FiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ERC721Impl ERC721 implementation
+type ERC721Impl struct {
+	*ERC721CallerImpl
+	*ERC721TransactorImpl
+	*ERC721FiltererImpl
+}
+
+// NewERC721Impl create ERC721 implementation of contract deployed at recipient
+func NewERC721Impl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *ERC721Impl {
+	return &ERC721Impl{
+		ERC721CallerImpl: &ERC721CallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		ERC721TransactorImpl: &ERC721TransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		ERC721FiltererImpl: &ERC721FiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ ERC721 = (*ERC721Impl)(nil)
+
+func (impl *ERC721CallerImpl) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("01ffc9a7")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SupportsInterface not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(interfaceId)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("70a08231")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("6352211e")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func OwnerOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenId)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	f, ok := impl.Contract.Select("081812fc")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func GetApproved not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenId)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("e985e9c5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func IsApprovedForAll not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(owner, operator)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) Name(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("06fdde03")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Name not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) Symbol(ctx context.Context) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("95d89b41")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Symbol not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call()
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721CallerImpl) TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("c87b56dd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TokenURI not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(tokenId)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC721TransactorImpl) SafeTransferFromWithData(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("b88d4fde")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFromWithData not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, tokenId, data)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC721TransactorImpl) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("42842e0e")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC721TransactorImpl) TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("23b872dd")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func TransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC721TransactorImpl) Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("095ea7b3")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Approve not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC721TransactorImpl) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a22cb465")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SetApprovalForAll not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(operator, approved)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterTransfer returns Transfer events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC721FiltererImpl) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*ERC721TransferEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC721TransferEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.TokenId}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransfer stream Transfer events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC721FiltererImpl) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721TransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Transfer not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(from, to, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC721TransferEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.TokenId}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterApproval returns Approval events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC721FiltererImpl) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*ERC721ApprovalEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, approved, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC721ApprovalEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Approved, &event.TokenId}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchApproval stream Approval events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC721FiltererImpl) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event Approval not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, approved, tokenId)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC721ApprovalEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Approved, &event.TokenId}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterApprovalForAll returns ApprovalForAll events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC721FiltererImpl) FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*ERC721ApprovalForAllEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event ApprovalForAll not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, operator)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC721ApprovalForAllEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Operator, &event.Approved}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchApprovalForAll stream ApprovalForAll events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC721FiltererImpl) WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event ApprovalForAll not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(owner, operator)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC721ApprovalForAllEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Operator, &event.Approved}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockERC721 in-memory ERC721 implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockERC721 struct {
+	SupportsInterfaceFunc        func(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error)
+	BalanceOfFunc                func(ctx context.Context, owner address.Address) (ret0 *big.Int, err error)
+	OwnerOfFunc                  func(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	SafeTransferFromWithDataFunc func(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFromFunc         func(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	TransferFromFunc             func(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	ApproveFunc                  func(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SetApprovalForAllFunc        func(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	GetApprovedFunc              func(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error)
+	IsApprovedForAllFunc         func(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error)
+	NameFunc                     func(ctx context.Context) (ret0 string, err error)
+	SymbolFunc                   func(ctx context.Context) (ret0 string, err error)
+	TokenURIFunc                 func(ctx context.Context, tokenId *big.Int) (ret0 string, err error)
+	FilterTransferFunc           func(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*ERC721TransferEvent, err error)
+	WatchTransferFunc            func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721TransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+	FilterApprovalFunc           func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*ERC721ApprovalEvent, err error)
+	WatchApprovalFunc            func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error)
+	FilterApprovalForAllFunc     func(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*ERC721ApprovalForAllEvent, err error)
+	WatchApprovalForAllFunc      func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error)
+}
+
+var _ ERC721 = (*MockERC721)(nil)
+
+func (mock *MockERC721) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
+	if mock.SupportsInterfaceFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SupportsInterface not stubbed")
+		return
+	}
+
+	return mock.SupportsInterfaceFunc(ctx, interfaceId)
+}
+
+func (mock *MockERC721) BalanceOf(ctx context.Context, owner address.Address) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, owner)
+}
+
+func (mock *MockERC721) OwnerOf(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	if mock.OwnerOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func OwnerOf not stubbed")
+		return
+	}
+
+	return mock.OwnerOfFunc(ctx, tokenId)
+}
+
+func (mock *MockERC721) SafeTransferFromWithData(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SafeTransferFromWithDataFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SafeTransferFromWithData not stubbed")
+		return
+	}
+
+	return mock.SafeTransferFromWithDataFunc(ctx, from, to, tokenId, data, ops...)
+}
+
+func (mock *MockERC721) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SafeTransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SafeTransferFrom not stubbed")
+		return
+	}
+
+	return mock.SafeTransferFromFunc(ctx, from, to, tokenId, ops...)
+}
+
+func (mock *MockERC721) TransferFrom(ctx context.Context, from address.Address, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.TransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TransferFrom not stubbed")
+		return
+	}
+
+	return mock.TransferFromFunc(ctx, from, to, tokenId, ops...)
+}
+
+func (mock *MockERC721) Approve(ctx context.Context, to address.Address, tokenId *big.Int, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.ApproveFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Approve not stubbed")
+		return
+	}
+
+	return mock.ApproveFunc(ctx, to, tokenId, ops...)
+}
+
+func (mock *MockERC721) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SetApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SetApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.SetApprovalForAllFunc(ctx, operator, approved, ops...)
+}
+
+func (mock *MockERC721) GetApproved(ctx context.Context, tokenId *big.Int) (ret0 address.Address, err error) {
+	if mock.GetApprovedFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func GetApproved not stubbed")
+		return
+	}
+
+	return mock.GetApprovedFunc(ctx, tokenId)
+}
+
+func (mock *MockERC721) IsApprovedForAll(ctx context.Context, owner address.Address, operator address.Address) (ret0 bool, err error) {
+	if mock.IsApprovedForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func IsApprovedForAll not stubbed")
+		return
+	}
+
+	return mock.IsApprovedForAllFunc(ctx, owner, operator)
+}
+
+func (mock *MockERC721) Name(ctx context.Context) (ret0 string, err error) {
+	if mock.NameFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Name not stubbed")
+		return
+	}
+
+	return mock.NameFunc(ctx)
+}
+
+func (mock *MockERC721) Symbol(ctx context.Context) (ret0 string, err error) {
+	if mock.SymbolFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Symbol not stubbed")
+		return
+	}
+
+	return mock.SymbolFunc(ctx)
+}
+
+func (mock *MockERC721) TokenURI(ctx context.Context, tokenId *big.Int) (ret0 string, err error) {
+	if mock.TokenURIFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func TokenURI not stubbed")
+		return
+	}
+
+	return mock.TokenURIFunc(ctx, tokenId)
+}
+
+func (mock *MockERC721) FilterTransfer(ctx context.Context, opts *binding.FilterOpts, from []address.Address, to []address.Address, tokenId []*big.Int) (events []*ERC721TransferEvent, err error) {
+	if mock.FilterTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransfer not stubbed")
+		return
+	}
+
+	return mock.FilterTransferFunc(ctx, opts, from, to, tokenId)
+}
+
+func (mock *MockERC721) WatchTransfer(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721TransferEvent, from []address.Address, to []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	if mock.WatchTransferFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransfer not stubbed")
+		return
+	}
+
+	return mock.WatchTransferFunc(ctx, opts, sink, from, to, tokenId)
+}
+
+func (mock *MockERC721) FilterApproval(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, approved []address.Address, tokenId []*big.Int) (events []*ERC721ApprovalEvent, err error) {
+	if mock.FilterApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApproval not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalFunc(ctx, opts, owner, approved, tokenId)
+}
+
+func (mock *MockERC721) WatchApproval(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalEvent, owner []address.Address, approved []address.Address, tokenId []*big.Int) (sub client.Subscription, err error) {
+	if mock.WatchApprovalFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApproval not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalFunc(ctx, opts, sink, owner, approved, tokenId)
+}
+
+func (mock *MockERC721) FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, owner []address.Address, operator []address.Address) (events []*ERC721ApprovalForAllEvent, err error) {
+	if mock.FilterApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalForAllFunc(ctx, opts, owner, operator)
+}
+
+func (mock *MockERC721) WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC721ApprovalForAllEvent, owner []address.Address, operator []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalForAllFunc(ctx, opts, sink, owner, operator)
+}
+
+// ERC1155Caller view/pure funcs of contract ERC1155
+type ERC1155Caller interface {
+	SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error)
+	BalanceOf(ctx context.Context, account address.Address, id *big.Int) (ret0 *big.Int, err error)
+	BalanceOfBatch(ctx context.Context, accounts []address.Address, ids []*big.Int) (ret0 []*big.Int, err error)
+	IsApprovedForAll(ctx context.Context, account address.Address, operator address.Address) (ret0 bool, err error)
+	Uri(ctx context.Context, id *big.Int) (ret0 string, err error)
+}
+
+// ERC1155Transactor state-changing funcs of contract ERC1155
+type ERC1155Transactor interface {
+	SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, id *big.Int, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeBatchTransferFrom(ctx context.Context, from address.Address, to address.Address, ids []*big.Int, amounts []*big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+}
+
+// ERC1155Filterer event filterers and watchers of contract ERC1155
+type ERC1155Filterer interface {
+	FilterTransferSingle(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferSingleEvent, err error)
+	WatchTransferSingle(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferSingleEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterTransferBatch(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferBatchEvent, err error)
+	WatchTransferBatch(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferBatchEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, account []address.Address, operator []address.Address) (events []*ERC1155ApprovalForAllEvent, err error)
+	WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155ApprovalForAllEvent, account []address.Address, operator []address.Address) (sub client.Subscription, err error)
+	FilterURI(ctx context.Context, opts *binding.FilterOpts, id []*big.Int) (events []*ERC1155URIEvent, err error)
+	WatchURI(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155URIEvent, id []*big.Int) (sub client.Subscription, err error)
+}
+
+// ERC1155 contract ERC1155 binding interface
+type ERC1155 interface {
+	ERC1155Caller
+	ERC1155Transactor
+	ERC1155Filterer
+}
+
+// ERC1155TransferSingleEvent event TransferSingle(address,address,address,uint256,uint256) of contract ERC1155
+type ERC1155TransferSingleEvent struct {
+	Operator address.Address
+	From     address.Address
+	To       address.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC1155TransferBatchEvent event TransferBatch(address,address,address,uint256[],uint256[]) of contract ERC1155
+type ERC1155TransferBatchEvent struct {
+	Operator address.Address
+	From     address.Address
+	To       address.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC1155ApprovalForAllEvent event ApprovalForAll(address,address,bool) of contract ERC1155
+type ERC1155ApprovalForAllEvent struct {
+	Account  address.Address
+	Operator address.Address
+	Approved bool
+	Raw      *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC1155URIEvent event URI(string,uint256) of contract ERC1155
+type ERC1155URIEvent struct {
+	Value string
+	Id    *big.Int
+	Raw   *client.Log // raw log, Raw.Removed is true if the log is removed by chain reorganization
+}
+
+// ERC1155ABI json abi of contract ERC1155
+const ERC1155ABI = `[{"type":"event","name":"TransferSingle","inputs":[{"name":"operator","type":"address","internalType":"address","indexed":true},{"name":"from","type":"address","internalType":"address","indexed":true},{"name":"to","type":"address","internalType":"address","indexed":true},{"name":"id","type":"uint256","internalType":"uint256","indexed":false},{"name":"value","type":"uint256","internalType":"uint256","indexed":false}],"anonymous":false},{"type":"event","name":"TransferBatch","inputs":[{"name":"operator","type":"address","internalType":"address","indexed":true},{"name":"from","type":"address","internalType":"address","indexed":true},{"name":"to","type":"address","internalType":"address","indexed":true},{"name":"ids","type":"uint256[]","internalType":"uint256[]","indexed":false},{"name":"values","type":"uint256[]","internalType":"uint256[]","indexed":false}],"anonymous":false},{"type":"event","name":"ApprovalForAll","inputs":[{"name":"account","type":"address","internalType":"address","indexed":true},{"name":"operator","type":"address","internalType":"address","indexed":true},{"name":"approved","type":"bool","internalType":"bool","indexed":false}],"anonymous":false},{"type":"event","name":"URI","inputs":[{"name":"value","type":"string","internalType":"string","indexed":false},{"name":"id","type":"uint256","internalType":"uint256","indexed":true}],"anonymous":false},{"type":"function","name":"supportsInterface","inputs":[{"name":"interfaceId","type":"bytes4","internalType":"bytes4"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"view"},{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address","internalType":"address"},{"name":"id","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"},{"type":"function","name":"balanceOfBatch","inputs":[{"name":"accounts","type":"address[]","internalType":"address[]"},{"name":"ids","type":"uint256[]","internalType":"uint256[]"}],"outputs":[{"name":"","type":"uint256[]","internalType":"uint256[]"}],"stateMutability":"view"},{"type":"function","name":"setApprovalForAll","inputs":[{"name":"operator","type":"address","internalType":"address"},{"name":"approved","type":"bool","internalType":"bool"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"isApprovedForAll","inputs":[{"name":"account","type":"address","internalType":"address"},{"name":"operator","type":"address","internalType":"address"}],"outputs":[{"name":"","type":"bool","internalType":"bool"}],"stateMutability":"view"},{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address","internalType":"address"},{"name":"to","type":"address","internalType":"address"},{"name":"id","type":"uint256","internalType":"uint256"},{"name":"amount","type":"uint256","internalType":"uint256"},{"name":"data","type":"bytes","internalType":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"safeBatchTransferFrom","inputs":[{"name":"from","type":"address","internalType":"address"},{"name":"to","type":"address","internalType":"address"},{"name":"ids","type":"uint256[]","internalType":"uint256[]"},{"name":"amounts","type":"uint256[]","internalType":"uint256[]"},{"name":"data","type":"bytes","internalType":"bytes"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"uri","inputs":[{"name":"id","type":"uint256","internalType":"uint256"}],"outputs":[{"name":"","type":"string","internalType":"string"}],"stateMutability":"view"}]`
+
+var (
+	parsedERC1155Once sync.Once
+	parsedERC1155     abi.Contract
+	parsedERC1155Err  error
+)
+
+// ERC1155Contract returns the abi.Contract of ERC1155ABI, which is parsed once on first use
+func ERC1155Contract() (abi.Contract, error) {
+	parsedERC1155Once.Do(func() {
+		parsedERC1155, parsedERC1155Err = binding.Parse("ERC1155", []byte(ERC1155ABI), binding.NewSymbols())
+	})
+
+	return parsedERC1155, parsedERC1155Err
+}
+
+// NewERC1155 create ERC1155 binding of contract deployed at recipient, signer can be nil
+// if only view/pure funcs are called
+func NewERC1155(recipient address.Address, provider client.Provider, signer signer.Signer) (*ERC1155Impl, error) {
+	contract, err := ERC1155Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewERC1155Impl(contract, provider, signer, recipient.Hex()), nil
+}
+
+// NewERC1155Caller create ERC1155Caller of contract deployed at recipient
+func NewERC1155Caller(recipient address.Address, provider client.Provider) (*ERC1155CallerImpl, error) {
+	contract, err := ERC1155Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ERC1155CallerImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// NewERC1155Filterer create ERC1155Filterer of contract deployed at recipient
+func NewERC1155Filterer(recipient address.Address, provider client.Provider) (*ERC1155FiltererImpl, error) {
+	contract, err := ERC1155Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ERC1155FiltererImpl{
+		Contract:  contract,
+		Client:    provider,
+		Recipient: recipient.Hex(),
+	}, nil
+}
+
+// ERC1155CallerImpl ERC1155Caller implementation calling contract via provider
+type ERC1155CallerImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ERC1155TransactorImpl ERC1155Transactor implementation sending signed transactions via provider
+type ERC1155TransactorImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Signer    signer.Signer
+	Recipient string
+}
+
+// ERC1155FiltererImpl ERC1155Filterer implementation querying and subscribing logs via provider
+type ERC1155FiltererImpl struct {
+	Contract  abi.Contract
+	Client    client.Provider
+	Recipient string
+}
+
+// ERC1155Impl ERC1155 implementation
+type ERC1155Impl struct {
+	*ERC1155CallerImpl
+	*ERC1155TransactorImpl
+	*ERC1155FiltererImpl
+}
+
+// NewERC1155Impl create ERC1155 implementation of contract deployed at recipient
+func NewERC1155Impl(contract abi.Contract, provider client.Provider, signer signer.Signer, recipient string) *ERC1155Impl {
+	return &ERC1155Impl{
+		ERC1155CallerImpl: &ERC1155CallerImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+		ERC1155TransactorImpl: &ERC1155TransactorImpl{
+			Contract:  contract,
+			Client:    provider,
+			Signer:    signer,
+			Recipient: recipient,
+		},
+		ERC1155FiltererImpl: &ERC1155FiltererImpl{
+			Contract:  contract,
+			Client:    provider,
+			Recipient: recipient,
+		},
+	}
+}
+
+var _ ERC1155 = (*ERC1155Impl)(nil)
+
+func (impl *ERC1155CallerImpl) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("01ffc9a7")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SupportsInterface not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(interfaceId)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC1155CallerImpl) BalanceOf(ctx context.Context, account address.Address, id *big.Int) (ret0 *big.Int, err error) {
+	f, ok := impl.Contract.Select("00fdd58e")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOf not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(account, id)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC1155CallerImpl) BalanceOfBatch(ctx context.Context, accounts []address.Address, ids []*big.Int) (ret0 []*big.Int, err error) {
+	f, ok := impl.Contract.Select("4e1273f4")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func BalanceOfBatch not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(accounts, ids)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC1155CallerImpl) IsApprovedForAll(ctx context.Context, account address.Address, operator address.Address) (ret0 bool, err error) {
+	f, ok := impl.Contract.Select("e985e9c5")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func IsApprovedForAll not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(account, operator)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC1155CallerImpl) Uri(ctx context.Context, id *big.Int) (ret0 string, err error) {
+	f, ok := impl.Contract.Select("0e89341c")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func Uri not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(id)
+
+	if err != nil {
+		return
+	}
+
+	callSite := &client.CallSite{
+		To:   impl.Recipient,
+		Data: "0x" + hex.EncodeToString(buff),
+	}
+
+	var ret string
+
+	ret, err = impl.Client.Call(ctx, callSite)
+
+	if err != nil {
+		return
+	}
+
+	buff, err = hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return
+	}
+
+	_, err = f.Return(buff, []interface{}{&ret0})
+
+	return
+}
+
+func (impl *ERC1155TransactorImpl) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("a22cb465")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SetApprovalForAll not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(operator, approved)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC1155TransactorImpl) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, id *big.Int, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("f242432a")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SafeTransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, id, amount, data)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+func (impl *ERC1155TransactorImpl) SafeBatchTransferFrom(ctx context.Context, from address.Address, to address.Address, ids []*big.Int, amounts []*big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	f, ok := impl.Contract.Select("2eb2c2d6")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "func SafeBatchTransferFrom not found")
+		return
+	}
+
+	var buff []byte
+
+	buff, err = f.Call(from, to, ids, amounts, data)
+
+	if err != nil {
+		return
+	}
+
+	var callOps *abi.CallOps
+	callOps, err = abi.MakeCallOps(ctx, impl.Client, impl.Signer, ops)
+
+	if err != nil {
+		return
+	}
+
+	ret0, err = abi.MakeTransaction(ctx, impl.Client, impl.Signer, callOps, impl.Recipient, buff)
+
+	return
+}
+
+// FilterTransferSingle returns TransferSingle events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC1155FiltererImpl) FilterTransferSingle(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferSingleEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("c3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event TransferSingle not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(operator, from, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC1155TransferSingleEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Operator, &event.From, &event.To, &event.Id, &event.Value}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransferSingle stream TransferSingle events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC1155FiltererImpl) WatchTransferSingle(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferSingleEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("c3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event TransferSingle not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(operator, from, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC1155TransferSingleEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Operator, &event.From, &event.To, &event.Id, &event.Value}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterTransferBatch returns TransferBatch events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC1155FiltererImpl) FilterTransferBatch(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferBatchEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event TransferBatch not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(operator, from, to)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC1155TransferBatchEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Operator, &event.From, &event.To, &event.Ids, &event.Values}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchTransferBatch stream TransferBatch events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC1155FiltererImpl) WatchTransferBatch(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferBatchEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event TransferBatch not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(operator, from, to)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC1155TransferBatchEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Operator, &event.From, &event.To, &event.Ids, &event.Values}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterApprovalForAll returns ApprovalForAll events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC1155FiltererImpl) FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, account []address.Address, operator []address.Address) (events []*ERC1155ApprovalForAllEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event ApprovalForAll not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(account, operator)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC1155ApprovalForAllEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Account, &event.Operator, &event.Approved}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchApprovalForAll stream ApprovalForAll events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC1155FiltererImpl) WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155ApprovalForAllEvent, account []address.Address, operator []address.Address) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event ApprovalForAll not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(account, operator)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC1155ApprovalForAllEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Account, &event.Operator, &event.Approved}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// FilterURI returns URI events of the block range, each indexed argument
+// is an OR-set and nil matches any value
+func (impl *ERC1155FiltererImpl) FilterURI(ctx context.Context, opts *binding.FilterOpts, id []*big.Int) (events []*ERC1155URIEvent, err error) {
+	e, ok := impl.Contract.SelectEvent("6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event URI not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(id)
+
+	if err != nil {
+		return
+	}
+
+	var logs []*client.Log
+
+	logs, err = binding.FilterLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts)
+
+	if err != nil {
+		return
+	}
+
+	for _, log := range logs {
+		event := &ERC1155URIEvent{Raw: log}
+
+		if err = abi.UnpackLog(e, log, []interface{}{&event.Value, &event.Id}); err != nil {
+			return
+		}
+
+		events = append(events, event)
+	}
+
+	return
+}
+
+// WatchURI stream URI events to sink until sub is unsubscribed, the events of
+// logs removed by chain reorganization are sent with Raw.Removed set
+func (impl *ERC1155FiltererImpl) WatchURI(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155URIEvent, id []*big.Int) (sub client.Subscription, err error) {
+	e, ok := impl.Contract.SelectEvent("6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b")
+
+	if !ok {
+		err = errors.Wrap(binding.ErrBinding, "event URI not found")
+		return
+	}
+
+	var topics [][]string
+
+	topics, err = e.FilterTopics(id)
+
+	if err != nil {
+		return
+	}
+
+	return binding.WatchLogs(ctx, impl.Client, binding.LogQuery(impl.Recipient, topics), opts, func(ctx context.Context, log *client.Log) error {
+		event := &ERC1155URIEvent{Raw: log}
+
+		if err := abi.UnpackLog(e, log, []interface{}{&event.Value, &event.Id}); err != nil {
+			return err
+		}
+
+		select {
+		case sink <- event:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MockERC1155 in-memory ERC1155 implementation, each func is stubbed by the
+// corresponding <Func>Func field, calling an unstubbed func returns binding.ErrMock
+type MockERC1155 struct {
+	SupportsInterfaceFunc     func(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error)
+	BalanceOfFunc             func(ctx context.Context, account address.Address, id *big.Int) (ret0 *big.Int, err error)
+	BalanceOfBatchFunc        func(ctx context.Context, accounts []address.Address, ids []*big.Int) (ret0 []*big.Int, err error)
+	SetApprovalForAllFunc     func(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	IsApprovedForAllFunc      func(ctx context.Context, account address.Address, operator address.Address) (ret0 bool, err error)
+	SafeTransferFromFunc      func(ctx context.Context, from address.Address, to address.Address, id *big.Int, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	SafeBatchTransferFromFunc func(ctx context.Context, from address.Address, to address.Address, ids []*big.Int, amounts []*big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error)
+	UriFunc                   func(ctx context.Context, id *big.Int) (ret0 string, err error)
+	FilterTransferSingleFunc  func(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferSingleEvent, err error)
+	WatchTransferSingleFunc   func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferSingleEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterTransferBatchFunc   func(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferBatchEvent, err error)
+	WatchTransferBatchFunc    func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferBatchEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error)
+	FilterApprovalForAllFunc  func(ctx context.Context, opts *binding.FilterOpts, account []address.Address, operator []address.Address) (events []*ERC1155ApprovalForAllEvent, err error)
+	WatchApprovalForAllFunc   func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155ApprovalForAllEvent, account []address.Address, operator []address.Address) (sub client.Subscription, err error)
+	FilterURIFunc             func(ctx context.Context, opts *binding.FilterOpts, id []*big.Int) (events []*ERC1155URIEvent, err error)
+	WatchURIFunc              func(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155URIEvent, id []*big.Int) (sub client.Subscription, err error)
+}
+
+var _ ERC1155 = (*MockERC1155)(nil)
+
+func (mock *MockERC1155) SupportsInterface(ctx context.Context, interfaceId [4]byte) (ret0 bool, err error) {
+	if mock.SupportsInterfaceFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SupportsInterface not stubbed")
+		return
+	}
+
+	return mock.SupportsInterfaceFunc(ctx, interfaceId)
+}
+
+func (mock *MockERC1155) BalanceOf(ctx context.Context, account address.Address, id *big.Int) (ret0 *big.Int, err error) {
+	if mock.BalanceOfFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOf not stubbed")
+		return
+	}
+
+	return mock.BalanceOfFunc(ctx, account, id)
+}
+
+func (mock *MockERC1155) BalanceOfBatch(ctx context.Context, accounts []address.Address, ids []*big.Int) (ret0 []*big.Int, err error) {
+	if mock.BalanceOfBatchFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func BalanceOfBatch not stubbed")
+		return
+	}
+
+	return mock.BalanceOfBatchFunc(ctx, accounts, ids)
+}
+
+func (mock *MockERC1155) SetApprovalForAll(ctx context.Context, operator address.Address, approved bool, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SetApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SetApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.SetApprovalForAllFunc(ctx, operator, approved, ops...)
+}
+
+func (mock *MockERC1155) IsApprovedForAll(ctx context.Context, account address.Address, operator address.Address) (ret0 bool, err error) {
+	if mock.IsApprovedForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func IsApprovedForAll not stubbed")
+		return
+	}
+
+	return mock.IsApprovedForAllFunc(ctx, account, operator)
+}
+
+func (mock *MockERC1155) SafeTransferFrom(ctx context.Context, from address.Address, to address.Address, id *big.Int, amount *big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SafeTransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SafeTransferFrom not stubbed")
+		return
+	}
+
+	return mock.SafeTransferFromFunc(ctx, from, to, id, amount, data, ops...)
+}
+
+func (mock *MockERC1155) SafeBatchTransferFrom(ctx context.Context, from address.Address, to address.Address, ids []*big.Int, amounts []*big.Int, data []byte, ops ...abi.Op) (ret0 abi.Transaction, err error) {
+	if mock.SafeBatchTransferFromFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func SafeBatchTransferFrom not stubbed")
+		return
+	}
+
+	return mock.SafeBatchTransferFromFunc(ctx, from, to, ids, amounts, data, ops...)
+}
+
+func (mock *MockERC1155) Uri(ctx context.Context, id *big.Int) (ret0 string, err error) {
+	if mock.UriFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func Uri not stubbed")
+		return
+	}
+
+	return mock.UriFunc(ctx, id)
+}
+
+func (mock *MockERC1155) FilterTransferSingle(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferSingleEvent, err error) {
+	if mock.FilterTransferSingleFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransferSingle not stubbed")
+		return
+	}
+
+	return mock.FilterTransferSingleFunc(ctx, opts, operator, from, to)
+}
+
+func (mock *MockERC1155) WatchTransferSingle(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferSingleEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchTransferSingleFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransferSingle not stubbed")
+		return
+	}
+
+	return mock.WatchTransferSingleFunc(ctx, opts, sink, operator, from, to)
+}
+
+func (mock *MockERC1155) FilterTransferBatch(ctx context.Context, opts *binding.FilterOpts, operator []address.Address, from []address.Address, to []address.Address) (events []*ERC1155TransferBatchEvent, err error) {
+	if mock.FilterTransferBatchFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterTransferBatch not stubbed")
+		return
+	}
+
+	return mock.FilterTransferBatchFunc(ctx, opts, operator, from, to)
+}
+
+func (mock *MockERC1155) WatchTransferBatch(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155TransferBatchEvent, operator []address.Address, from []address.Address, to []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchTransferBatchFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchTransferBatch not stubbed")
+		return
+	}
+
+	return mock.WatchTransferBatchFunc(ctx, opts, sink, operator, from, to)
+}
+
+func (mock *MockERC1155) FilterApprovalForAll(ctx context.Context, opts *binding.FilterOpts, account []address.Address, operator []address.Address) (events []*ERC1155ApprovalForAllEvent, err error) {
+	if mock.FilterApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.FilterApprovalForAllFunc(ctx, opts, account, operator)
+}
+
+func (mock *MockERC1155) WatchApprovalForAll(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155ApprovalForAllEvent, account []address.Address, operator []address.Address) (sub client.Subscription, err error) {
+	if mock.WatchApprovalForAllFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchApprovalForAll not stubbed")
+		return
+	}
+
+	return mock.WatchApprovalForAllFunc(ctx, opts, sink, account, operator)
+}
+
+func (mock *MockERC1155) FilterURI(ctx context.Context, opts *binding.FilterOpts, id []*big.Int) (events []*ERC1155URIEvent, err error) {
+	if mock.FilterURIFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func FilterURI not stubbed")
+		return
+	}
+
+	return mock.FilterURIFunc(ctx, opts, id)
+}
+
+func (mock *MockERC1155) WatchURI(ctx context.Context, opts *binding.WatchOpts, sink chan<- *ERC1155URIEvent, id []*big.Int) (sub client.Subscription, err error) {
+	if mock.WatchURIFunc == nil {
+		err = errors.Wrap(binding.ErrMock, "func WatchURI not stubbed")
+		return
+	}
+
+	return mock.WatchURIFunc(ctx, opts, sink, id)
+}
@@ -0,0 +1,16 @@
+package token
+
+import "github.com/libs4go/errors"
+
+// ScopeOfAPIError .
+const errVendor = "ethers-token"
+
+// errors
+var (
+	ErrReturn    = errors.New("token call returns false", errors.WithVendor(errVendor), errors.WithCode(-1))
+	ErrMetadata  = errors.New("invalid token metadata", errors.WithVendor(errVendor), errors.WithCode(-2))
+	ErrPrecision = errors.New("amount precision exceeds token decimals", errors.WithVendor(errVendor), errors.WithCode(-3))
+	ErrAllowance = errors.New("insufficient allowance", errors.WithVendor(errVendor), errors.WithCode(-4))
+	ErrSigner    = errors.New("signer expect", errors.WithVendor(errVendor), errors.WithCode(-5))
+	ErrEvent     = errors.New("unknown token event", errors.WithVendor(errVendor), errors.WithCode(-6))
+)
@@ -0,0 +1,134 @@
+package token
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/client"
+)
+
+// selectEvent find the event of log topic0 in contract
+func selectEvent(contract abi.Contract, log *client.Log) (abi.Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.Wrap(ErrEvent, "log without topic")
+	}
+
+	e, ok := contract.SelectEvent(log.Topics[0])
+
+	if !ok {
+		return nil, errors.Wrap(ErrEvent, "unknown event topic %s", log.Topics[0])
+	}
+
+	return e, nil
+}
+
+// decoded returns event if err is nil
+func decoded(event interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// DecodeERC20Event decode ERC-20 log into *ERC20TransferEvent or *ERC20ApprovalEvent, the ERC-721
+// logs of the same signatures are rejected by the indexed topics count
+func DecodeERC20Event(log *client.Log) (interface{}, error) {
+	contract, err := ERC20Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := selectEvent(contract, log)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.Name() {
+	case "Transfer":
+		event := &ERC20TransferEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.Value}))
+	default:
+		event := &ERC20ApprovalEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Spender, &event.Value}))
+	}
+}
+
+// DecodeERC721Event decode ERC-721 log into *ERC721TransferEvent, *ERC721ApprovalEvent or
+// *ERC721ApprovalForAllEvent
+func DecodeERC721Event(log *client.Log) (interface{}, error) {
+	contract, err := ERC721Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := selectEvent(contract, log)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.Name() {
+	case "Transfer":
+		event := &ERC721TransferEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.From, &event.To, &event.TokenId}))
+	case "Approval":
+		event := &ERC721ApprovalEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Approved, &event.TokenId}))
+	default:
+		event := &ERC721ApprovalForAllEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Owner, &event.Operator, &event.Approved}))
+	}
+}
+
+// DecodeERC1155Event decode ERC-1155 log into *ERC1155TransferSingleEvent, *ERC1155TransferBatchEvent,
+// *ERC1155ApprovalForAllEvent or *ERC1155URIEvent
+func DecodeERC1155Event(log *client.Log) (interface{}, error) {
+	contract, err := ERC1155Contract()
+
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := selectEvent(contract, log)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.Name() {
+	case "TransferSingle":
+		event := &ERC1155TransferSingleEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Operator, &event.From, &event.To, &event.Id, &event.Value}))
+	case "TransferBatch":
+		event := &ERC1155TransferBatchEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Operator, &event.From, &event.To, &event.Ids, &event.Values}))
+	case "ApprovalForAll":
+		event := &ERC1155ApprovalForAllEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Account, &event.Operator, &event.Approved}))
+	default:
+		event := &ERC1155URIEvent{Raw: log}
+
+		return decoded(event, abi.UnpackLog(e, log, []interface{}{&event.Value, &event.Id}))
+	}
+}
+
+// TokenURI substitute the {id} placeholder of ERC-1155 metadata uri with the lowercase
+// 64 hex chars token id
+func TokenURI(uri string, id *big.Int) string {
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id))
+}
@@ -0,0 +1,399 @@
+// Package token provides the pre-generated bindings of ERC-20, ERC-721 and ERC-1155 with their
+// metadata extensions, and Token, the ERC-20 toolkit whose amounts are scaled by the token
+// decimals and which copes with the non-standard tokens, e.g. USDT returns no bool and MKR
+// returns bytes32 name and symbol
+package token
+
+//go:generate go run ../cmd/abigen -pkg token -out bindings.go ERC20=abi/ERC20.json ERC721=abi/ERC721.json ERC1155=abi/ERC1155.json
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/abi/binding"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/signer"
+	"github.com/libs4go/fixed"
+)
+
+// Token ERC-20 token whose amounts are fixed.Number scaled by the token decimals
+type Token struct {
+	Binding      *ERC20Impl      // generated binding of raw amounts
+	Address      address.Address // token address
+	Decimals     int             // token decimals
+	PollInterval time.Duration   // receipt polling interval, 0 is client.DefaultReceiptInterval
+}
+
+// New create token deployed at addr, the decimals are read on-chain, signer can be nil if no
+// transaction is sent
+func New(ctx context.Context, addr address.Address, provider client.Provider, s signer.Signer) (*Token, error) {
+	impl, err := NewERC20(addr, provider, s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := impl.Decimals(ctx)
+
+	if err != nil {
+		return nil, errors.Wrap(ErrMetadata, "read token %s decimals error: %s", addr.Hex(), err)
+	}
+
+	return &Token{
+		Binding:  impl,
+		Address:  addr,
+		Decimals: int(decimals.Int64()),
+	}, nil
+}
+
+// Amount scale raw amount by token decimals
+func (t *Token) Amount(raw *big.Int) *fixed.Number {
+	return &fixed.Number{
+		RawValue: new(big.Int).Set(raw),
+		Decimals: t.Decimals,
+	}
+}
+
+// Raw returns the raw amount of fixed number, which is rescaled to token decimals, ErrPrecision
+// is returned if the amount has more fractional digits than token decimals
+func (t *Token) Raw(amount *fixed.Number) (*big.Int, error) {
+	diff := t.Decimals - amount.Decimals
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(diff))), nil)
+
+	if diff >= 0 {
+		return new(big.Int).Mul(amount.RawValue, scale), nil
+	}
+
+	quo, rem := new(big.Int).QuoRem(amount.RawValue, scale, new(big.Int))
+
+	if rem.Sign() != 0 {
+		return nil, errors.Wrap(ErrPrecision, "amount %s has more than %d decimals", amount.Float().Text('f', amount.Decimals), t.Decimals)
+	}
+
+	return quo, nil
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// Name returns the token name, the bytes32 name of non-standard token is supported
+func (t *Token) Name(ctx context.Context) (string, error) {
+	return t.text(ctx, "name()")
+}
+
+// Symbol returns the token symbol, the bytes32 symbol of non-standard token is supported
+func (t *Token) Symbol(ctx context.Context) (string, error) {
+	return t.text(ctx, "symbol()")
+}
+
+func (t *Token) text(ctx context.Context, signature string) (string, error) {
+	ret, err := t.Binding.ERC20CallerImpl.Client.Call(ctx, &client.CallSite{
+		To:   t.Address.Hex(),
+		Data: "0x" + hex.EncodeToString(abi.Selector(signature)),
+	})
+
+	if err != nil {
+		return "", errors.Wrap(err, "call %s %s error", t.Address.Hex(), signature)
+	}
+
+	buff, err := hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return "", errors.Wrap(err, "decode eth_call result %s error", ret)
+	}
+
+	return decodeText(buff)
+}
+
+// decodeText decode abi string or bytes32 text return
+func decodeText(buff []byte) (string, error) {
+	if len(buff) == 32 {
+		text := bytes.TrimRight(buff, "\x00")
+
+		if !utf8.Valid(text) {
+			return "", errors.Wrap(ErrMetadata, "invalid bytes32 text 0x%x", buff)
+		}
+
+		return string(text), nil
+	}
+
+	encoder, err := abi.String()
+
+	if err != nil {
+		return "", err
+	}
+
+	decoder, err := abi.Tuple("outputs", encoder)
+
+	if err != nil {
+		return "", err
+	}
+
+	var text string
+
+	if _, err := decoder.Unmarshal(buff, []interface{}{&text}); err != nil {
+		return "", errors.Wrap(ErrMetadata, "invalid string return 0x%x: %s", buff, err)
+	}
+
+	return text, nil
+}
+
+// TotalSupply returns the scaled total supply
+func (t *Token) TotalSupply(ctx context.Context) (*fixed.Number, error) {
+	raw, err := t.Binding.TotalSupply(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Amount(raw), nil
+}
+
+// BalanceOf returns the scaled balance of owner
+func (t *Token) BalanceOf(ctx context.Context, owner address.Address) (*fixed.Number, error) {
+	raw, err := t.Binding.BalanceOf(ctx, owner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Amount(raw), nil
+}
+
+// Allowance returns the scaled allowance of spender approved by owner
+func (t *Token) Allowance(ctx context.Context, owner address.Address, spender address.Address) (*fixed.Number, error) {
+	raw, err := t.Binding.Allowance(ctx, owner, spender)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Amount(raw), nil
+}
+
+// CheckAllowance returns ErrAllowance if the allowance of spender approved by owner is less than amount
+func (t *Token) CheckAllowance(ctx context.Context, owner address.Address, spender address.Address, amount *fixed.Number) error {
+	required, err := t.Raw(amount)
+
+	if err != nil {
+		return err
+	}
+
+	allowance, err := t.Binding.Allowance(ctx, owner, spender)
+
+	if err != nil {
+		return err
+	}
+
+	if allowance.Cmp(required) < 0 {
+		return errors.Wrap(ErrAllowance, "allowance %s of spender %s < %s", t.Amount(allowance).Float().String(), spender.Hex(), amount.Float().String())
+	}
+
+	return nil
+}
+
+// Receipt mined transaction receipt with the decoded events of token
+type Receipt struct {
+	*client.TransactionReceipt
+	Transfers []*ERC20TransferEvent
+	Approvals []*ERC20ApprovalEvent
+}
+
+// Transfer transfer amount to recipient and wait the receipt
+func (t *Token) Transfer(ctx context.Context, to address.Address, amount *fixed.Number, ops ...abi.Op) (*Receipt, error) {
+	raw, err := t.Raw(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.send(ctx, "transfer(address,uint256)", []interface{}{to, raw}, ops)
+}
+
+// TransferFrom transfer amount from sender to recipient with the allowance of signer and wait the receipt
+func (t *Token) TransferFrom(ctx context.Context, from address.Address, to address.Address, amount *fixed.Number, ops ...abi.Op) (*Receipt, error) {
+	raw, err := t.Raw(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.send(ctx, "transferFrom(address,address,uint256)", []interface{}{from, to, raw}, ops)
+}
+
+// SafeApprove approve amount to spender and wait the receipt, the allowance is reset to zero first
+// if both the current and the new allowance are non-zero, which is required by tokens like USDT,
+// the nonce op of caller only applies to the first transaction.
+// Returns nil receipt and nil error if the allowance equals amount already, nothing is sent
+func (t *Token) SafeApprove(ctx context.Context, spender address.Address, amount *fixed.Number, ops ...abi.Op) (*Receipt, error) {
+	s := t.Binding.Signer
+
+	if s == nil {
+		return nil, errors.Wrap(ErrSigner, "approve token %s", t.Address.Hex())
+	}
+
+	raw, err := t.Raw(amount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := t.Binding.Allowance(ctx, address.HexToAddress(s.Addresss()), spender)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Cmp(raw) == 0 {
+		return nil, nil
+	}
+
+	if current.Sign() != 0 && raw.Sign() != 0 {
+		if _, err := t.send(ctx, "approve(address,uint256)", []interface{}{spender, big.NewInt(0)}, ops); err != nil {
+			return nil, errors.Wrap(err, "reset allowance of spender %s error", spender.Hex())
+		}
+
+		// the nonce is used by the reset transaction, read the next one from provider
+		ops = append(ops[:len(ops):len(ops)], clearNonce)
+	}
+
+	return t.send(ctx, "approve(address,uint256)", []interface{}{spender, raw}, ops)
+}
+
+func clearNonce(ops *abi.CallOps) {
+	ops.Nonce = nil
+}
+
+// send simulate the call from signer by eth_call, the optional bool return must be true, then send
+// the transaction with the estimated gas limit and wait the receipt
+func (t *Token) send(ctx context.Context, signature string, args []interface{}, ops []abi.Op) (*Receipt, error) {
+	s := t.Binding.Signer
+
+	if s == nil {
+		return nil, errors.Wrap(ErrSigner, "send %s to token %s", signature, t.Address.Hex())
+	}
+
+	provider := t.Binding.ERC20TransactorImpl.Client
+
+	f, ok := abi.TryGetFunc(t.Binding.ERC20TransactorImpl.Contract, signature)
+
+	if !ok {
+		return nil, errors.Wrap(binding.ErrBinding, "func %s not found", signature)
+	}
+
+	data, err := f.Call(args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	callsite := &client.CallSite{
+		From: s.Addresss(),
+		To:   t.Address.Hex(),
+		Data: "0x" + hex.EncodeToString(data),
+	}
+
+	ret, err := provider.Call(ctx, callsite)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "simulate %s of token %s error", signature, t.Address.Hex())
+	}
+
+	if err := checkReturn(ret); err != nil {
+		return nil, errors.Wrap(err, "simulate %s of token %s", signature, t.Address.Hex())
+	}
+
+	gas, err := provider.EstimateGas(ctx, callsite)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "estimate gas of %s of token %s error", signature, t.Address.Hex())
+	}
+
+	// the gas limit op of caller takes precedence
+	callOps, err := abi.MakeCallOps(ctx, provider, s, append([]abi.Op{abi.WithGasLimits(gas)}, ops...))
+
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := abi.MakeTransaction(ctx, provider, s, callOps, t.Address.Hex(), data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Close()
+
+	receipt, err := client.WaitReceipt(ctx, provider, tx.TX(), t.PollInterval)
+
+	if receipt == nil {
+		return nil, err
+	}
+
+	return t.decodeReceipt(receipt), err
+}
+
+// decodeReceipt decode the ERC-20 events of token in receipt, the other logs are skipped
+func (t *Token) decodeReceipt(receipt *client.TransactionReceipt) *Receipt {
+	result := &Receipt{TransactionReceipt: receipt}
+
+	for _, log := range receipt.Logs {
+		if address.HexToAddress(log.Address) != t.Address {
+			continue
+		}
+
+		event, err := DecodeERC20Event(log)
+
+		if err != nil {
+			continue
+		}
+
+		switch event := event.(type) {
+		case *ERC20TransferEvent:
+			result.Transfers = append(result.Transfers, event)
+		case *ERC20ApprovalEvent:
+			result.Approvals = append(result.Approvals, event)
+		}
+	}
+
+	return result
+}
+
+// checkReturn check the call returns nothing, e.g. USDT, or abi encoded true
+func checkReturn(ret string) error {
+	buff, err := hex.DecodeString(strings.TrimPrefix(ret, "0x"))
+
+	if err != nil {
+		return errors.Wrap(err, "decode eth_call result %s error", ret)
+	}
+
+	if len(buff) == 0 {
+		return nil
+	}
+
+	ok, _, err := abi.DecodeBool(buff)
+
+	if err != nil {
+		return errors.Wrap(ErrReturn, "invalid bool return 0x%x", buff)
+	}
+
+	if !ok {
+		return errors.Wrap(ErrReturn, "returns false")
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package token
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/libs4go/errors"
+	"github.com/libs4go/ethers/abi"
+	"github.com/libs4go/ethers/address"
+	"github.com/libs4go/ethers/client"
+	"github.com/libs4go/ethers/client/clienttest"
+	"github.com/libs4go/ethers/internal/keccak"
+	"github.com/libs4go/ethers/signer"
+	"github.com/libs4go/fixed"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tokenAddress = address.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	spender      = address.HexToAddress("0x55d398326f99059fF775485246999027B3197955")
+)
+
+func word(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, 32))
+}
+
+func addressTopic(addr address.Address) string {
+	return "0x" + hex.EncodeToString(append(make([]byte, 12), addr[:]...))
+}
+
+func eventTopic(signature string) string {
+	return "0x" + hex.EncodeToString(keccak.Hash([]byte(signature)))
+}
+
+// newTestToken create 6 decimals USDT like token, whose transfer and approve return nothing
+func newTestToken(t *testing.T) (*Token, *clienttest.Provider, signer.Signer) {
+	s, err := signer.OpenHDWallet("orchard mean picnic worry sleep squeeze auto copy hard eager island entry define dune raise spice steel voice prosper mosquito warm ignore book negative", "m/44'/60'/0'/0/0")
+
+	require.NoError(t, err)
+
+	provider := clienttest.New(1)
+
+	provider.HandleCall(tokenAddress.Hex(), "decimals()", func(data []byte) ([]byte, error) {
+		return word(big.NewInt(6)), nil
+	})
+
+	token, err := New(context.Background(), tokenAddress, provider, s)
+
+	require.NoError(t, err)
+
+	token.PollInterval = time.Millisecond
+
+	return token, provider, s
+}
+
+func TestAmount(t *testing.T) {
+	token, _, _ := newTestToken(t)
+
+	require.Equal(t, 6, token.Decimals)
+
+	amount, err := fixed.New(18, fixed.Float(1.5))
+
+	require.NoError(t, err)
+
+	raw, err := token.Raw(amount)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(1500000), raw.Int64())
+
+	amount, err = fixed.New(2, fixed.Int(3))
+
+	require.NoError(t, err)
+
+	raw, err = token.Raw(amount)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3000000), raw.Int64())
+
+	_, err = token.Raw(&fixed.Number{RawValue: big.NewInt(1), Decimals: 18})
+
+	require.True(t, errors.Is(err, ErrPrecision))
+
+	require.Equal(t, "1.5", token.Amount(big.NewInt(1500000)).Float().String())
+}
+
+func TestMetadata(t *testing.T) {
+	token, provider, _ := newTestToken(t)
+
+	// MKR returns bytes32 name
+	provider.HandleCall(tokenAddress.Hex(), "name()", func(data []byte) ([]byte, error) {
+		name := make([]byte, 32)
+
+		copy(name, "Maker")
+
+		return name, nil
+	})
+
+	provider.HandleCall(tokenAddress.Hex(), "symbol()", func(data []byte) ([]byte, error) {
+		encoder, err := abi.String()
+
+		if err != nil {
+			return nil, err
+		}
+
+		tuple, err := abi.Tuple("outputs", encoder)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return tuple.Marshal([]interface{}{"MKR"})
+	})
+
+	name, err := token.Name(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, "Maker", name)
+
+	symbol, err := token.Symbol(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, "MKR", symbol)
+}
+
+func TestTransfer(t *testing.T) {
+	token, provider, s := newTestToken(t)
+
+	to := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	// USDT transfer returns nothing
+	provider.HandleTransaction(tokenAddress.Hex(), "transfer(address,uint256)", func(data []byte) ([]*client.Log, error) {
+		return []*client.Log{{
+			Topics: []string{eventTopic("Transfer(address,address,uint256)"), addressTopic(address.HexToAddress(s.Addresss())), "0x" + hex.EncodeToString(data[:32])},
+			Data:   "0x" + hex.EncodeToString(data[32:64]),
+		}}, nil
+	})
+
+	amount, err := fixed.New(6, fixed.Float(2.5))
+
+	require.NoError(t, err)
+
+	receipt, err := token.Transfer(context.Background(), to, amount)
+
+	require.NoError(t, err)
+
+	require.True(t, receipt.Succeeded())
+	require.Len(t, receipt.Transfers, 1)
+	require.Equal(t, to, receipt.Transfers[0].To)
+	require.Equal(t, int64(2500000), receipt.Transfers[0].Value.Int64())
+
+	// the gas limit is estimated
+	require.Equal(t, int64(clienttest.DefaultGasEstimate), provider.Transactions()[0].GasLimit.Int64())
+
+	// token returns false
+	provider.HandleCall(tokenAddress.Hex(), "transfer(address,uint256)", func(data []byte) ([]byte, error) {
+		return word(big.NewInt(0)), nil
+	})
+
+	_, err = token.Transfer(context.Background(), to, amount)
+
+	require.True(t, errors.Is(err, ErrReturn))
+	require.Len(t, provider.Transactions(), 1, "simulated failure is not sent")
+
+	// the gas limit of caller takes precedence
+	provider.HandleCall(tokenAddress.Hex(), "transfer(address,uint256)", func(data []byte) ([]byte, error) {
+		return nil, nil
+	})
+
+	provider.SetGasEstimate(45000)
+
+	_, err = token.Transfer(context.Background(), to, amount)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(45000), provider.Transactions()[1].GasLimit.Int64())
+
+	_, err = token.Transfer(context.Background(), to, amount, abi.WithGasLimits(big.NewInt(100000)))
+
+	require.NoError(t, err)
+	require.Equal(t, int64(100000), provider.Transactions()[2].GasLimit.Int64())
+
+	// reverted on-chain
+	provider.HandleCall(tokenAddress.Hex(), "transfer(address,uint256)", func(data []byte) ([]byte, error) {
+		return word(big.NewInt(1)), nil
+	})
+
+	provider.HandleTransaction(tokenAddress.Hex(), "transfer(address,uint256)", func(data []byte) ([]*client.Log, error) {
+		return nil, errors.New("insufficient balance")
+	})
+
+	receipt, err = token.Transfer(context.Background(), to, amount)
+
+	require.True(t, errors.Is(err, client.ErrReverted))
+	require.False(t, receipt.Succeeded())
+}
+
+func TestSafeApprove(t *testing.T) {
+	token, provider, _ := newTestToken(t)
+
+	allowance := big.NewInt(100)
+
+	provider.HandleCall(tokenAddress.Hex(), "allowance(address,address)", func(data []byte) ([]byte, error) {
+		return word(allowance), nil
+	})
+
+	var approved []int64
+
+	// USDT rejects changing non-zero allowance to non-zero
+	provider.HandleTransaction(tokenAddress.Hex(), "approve(address,uint256)", func(data []byte) ([]*client.Log, error) {
+		value := new(big.Int).SetBytes(data[32:64])
+
+		if allowance.Sign() != 0 && value.Sign() != 0 {
+			return nil, errors.New("non-zero allowance")
+		}
+
+		allowance = value
+		approved = append(approved, value.Int64())
+
+		return nil, nil
+	})
+
+	amount := token.Amount(big.NewInt(500))
+
+	require.True(t, errors.Is(token.CheckAllowance(context.Background(), spender, spender, amount), ErrAllowance))
+
+	receipt, err := token.SafeApprove(context.Background(), spender, amount, abi.WithNonce(7))
+
+	require.NoError(t, err)
+	require.True(t, receipt.Succeeded())
+	require.Equal(t, []int64{0, 500}, approved)
+
+	// the nonce of caller is used by the reset transaction only
+	require.Equal(t, uint64(7), provider.Transactions()[0].AccountNonce)
+	require.Equal(t, uint64(1), provider.Transactions()[1].AccountNonce)
+
+	require.NoError(t, token.CheckAllowance(context.Background(), spender, spender, amount))
+
+	// allowance is up to date
+	receipt, err = token.SafeApprove(context.Background(), spender, amount)
+
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+	require.Len(t, provider.Transactions(), 2)
+}
+
+func TestDecodeEvents(t *testing.T) {
+	from := address.HexToAddress("0x44A347Cf7278685320a05Cb39e903C42e472e262")
+
+	erc20Log := &client.Log{
+		Topics: []string{eventTopic("Transfer(address,address,uint256)"), addressTopic(from), addressTopic(spender)},
+		Data:   "0x" + hex.EncodeToString(word(big.NewInt(7))),
+	}
+
+	erc721Log := &client.Log{
+		Topics: []string{eventTopic("Transfer(address,address,uint256)"), addressTopic(from), addressTopic(spender), "0x" + hex.EncodeToString(word(big.NewInt(7)))},
+		Data:   "0x",
+	}
+
+	event, err := DecodeERC20Event(erc20Log)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(7), event.(*ERC20TransferEvent).Value.Int64())
+
+	_, err = DecodeERC20Event(erc721Log)
+
+	require.True(t, errors.Is(err, abi.ErrTopic))
+
+	event, err = DecodeERC721Event(erc721Log)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(7), event.(*ERC721TransferEvent).TokenId.Int64())
+	require.Equal(t, spender, event.(*ERC721TransferEvent).To)
+
+	_, err = DecodeERC1155Event(erc20Log)
+
+	require.True(t, errors.Is(err, ErrEvent))
+
+	require.Equal(t, "https://token/000000000000000000000000000000000000000000000000000000000004cce0.json", TokenURI("https://token/{id}.json", big.NewInt(314592)))
+}